@@ -1,92 +1,193 @@
-package main
-
-import (
-	"flag"
-	"log"
-	"os"
-	"os/signal"
-	"syscall"
-
-	"github.com/lindaprotocol/grpc-api-gateway/internal/config"
-	"github.com/lindaprotocol/grpc-api-gateway/internal/services/blockchain"
-	"github.com/lindaprotocol/grpc-api-gateway/internal/services/indexer"
-	"github.com/lindaprotocol/grpc-api-gateway/internal/services/storage/postgres"
-	"github.com/lindaprotocol/grpc-api-gateway/internal/services/storage/repository"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
-)
-
-var (
-	configPath = flag.String("config", "./internal/config/config.yaml", "configuration file path")
-)
-
-func main() {
-	flag.Parse()
-
-	// Load configuration
-	cfg, err := config.Load(*configPath)
-	if err != nil {
-		log.Fatalf("Failed to load config: %v", err)
-	}
-
-	// Initialize database
-	db, err := postgres.NewConnection(cfg.Database)
-	if err != nil {
-		log.Fatalf("Failed to connect to database: %v", err)
-	}
-
-	// Create gRPC connection
-	conn, err := grpc.Dial(
-		cfg.Linda.FullnodeEndpoint,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-	)
-	if err != nil {
-		log.Fatalf("Failed to connect to fullnode: %v", err)
-	}
-	defer conn.Close()
-
-	solidityConn, err := grpc.Dial(
-		cfg.Linda.SolidityEndpoint,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-	)
-	if err != nil {
-		log.Fatalf("Failed to connect to solidity node: %v", err)
-	}
-	defer solidityConn.Close()
-
-	// Initialize blockchain client
-	blockchainClient := blockchain.NewClient(conn, solidityConn, cfg.Linda)
-
-	// Initialize repositories
-	blockRepo := repository.NewBlockRepository(db)
-	txRepo := repository.NewTransactionRepository(db)
-	tokenRepo := repository.NewTokenRepository(db)
-	eventRepo := repository.NewEventRepository(db)
-	statsRepo := repository.NewStatsRepository(db)
-
-	// Initialize indexer
-	idx := indexer.NewIndexer(
-		&cfg.Indexer,
-		blockchainClient,
-		blockRepo,
-		txRepo,
-		tokenRepo,
-		eventRepo,
-		statsRepo,
-	)
-
-	// Start indexer
-	if err := idx.Start(); err != nil {
-		log.Fatalf("Failed to start indexer: %v", err)
-	}
-
-	// Wait for shutdown signal
-	sigChan := make(chan os.Signal, 1)
-	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
-	<-sigChan
-
-	// Stop indexer
-	if err := idx.Stop(); err != nil {
-		log.Printf("Error stopping indexer: %v", err)
-	}
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/lindaprotocol/grpc-api-gateway/internal/config"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/aggregator"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/alert"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/blockchain"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/indexer"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/price"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/storage/postgres"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/storage/repository"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/telemetry"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/webhook"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+var (
+	configPath = flag.String("config", "./internal/config/config.yaml", "configuration file path")
+)
+
+func main() {
+	flag.Parse()
+
+	// Load configuration
+	cfg, err := config.Load(*configPath)
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+
+	// Initialize database
+	db, err := postgres.NewConnection(cfg.Database)
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+
+	// Create gRPC connection
+	conn, err := grpc.Dial(
+		cfg.Linda.FullnodeEndpoint,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		log.Fatalf("Failed to connect to fullnode: %v", err)
+	}
+	defer conn.Close()
+
+	solidityConn, err := grpc.Dial(
+		cfg.Linda.SolidityEndpoint,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		log.Fatalf("Failed to connect to solidity node: %v", err)
+	}
+	defer solidityConn.Close()
+
+	// Initialize blockchain client
+	blockchainClient := blockchain.NewClient(conn, solidityConn, cfg.Linda)
+
+	// Initialize repositories
+	blockRepo := repository.NewBlockRepository(db)
+	txRepo := repository.NewTransactionRepository(db)
+	tokenRepo := repository.NewTokenRepository(db)
+	eventRepo := repository.NewEventRepository(db)
+	statsRepo := repository.NewStatsRepository(db)
+	nftRepo := repository.NewNFTRepository(db)
+	balanceRepo := repository.NewBalanceRepository(db)
+	stakingRepo := repository.NewStakingRepository(db)
+	witnessRepo := repository.NewWitnessRepository(db)
+	governanceRepo := repository.NewGovernanceRepository(db)
+	exchangeRepo := repository.NewExchangeRepository(db)
+	webhookRepo := repository.NewWebhookRepository(db)
+	alertRepo := repository.NewAlertRepository(db)
+	nodeRepo := repository.NewNodeRepository(db)
+
+	// Initialize webhooks
+	var webhookNotifier *webhook.Notifier
+	var webhookDispatcher *webhook.Dispatcher
+	if cfg.Webhook.Enabled {
+		webhookNotifier = webhook.NewNotifier(webhookRepo, cfg.Webhook.SubscriptionRefresh)
+		webhookDispatcher = webhook.NewDispatcher(cfg.Webhook, webhookRepo, blockchainClient)
+	}
+
+	// Initialize alerts
+	var alertEngine *alert.Engine
+	if cfg.Alerts.Enabled {
+		alertEngine = alert.NewEngine(cfg.Alerts, alertRepo, tokenRepo, blockchainClient)
+	}
+
+	// Initialize price feed
+	var priceRefresher *price.Refresher
+	if cfg.PriceFeed.Enabled {
+		providers, err := price.NewProviders(cfg.PriceFeed, cfg.External, exchangeRepo)
+		if err != nil {
+			log.Fatalf("Failed to create price providers: %v", err)
+		}
+		priceRefresher = price.NewRefresher(cfg.PriceFeed, tokenRepo, providers)
+	}
+
+	// Initialize node telemetry retention
+	telemetryPruner := telemetry.NewPruner(cfg.Telemetry, nodeRepo)
+
+	// Initialize statistics aggregation
+	var statsAggregator *aggregator.Aggregator
+	if cfg.Aggregator.Enabled {
+		statsAggregator = aggregator.NewAggregator(cfg.Aggregator, statsRepo)
+	}
+
+	// Initialize indexer
+	idx := indexer.NewIndexer(
+		&cfg.Indexer,
+		blockchainClient,
+		blockRepo,
+		txRepo,
+		tokenRepo,
+		eventRepo,
+		statsRepo,
+		nftRepo,
+		balanceRepo,
+		stakingRepo,
+		witnessRepo,
+		governanceRepo,
+		exchangeRepo,
+		webhookNotifier,
+		alertEngine,
+	)
+
+	// Start indexer
+	if err := idx.Start(); err != nil {
+		log.Fatalf("Failed to start indexer: %v", err)
+	}
+
+	// Start webhook dispatcher
+	if webhookDispatcher != nil {
+		webhookDispatcher.Start()
+	}
+
+	// Start alert engine
+	if alertEngine != nil {
+		alertEngine.Start()
+	}
+
+	// Start price refresher
+	if priceRefresher != nil {
+		priceRefresher.Start()
+	}
+
+	// Start node telemetry pruner
+	telemetryPruner.Start()
+
+	// Start statistics aggregator
+	if statsAggregator != nil {
+		statsAggregator.Start()
+	}
+
+	// Wait for shutdown signal
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
+	<-sigChan
+
+	// Stop webhook dispatcher
+	if webhookDispatcher != nil {
+		webhookDispatcher.Stop()
+	}
+
+	// Stop alert engine
+	if alertEngine != nil {
+		alertEngine.Stop()
+	}
+
+	// Stop price refresher
+	if priceRefresher != nil {
+		priceRefresher.Stop()
+	}
+
+	// Stop node telemetry pruner
+	telemetryPruner.Stop()
+
+	// Stop statistics aggregator
+	if statsAggregator != nil {
+		statsAggregator.Stop()
+	}
+
+	// Stop indexer
+	if err := idx.Stop(); err != nil {
+		log.Printf("Error stopping indexer: %v", err)
+	}
 }
\ No newline at end of file
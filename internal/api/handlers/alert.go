@@ -0,0 +1,366 @@
+package handlers
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/auth"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/storage/repository"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/webhook"
+	"github.com/lindaprotocol/grpc-api-gateway/pkg/utils"
+	"gorm.io/gorm"
+)
+
+type AlertHandler struct {
+	alertRepo *repository.AlertRepository
+}
+
+func NewAlertHandler(alertRepo *repository.AlertRepository) *AlertHandler {
+	return &AlertHandler{
+		alertRepo: alertRepo,
+	}
+}
+
+// CreateWatchlist handles POST /api/watchlists
+// Creates a watchlist with an optional initial set of addresses
+func (h *AlertHandler) CreateWatchlist(c *gin.Context) {
+	user, ok := authenticatedUser(c)
+	if !ok {
+		utils.RespondWithError(c, http.StatusUnauthorized, "Authentication is required to manage watchlists")
+		return
+	}
+
+	var req models.WatchlistRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
+		return
+	}
+
+	watchlist := &models.Watchlist{
+		ID:        uuid.New().String(),
+		UserID:    user.ID,
+		Name:      req.Name,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	seen := make(map[string]bool)
+	for _, addr := range req.Addresses {
+		if !utils.IsValidBase58Address(addr.Address) {
+			utils.RespondWithError(c, http.StatusBadRequest, "Invalid address format: "+addr.Address)
+			return
+		}
+		if seen[addr.Address] {
+			continue
+		}
+		seen[addr.Address] = true
+		watchlist.Addresses = append(watchlist.Addresses, &models.WatchlistAddress{
+			WatchlistID: watchlist.ID,
+			Address:     addr.Address,
+			Label:       addr.Label,
+			CreatedAt:   time.Now(),
+		})
+	}
+
+	if err := h.alertRepo.CreateWatchlist(watchlist); err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to create watchlist: "+err.Error())
+		return
+	}
+
+	utils.RespondWithCreated(c, watchlist)
+}
+
+// ListWatchlists handles GET /api/watchlists
+// Returns the watchlists of the calling user
+func (h *AlertHandler) ListWatchlists(c *gin.Context) {
+	user, ok := authenticatedUser(c)
+	if !ok {
+		utils.RespondWithError(c, http.StatusUnauthorized, "Authentication is required to manage watchlists")
+		return
+	}
+
+	watchlists, err := h.alertRepo.GetWatchlists(user.ID)
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get watchlists: "+err.Error())
+		return
+	}
+
+	utils.RespondWithSuccess(c, watchlists)
+}
+
+// DeleteWatchlist handles DELETE /api/watchlists/:id
+// Removes a watchlist together with its alert rules
+func (h *AlertHandler) DeleteWatchlist(c *gin.Context) {
+	user, ok := authenticatedUser(c)
+	if !ok {
+		utils.RespondWithError(c, http.StatusUnauthorized, "Authentication is required to manage watchlists")
+		return
+	}
+
+	if err := h.alertRepo.DeleteWatchlist(c.Param("id"), user.ID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			utils.RespondWithError(c, http.StatusNotFound, "Watchlist not found")
+			return
+		}
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to delete watchlist: "+err.Error())
+		return
+	}
+
+	utils.RespondWithSuccess(c, gin.H{"id": c.Param("id")})
+}
+
+// AddWatchlistAddress handles POST /api/watchlists/:id/addresses
+// Adds an address to a watchlist or updates its label
+func (h *AlertHandler) AddWatchlistAddress(c *gin.Context) {
+	user, ok := authenticatedUser(c)
+	if !ok {
+		utils.RespondWithError(c, http.StatusUnauthorized, "Authentication is required to manage watchlists")
+		return
+	}
+
+	var req models.WatchlistAddressRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
+		return
+	}
+	if !utils.IsValidBase58Address(req.Address) {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid address format")
+		return
+	}
+
+	watchlist, err := h.alertRepo.GetWatchlist(c.Param("id"), user.ID)
+	if err != nil {
+		utils.RespondWithError(c, http.StatusNotFound, "Watchlist not found")
+		return
+	}
+
+	address := &models.WatchlistAddress{
+		WatchlistID: watchlist.ID,
+		Address:     req.Address,
+		Label:       req.Label,
+		CreatedAt:   time.Now(),
+	}
+	if err := h.alertRepo.AddWatchlistAddress(address); err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to add address: "+err.Error())
+		return
+	}
+
+	utils.RespondWithCreated(c, address)
+}
+
+// RemoveWatchlistAddress handles DELETE /api/watchlists/:id/addresses/:address
+// Removes an address from a watchlist
+func (h *AlertHandler) RemoveWatchlistAddress(c *gin.Context) {
+	user, ok := authenticatedUser(c)
+	if !ok {
+		utils.RespondWithError(c, http.StatusUnauthorized, "Authentication is required to manage watchlists")
+		return
+	}
+
+	watchlist, err := h.alertRepo.GetWatchlist(c.Param("id"), user.ID)
+	if err != nil {
+		utils.RespondWithError(c, http.StatusNotFound, "Watchlist not found")
+		return
+	}
+
+	if err := h.alertRepo.RemoveWatchlistAddress(watchlist.ID, c.Param("address")); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			utils.RespondWithError(c, http.StatusNotFound, "Address not on watchlist")
+			return
+		}
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to remove address: "+err.Error())
+		return
+	}
+
+	utils.RespondWithSuccess(c, gin.H{"address": c.Param("address")})
+}
+
+// CreateRule handles POST /api/alert-rules
+// Attaches an alert rule and its notifier to a watchlist
+func (h *AlertHandler) CreateRule(c *gin.Context) {
+	user, ok := authenticatedUser(c)
+	if !ok {
+		utils.RespondWithError(c, http.StatusUnauthorized, "Authentication is required to manage alert rules")
+		return
+	}
+
+	var req models.AlertRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
+		return
+	}
+	if msg := validateAlertRule(c.Request.Context(), &req); msg != "" {
+		utils.RespondWithError(c, http.StatusBadRequest, msg)
+		return
+	}
+
+	if _, err := h.alertRepo.GetWatchlist(req.WatchlistID, user.ID); err != nil {
+		utils.RespondWithError(c, http.StatusNotFound, "Watchlist not found")
+		return
+	}
+
+	rule := &models.AlertRule{
+		ID:          uuid.New().String(),
+		UserID:      user.ID,
+		WatchlistID: req.WatchlistID,
+		Type:        req.Type,
+		Asset:       req.Asset,
+		Threshold:   req.Threshold,
+		Notifier:    req.Notifier,
+		Target:      req.Target,
+		DedupWindow: req.DedupWindow,
+		Active:      true,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+
+	if rule.Notifier == models.AlertNotifierWebhook {
+		secret := make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			utils.RespondWithError(c, http.StatusInternalServerError, "Failed to generate secret: "+err.Error())
+			return
+		}
+		rule.Secret = hex.EncodeToString(secret)
+	}
+
+	if err := h.alertRepo.CreateRule(rule); err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to create alert rule: "+err.Error())
+		return
+	}
+
+	utils.RespondWithCreated(c, &models.AlertRuleResponse{
+		AlertRule: rule,
+		Secret:    rule.Secret,
+	})
+}
+
+// ListRules handles GET /api/alert-rules
+// Returns the alert rules of the calling user
+func (h *AlertHandler) ListRules(c *gin.Context) {
+	user, ok := authenticatedUser(c)
+	if !ok {
+		utils.RespondWithError(c, http.StatusUnauthorized, "Authentication is required to manage alert rules")
+		return
+	}
+
+	rules, err := h.alertRepo.GetRules(user.ID)
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get alert rules: "+err.Error())
+		return
+	}
+
+	utils.RespondWithSuccess(c, rules)
+}
+
+// DeleteRule handles DELETE /api/alert-rules/:id
+// Removes an alert rule
+func (h *AlertHandler) DeleteRule(c *gin.Context) {
+	user, ok := authenticatedUser(c)
+	if !ok {
+		utils.RespondWithError(c, http.StatusUnauthorized, "Authentication is required to manage alert rules")
+		return
+	}
+
+	if err := h.alertRepo.DeleteRule(c.Param("id"), user.ID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			utils.RespondWithError(c, http.StatusNotFound, "Alert rule not found")
+			return
+		}
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to delete alert rule: "+err.Error())
+		return
+	}
+
+	utils.RespondWithSuccess(c, gin.H{"id": c.Param("id")})
+}
+
+// GetAlerts handles GET /api/alerts
+// Returns the alert history of the calling user
+func (h *AlertHandler) GetAlerts(c *gin.Context) {
+	user, ok := authenticatedUser(c)
+	if !ok {
+		utils.RespondWithError(c, http.StatusUnauthorized, "Authentication is required to view alerts")
+		return
+	}
+
+	var req models.AlertListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
+		return
+	}
+
+	// Set defaults
+	if req.Limit <= 0 {
+		req.Limit = 20
+	}
+	if req.Limit > 200 {
+		req.Limit = 200
+	}
+
+	alerts, total, err := h.alertRepo.GetAlerts(user.ID, req.RuleID, req.Start, req.Limit)
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get alerts: "+err.Error())
+		return
+	}
+
+	utils.RespondWithMeta(c, alerts, gin.H{
+		"total": total,
+		"start": req.Start,
+		"limit": req.Limit,
+	})
+}
+
+// validateAlertRule checks the fields a rule type and notifier depend on
+func validateAlertRule(ctx context.Context, req *models.AlertRuleRequest) string {
+	if req.Asset != "" && req.Asset != models.AssetLIND && !utils.IsTokenID(req.Asset) && !utils.IsValidBase58Address(req.Asset) {
+		return "Asset must be LIND, an LRC-10 token ID or an LRC-20 contract address"
+	}
+
+	switch req.Type {
+	case models.AlertRuleLargeTransfer, models.AlertRuleLowBalance:
+		threshold, ok := new(big.Int).SetString(req.Threshold, 10)
+		if !ok || threshold.Sign() < 0 {
+			return "Threshold must be a non-negative integer amount"
+		}
+		if req.Type == models.AlertRuleLowBalance && req.Asset == "" {
+			return "Asset is required for low_balance rules"
+		}
+	}
+
+	switch req.Notifier {
+	case models.AlertNotifierWebhook:
+		if err := webhook.ValidateCallbackURL(ctx, req.Target); err != nil {
+			return "Target must be a public callback URL for webhook notifiers: " + err.Error()
+		}
+	case models.AlertNotifierEmail:
+		if _, err := mail.ParseAddress(req.Target); err != nil || strings.ContainsAny(req.Target, "\r\n") {
+			return "Target must be an email address for email notifiers"
+		}
+	}
+
+	if req.DedupWindow < 0 {
+		return "Dedup window must not be negative"
+	}
+	return ""
+}
+
+// authenticatedUser returns the non-anonymous user set by the auth middleware
+func authenticatedUser(c *gin.Context) (*auth.User, bool) {
+	value, exists := c.Get("auth_user")
+	if !exists {
+		return nil, false
+	}
+	user, ok := value.(*auth.User)
+	if !ok || user == nil || user.IsAnonymous || user.ID == "" {
+		return nil, false
+	}
+	return user, true
+}
@@ -0,0 +1,161 @@
+package handlers
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/blockchain"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/storage/repository"
+	"github.com/lindaprotocol/grpc-api-gateway/pkg/lindapb"
+	"github.com/lindaprotocol/grpc-api-gateway/pkg/utils"
+)
+
+// defaultRevokeFeeLimit is the fee limit of a revoke transaction when none is given, in sun
+const defaultRevokeFeeLimit = 100000000
+
+// unlimitedAllowance is the allowance from which an approval is reported as
+// unlimited, wallets approve the maximum uint256 for "infinite" approvals
+var unlimitedAllowance = new(big.Int).Lsh(big.NewInt(1), 255)
+
+type ApprovalHandler struct {
+	blockchainClient *blockchain.Client
+	tokenRepo        *repository.TokenRepository
+}
+
+func NewApprovalHandler(client *blockchain.Client, tokenRepo *repository.TokenRepository) *ApprovalHandler {
+	return &ApprovalHandler{
+		blockchainClient: client,
+		tokenRepo:        tokenRepo,
+	}
+}
+
+// GetApprovals handles GET /api/account/approvals
+// Returns the LRC20 allowances an address has granted, flagging unlimited ones
+func (h *ApprovalHandler) GetApprovals(c *gin.Context) {
+	var req models.ApprovalListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
+		return
+	}
+
+	if !utils.IsValidBase58Address(req.Address) {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid address format")
+		return
+	}
+	if req.Contract != "" && !utils.IsValidBase58Address(req.Contract) {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid contract address")
+		return
+	}
+
+	// Set defaults
+	if req.Limit <= 0 {
+		req.Limit = 20
+	}
+	if req.Limit > 200 {
+		req.Limit = 200
+	}
+
+	approvals, total, err := h.tokenRepo.GetApprovals(req.Address, req.Contract, req.Start, req.Limit)
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get approvals: "+err.Error())
+		return
+	}
+
+	unlimited := 0
+	for _, approval := range approvals {
+		approval.Unlimited = isUnlimitedAllowance(approval.Allowance, approval.TotalSupply)
+		if approval.Unlimited {
+			unlimited++
+		}
+	}
+
+	utils.RespondWithMeta(c, approvals, gin.H{
+		"total":     total,
+		"start":     req.Start,
+		"limit":     req.Limit,
+		"unlimited": unlimited,
+	})
+}
+
+// RevokeApproval handles POST /api/account/approvals/revoke
+// Builds the unsigned approve(spender, 0) transaction that revokes an allowance
+func (h *ApprovalHandler) RevokeApproval(c *gin.Context) {
+	var req models.RevokeApprovalRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
+		return
+	}
+
+	triggerReq, err := BuildRevokeApproval(req.OwnerAddress, req.SpenderAddress, req.ContractAddress, req.FeeLimit)
+	if err != nil {
+		utils.RespondWithError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	result, err := h.blockchainClient.TriggerSmartContract(context.Background(), triggerReq)
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to build revoke transaction: "+err.Error())
+		return
+	}
+
+	utils.RespondWithSuccess(c, gin.H{
+		"transaction": convertTransactionToResponse(result.Transaction, true),
+		"txid":        hex.EncodeToString(result.Txid),
+		"result":      result.Result,
+	})
+}
+
+// BuildRevokeApproval builds the TriggerSmartContract request calling
+// approve(spender, 0) on an LRC20 token from base58 addresses
+func BuildRevokeApproval(owner, spender, contract string, feeLimit int64) (*lindapb.TriggerSmartContractReq, error) {
+	addresses := make([][]byte, 0, 3)
+	for _, address := range []string{owner, spender, contract} {
+		if !utils.IsValidBase58Address(address) {
+			return nil, fmt.Errorf("Invalid address format: %s", address)
+		}
+		hexAddr, err := utils.Base58ToHex(address)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid address format: %s", address)
+		}
+		raw, err := hex.DecodeString(hexAddr)
+		if err != nil || len(raw) != 21 {
+			return nil, fmt.Errorf("Invalid address format: %s", address)
+		}
+		addresses = append(addresses, raw)
+	}
+
+	if feeLimit <= 0 {
+		feeLimit = defaultRevokeFeeLimit
+	}
+
+	// ABI arguments: the 20 byte spender address without prefix, then a zero amount
+	parameter := strings.Repeat("0", 24) + hex.EncodeToString(addresses[1][1:]) + strings.Repeat("0", 64)
+
+	return &lindapb.TriggerSmartContractReq{
+		OwnerAddress:     addresses[0],
+		ContractAddress:  addresses[2],
+		FunctionSelector: "approve(address,uint256)",
+		Parameter:        parameter,
+		FeeLimit:         feeLimit,
+	}, nil
+}
+
+// isUnlimitedAllowance reports whether an allowance is effectively infinite,
+// either near the maximum uint256 or above the whole token supply
+func isUnlimitedAllowance(allowance, totalSupply string) bool {
+	value, ok := new(big.Int).SetString(allowance, 10)
+	if !ok {
+		return false
+	}
+	if value.Cmp(unlimitedAllowance) >= 0 {
+		return true
+	}
+	supply, ok := new(big.Int).SetString(totalSupply, 10)
+	return ok && supply.Sign() > 0 && value.Cmp(supply) > 0
+}
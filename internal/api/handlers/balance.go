@@ -0,0 +1,145 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/storage/repository"
+	"github.com/lindaprotocol/grpc-api-gateway/pkg/utils"
+)
+
+// maxBalancePoints is the maximum number of intervals of a balance history
+const maxBalancePoints = 1000
+
+// defaultBalanceHistoryRange is the history returned when from is not given
+const defaultBalanceHistoryRange = 30 * 24 * time.Hour
+
+// balanceIntervals are the supported history intervals
+var balanceIntervals = map[string]time.Duration{
+	"1h": time.Hour,
+	"4h": 4 * time.Hour,
+	"1d": 24 * time.Hour,
+	"1w": 7 * 24 * time.Hour,
+}
+
+type BalanceHandler struct {
+	balanceRepo *repository.BalanceRepository
+}
+
+func NewBalanceHandler(balanceRepo *repository.BalanceRepository) *BalanceHandler {
+	return &BalanceHandler{
+		balanceRepo: balanceRepo,
+	}
+}
+
+// GetBalanceHistory handles GET /api/account/balance-history
+// Returns the indexed balance of an address in LIND, an LRC10 token or an LRC20 token at the end of each interval
+func (h *BalanceHandler) GetBalanceHistory(c *gin.Context) {
+	var req models.BalanceHistoryRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
+		return
+	}
+
+	if !utils.IsValidBase58Address(req.Address) {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid address format")
+		return
+	}
+	asset, err := balanceAsset(req.Token)
+	if err != nil {
+		utils.RespondWithError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Set defaults
+	if req.Interval == "" {
+		req.Interval = "1d"
+	}
+	interval, ok := balanceIntervals[req.Interval]
+	if !ok {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid interval: "+req.Interval)
+		return
+	}
+	if req.To <= 0 {
+		req.To = time.Now().UnixMilli()
+	}
+	if req.From <= 0 {
+		req.From = req.To - defaultBalanceHistoryRange.Milliseconds()
+	}
+	if req.From > req.To {
+		utils.RespondWithError(c, http.StatusBadRequest, "from must not be after to")
+		return
+	}
+	if (req.To-req.From)/interval.Milliseconds() >= maxBalancePoints {
+		utils.RespondWithError(c, http.StatusBadRequest, fmt.Sprintf("Range exceeds %d intervals", maxBalancePoints))
+		return
+	}
+
+	points, err := h.balanceRepo.GetHistory(req.Address, asset, req.From, req.To, interval.Milliseconds())
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get balance history: "+err.Error())
+		return
+	}
+
+	utils.RespondWithMeta(c, points, gin.H{
+		"address":  req.Address,
+		"token":    asset,
+		"from":     req.From,
+		"to":       req.To,
+		"interval": req.Interval,
+	})
+}
+
+// GetBalanceAtBlock handles GET /api/account/balance
+// Returns the indexed balance of an address as of a block, the latest indexed block when none is given
+func (h *BalanceHandler) GetBalanceAtBlock(c *gin.Context) {
+	var req models.BalanceAtBlockRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
+		return
+	}
+
+	if !utils.IsValidBase58Address(req.Address) {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid address format")
+		return
+	}
+	asset, err := balanceAsset(req.Token)
+	if err != nil {
+		utils.RespondWithError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	balance, err := h.balanceRepo.GetBalanceAt(req.Address, asset, req.Block)
+	if errors.Is(err, repository.ErrBalanceNotAvailable) {
+		utils.RespondWithError(c, http.StatusNotFound, err.Error())
+		return
+	}
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get balance: "+err.Error())
+		return
+	}
+
+	utils.RespondWithSuccess(c, gin.H{
+		"address": req.Address,
+		"token":   asset,
+		"block":   req.Block,
+		"balance": balance,
+	})
+}
+
+// balanceAsset returns the delta asset of a token parameter: LIND when it is
+// empty, an LRC10 token ID or an LRC20 contract address
+func balanceAsset(token string) (string, error) {
+	if token == "" || strings.EqualFold(token, models.AssetLIND) {
+		return models.AssetLIND, nil
+	}
+	if utils.IsTokenID(token) || utils.IsValidBase58Address(token) {
+		return token, nil
+	}
+	return "", fmt.Errorf("Invalid token: %s", token)
+}
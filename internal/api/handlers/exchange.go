@@ -0,0 +1,212 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/storage/repository"
+	"github.com/lindaprotocol/grpc-api-gateway/pkg/utils"
+)
+
+// candleIntervals are the supported candle intervals in milliseconds
+var candleIntervals = map[string]int64{
+	"1m": time.Minute.Milliseconds(),
+	"5m": (5 * time.Minute).Milliseconds(),
+	"1h": time.Hour.Milliseconds(),
+	"1d": (24 * time.Hour).Milliseconds(),
+}
+
+// maxCandles is the maximum number of candles returned by one request
+const maxCandles = 1000
+
+// defaultLiquidityRange is the liquidity history returned when from is not given
+const defaultLiquidityRange = 30 * 24 * time.Hour
+
+type ExchangeHandler struct {
+	exchangeRepo *repository.ExchangeRepository
+}
+
+func NewExchangeHandler(exchangeRepo *repository.ExchangeRepository) *ExchangeHandler {
+	return &ExchangeHandler{
+		exchangeRepo: exchangeRepo,
+	}
+}
+
+// GetExchangeCandles handles GET /api/exchange/:id/candles
+// Returns the OHLCV candles and 24h volume of a Bancor exchange
+func (h *ExchangeHandler) GetExchangeCandles(c *gin.Context) {
+	exchangeID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid exchange ID")
+		return
+	}
+
+	req, interval, ok := bindCandleRequest(c)
+	if !ok {
+		return
+	}
+
+	candles, err := h.exchangeRepo.GetExchangeCandles(exchangeID, interval, req.From, req.To)
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get candles: "+err.Error())
+		return
+	}
+
+	volume, err := h.exchangeRepo.GetExchangeVolume(exchangeID, time.Now().Add(-24*time.Hour).UnixMilli())
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get volume: "+err.Error())
+		return
+	}
+
+	utils.RespondWithMeta(c, candles, gin.H{
+		"exchange_id": exchangeID,
+		"interval":    req.Interval,
+		"from":        req.From,
+		"to":          req.To,
+		"volume_24h":  volume,
+	})
+}
+
+// GetExchangeLiquidity handles GET /api/exchange/:id/liquidity
+// Returns the daily closing balances of a Bancor exchange
+func (h *ExchangeHandler) GetExchangeLiquidity(c *gin.Context) {
+	exchangeID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid exchange ID")
+		return
+	}
+
+	var req models.CandleRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
+		return
+	}
+
+	// Set defaults
+	if req.To <= 0 {
+		req.To = time.Now().UnixMilli()
+	}
+	if req.From <= 0 {
+		req.From = req.To - defaultLiquidityRange.Milliseconds()
+	}
+
+	points, err := h.exchangeRepo.GetLiquidityHistory(exchangeID, req.From, req.To)
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get liquidity history: "+err.Error())
+		return
+	}
+
+	utils.RespondWithSuccess(c, points)
+}
+
+// GetMarketTrades handles GET /api/market/:pair/trades
+// Returns the fills of a market pair, newest first, with its 24h volume
+func (h *ExchangeHandler) GetMarketTrades(c *gin.Context) {
+	pair, ok := parseMarketPair(c)
+	if !ok {
+		return
+	}
+
+	var req models.TradeHistoryRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
+		return
+	}
+
+	// Set defaults
+	if req.Limit <= 0 {
+		req.Limit = 20
+	}
+	if req.Limit > 200 {
+		req.Limit = 200
+	}
+
+	trades, total, err := h.exchangeRepo.GetMarketTrades(pair, req.Start, req.Limit)
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get trades: "+err.Error())
+		return
+	}
+
+	volume, err := h.exchangeRepo.GetMarketVolume(pair, time.Now().Add(-24*time.Hour).UnixMilli())
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get volume: "+err.Error())
+		return
+	}
+
+	utils.RespondWithMeta(c, trades, gin.H{
+		"pair":       pair,
+		"total":      total,
+		"start":      req.Start,
+		"limit":      req.Limit,
+		"volume_24h": volume,
+	})
+}
+
+// GetMarketCandles handles GET /api/market/:pair/candles
+// Returns the OHLCV candles of a market pair
+func (h *ExchangeHandler) GetMarketCandles(c *gin.Context) {
+	pair, ok := parseMarketPair(c)
+	if !ok {
+		return
+	}
+
+	req, interval, ok := bindCandleRequest(c)
+	if !ok {
+		return
+	}
+
+	candles, err := h.exchangeRepo.GetMarketCandles(pair, interval, req.From, req.To)
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get candles: "+err.Error())
+		return
+	}
+
+	utils.RespondWithMeta(c, candles, gin.H{
+		"pair":     pair,
+		"interval": req.Interval,
+		"from":     req.From,
+		"to":       req.To,
+	})
+}
+
+// bindCandleRequest binds candle query parameters and limits the range to maxCandles intervals
+func bindCandleRequest(c *gin.Context) (*models.CandleRequest, int64, bool) {
+	var req models.CandleRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
+		return nil, 0, false
+	}
+
+	// Set defaults
+	if req.Interval == "" {
+		req.Interval = "1h"
+	}
+	interval, ok := candleIntervals[req.Interval]
+	if !ok {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid interval, expected 1m, 5m, 1h or 1d")
+		return nil, 0, false
+	}
+	if req.To <= 0 {
+		req.To = time.Now().UnixMilli()
+	}
+	if req.From <= 0 || (req.To-req.From)/interval > maxCandles {
+		req.From = req.To - maxCandles*interval
+	}
+
+	return &req, interval, true
+}
+
+// parseMarketPair reads a "<token>-<token>" pair path parameter in either order
+func parseMarketPair(c *gin.Context) (string, bool) {
+	tokens := strings.Split(c.Param("pair"), "-")
+	if len(tokens) != 2 || tokens[0] == "" || tokens[1] == "" || tokens[0] == tokens[1] {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid pair, expected <token>-<token>")
+		return "", false
+	}
+	pair, _, _ := models.MarketPair(tokens[0], tokens[1])
+	return pair, true
+}
@@ -0,0 +1,167 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/blockchain"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/storage/repository"
+	"github.com/lindaprotocol/grpc-api-gateway/pkg/lindapb"
+	"github.com/lindaprotocol/grpc-api-gateway/pkg/utils"
+)
+
+type GovernanceHandler struct {
+	blockchainClient *blockchain.Client
+	governanceRepo   *repository.GovernanceRepository
+}
+
+func NewGovernanceHandler(client *blockchain.Client, governanceRepo *repository.GovernanceRepository) *GovernanceHandler {
+	return &GovernanceHandler{
+		blockchainClient: client,
+		governanceRepo:   governanceRepo,
+	}
+}
+
+// GetAccountProposals handles GET /api/account-proposal
+// Returns proposals, optionally created by an account, with their approval timeline when indexed
+func (h *GovernanceHandler) GetAccountProposals(c *gin.Context) {
+	var req models.AccountProposalRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
+		return
+	}
+
+	// Set defaults
+	if req.Limit <= 0 {
+		req.Limit = 20
+	}
+	if req.Limit > 200 {
+		req.Limit = 200
+	}
+
+	proposals, total, err := h.governanceRepo.GetProposals(req.Address, req.Start, req.Limit)
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get proposals: "+err.Error())
+		return
+	}
+	if total == 0 {
+		h.nodeAccountProposals(c, &req)
+		return
+	}
+
+	ids := make([]int64, len(proposals))
+	timelines := make([]*models.ProposalTimeline, len(proposals))
+	byID := make(map[int64]*models.ProposalTimeline, len(proposals))
+	for i, proposal := range proposals {
+		ids[i] = proposal.ProposalID
+		timelines[i] = &models.ProposalTimeline{
+			ProposalResponse: models.ProposalResponse{
+				ProposalID:      proposal.ProposalID,
+				ProposerAddress: proposal.Proposer,
+				ExpirationTime:  proposal.ExpirationTime,
+				CreateTime:      proposal.CreateTime,
+				Approvals:       make([]string, 0),
+				State:           proposal.State,
+			},
+			Timeline: make([]*models.ProposalApproval, 0),
+		}
+		json.Unmarshal(proposal.Parameters, &timelines[i].Parameters)
+		byID[proposal.ProposalID] = timelines[i]
+	}
+
+	approvals, err := h.governanceRepo.GetApprovals(ids)
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get approvals: "+err.Error())
+		return
+	}
+	for _, approval := range approvals {
+		timeline, ok := byID[approval.ProposalID]
+		if !ok {
+			continue
+		}
+		timeline.Timeline = append(timeline.Timeline, approval)
+
+		// Approvals lists the witnesses approving after the last change
+		current := timeline.Approvals[:0]
+		for _, witness := range timeline.Approvals {
+			if witness != approval.Witness {
+				current = append(current, witness)
+			}
+		}
+		if approval.Approve {
+			current = append(current, approval.Witness)
+		}
+		timeline.Approvals = current
+	}
+
+	utils.RespondWithSuccess(c, gin.H{
+		"proposals": timelines,
+		"total":     total,
+	})
+}
+
+// nodeAccountProposals lists the proposals known to the node, for when none are indexed
+func (h *GovernanceHandler) nodeAccountProposals(c *gin.Context, req *models.AccountProposalRequest) {
+	proposals, err := h.blockchainClient.ListProposals(context.Background(), &lindapb.EmptyMessage{})
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get proposals: "+err.Error())
+		return
+	}
+
+	// Filter by proposer address
+	filtered := make([]gin.H, 0)
+	for _, p := range proposals.Proposals {
+		proposerBase58, _ := utils.HexToBase58(string(p.ProposerAddress))
+		if req.Address == "" || proposerBase58 == req.Address {
+			filtered = append(filtered, convertProposalToResponse(p, true))
+		}
+	}
+
+	// Apply pagination
+	start := req.Start
+	end := start + req.Limit
+	if start > len(filtered) {
+		start = len(filtered)
+	}
+	if end > len(filtered) {
+		end = len(filtered)
+	}
+
+	utils.RespondWithSuccess(c, gin.H{
+		"proposals": filtered[start:end],
+		"total":     len(filtered),
+	})
+}
+
+// GetChainParameterHistory handles GET /api/chainparameters/history
+// Returns the chain parameter changes observed at maintenance periods, newest first
+func (h *GovernanceHandler) GetChainParameterHistory(c *gin.Context) {
+	var req models.ChainParameterHistoryRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
+		return
+	}
+
+	// Set defaults
+	if req.Limit <= 0 {
+		req.Limit = 20
+	}
+	if req.Limit > 200 {
+		req.Limit = 200
+	}
+
+	changes, total, err := h.governanceRepo.GetParameterHistory(req.Key, req.Start, req.Limit)
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get chain parameter history: "+err.Error())
+		return
+	}
+
+	utils.RespondWithMeta(c, changes, gin.H{
+		"total": total,
+		"start": req.Start,
+		"limit": req.Limit,
+	})
+}
@@ -0,0 +1,218 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/storage/repository"
+	"github.com/lindaprotocol/grpc-api-gateway/pkg/utils"
+	"gorm.io/gorm"
+)
+
+type NFTHandler struct {
+	nftRepo *repository.NFTRepository
+}
+
+func NewNFTHandler(nftRepo *repository.NFTRepository) *NFTHandler {
+	return &NFTHandler{
+		nftRepo: nftRepo,
+	}
+}
+
+// GetCollections handles GET /api/nft/collections
+// Returns LRC-721 and LRC-1155 collections ordered by transfer count
+func (h *NFTHandler) GetCollections(c *gin.Context) {
+	var req models.NFTCollectionsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
+		return
+	}
+
+	req.Standard = strings.ToUpper(req.Standard)
+	if req.Standard != "" && req.Standard != models.NFTStandardLRC721 && req.Standard != models.NFTStandardLRC1155 {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid standard: "+req.Standard)
+		return
+	}
+
+	// Set defaults
+	if req.Limit <= 0 {
+		req.Limit = 20
+	}
+	if req.Limit > 200 {
+		req.Limit = 200
+	}
+
+	collections, total, err := h.nftRepo.GetCollections(req.Standard, req.Start, req.Limit)
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get collections: "+err.Error())
+		return
+	}
+
+	utils.RespondWithMeta(c, collections, gin.H{
+		"total": total,
+		"start": req.Start,
+		"limit": req.Limit,
+	})
+}
+
+// GetCollection handles GET /api/nft/collections/:contract
+// Returns a single collection
+func (h *NFTHandler) GetCollection(c *gin.Context) {
+	contract := c.Param("contract")
+	if !utils.IsValidBase58Address(contract) {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid contract address")
+		return
+	}
+
+	collection, err := h.nftRepo.GetCollection(contract)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			utils.RespondWithError(c, http.StatusNotFound, "Collection not found")
+			return
+		}
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get collection: "+err.Error())
+		return
+	}
+
+	utils.RespondWithSuccess(c, collection)
+}
+
+// GetToken handles GET /api/nft/collections/:contract/tokens/:token_id
+// Returns a token with its metadata URI
+func (h *NFTHandler) GetToken(c *gin.Context) {
+	contract := c.Param("contract")
+	if !utils.IsValidBase58Address(contract) {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid contract address")
+		return
+	}
+
+	token, err := h.nftRepo.GetToken(contract, c.Param("token_id"))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			utils.RespondWithError(c, http.StatusNotFound, "Token not found")
+			return
+		}
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get token: "+err.Error())
+		return
+	}
+
+	utils.RespondWithSuccess(c, token)
+}
+
+// GetTokenOwners handles GET /api/nft/collections/:contract/tokens/:token_id/owners
+// Returns the holders of a token id, a single owner for LRC-721
+func (h *NFTHandler) GetTokenOwners(c *gin.Context) {
+	contract := c.Param("contract")
+	if !utils.IsValidBase58Address(contract) {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid contract address")
+		return
+	}
+
+	start, limit := nftPagination(c)
+	owners, total, err := h.nftRepo.GetTokenOwners(contract, c.Param("token_id"), start, limit)
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get token owners: "+err.Error())
+		return
+	}
+
+	utils.RespondWithMeta(c, owners, gin.H{
+		"total": total,
+		"start": start,
+		"limit": limit,
+	})
+}
+
+// GetOwnerTokens handles GET /api/nft/owners/:address/tokens
+// Returns the tokens held by an address, optionally filtered by contract
+func (h *NFTHandler) GetOwnerTokens(c *gin.Context) {
+	address := c.Param("address")
+	if !utils.IsValidBase58Address(address) {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid address format")
+		return
+	}
+
+	contract := c.Query("contract")
+	if contract != "" && !utils.IsValidBase58Address(contract) {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid contract address")
+		return
+	}
+
+	start, limit := nftPagination(c)
+	tokens, total, err := h.nftRepo.GetTokensByOwner(address, contract, start, limit)
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get tokens: "+err.Error())
+		return
+	}
+
+	utils.RespondWithMeta(c, tokens, gin.H{
+		"total": total,
+		"start": start,
+		"limit": limit,
+	})
+}
+
+// GetTransfers handles GET /api/nft/transfers
+// Returns NFT transfer history filtered by contract, token id and address
+func (h *NFTHandler) GetTransfers(c *gin.Context) {
+	var req models.NFTTransfersRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
+		return
+	}
+
+	if req.TokenID != "" && req.Contract == "" {
+		utils.RespondWithError(c, http.StatusBadRequest, "Contract address is required when filtering by token_id")
+		return
+	}
+	if req.Contract != "" && !utils.IsValidBase58Address(req.Contract) {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid contract address")
+		return
+	}
+	if req.Address != "" && !utils.IsValidBase58Address(req.Address) {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid address format")
+		return
+	}
+
+	// Set defaults
+	if req.Limit <= 0 {
+		req.Limit = 20
+	}
+	if req.Limit > 200 {
+		req.Limit = 200
+	}
+
+	transfers, total, err := h.nftRepo.GetTransfers(req.Contract, req.TokenID, req.Address, req.Start, req.Limit)
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get transfers: "+err.Error())
+		return
+	}
+
+	utils.RespondWithMeta(c, transfers, gin.H{
+		"total": total,
+		"start": req.Start,
+		"limit": req.Limit,
+	})
+}
+
+// nftPagination reads start and limit, limit defaults to 20 and is capped at 200
+func nftPagination(c *gin.Context) (int, int) {
+	var page struct {
+		Start int `form:"start"`
+		Limit int `form:"limit"`
+	}
+	c.ShouldBindQuery(&page)
+
+	if page.Start < 0 {
+		page.Start = 0
+	}
+	if page.Limit <= 0 {
+		page.Limit = 20
+	}
+	if page.Limit > 200 {
+		page.Limit = 200
+	}
+	return page.Start, page.Limit
+}
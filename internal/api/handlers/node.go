@@ -1,934 +1,1239 @@
-package handlers
-
-import (
-	"context"
-	"net/http"
-	"strconv"
-
-	"github.com/gin-gonic/gin"
-	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
-	"github.com/lindaprotocol/grpc-api-gateway/internal/services/blockchain"
-	"github.com/lindaprotocol/grpc-api-gateway/pkg/lindapb"
-	"github.com/lindaprotocol/grpc-api-gateway/pkg/utils"
-)
-
-type NodeHandler struct {
-	blockchainClient *blockchain.Client
-}
-
-func NewNodeHandler(client *blockchain.Client) *NodeHandler {
-	return &NodeHandler{
-		blockchainClient: client,
-	}
-}
-
-// ==================== Node Methods ====================
-
-// ListNodes handles POST /wallet/listnodes
-func (h *NodeHandler) ListNodes(c *gin.Context) {
-	visible := c.DefaultQuery("visible", "false") == "true"
-
-	nodes, err := h.blockchainClient.ListNodes(context.Background(), &lindapb.EmptyMessage{})
-	if err != nil {
-		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to list nodes: "+err.Error())
-		return
-	}
-
-	var response []gin.H
-	for _, node := range nodes.Nodes {
-		nodeInfo := gin.H{
-			"address": gin.H{
-				"host": string(node.Address.Host),
-				"port": node.Address.Port,
-			},
-		}
-		response = append(response, nodeInfo)
-	}
-
-	utils.RespondWithSuccess(c, gin.H{"nodes": response})
-}
-
-// GetNodeInfo handles POST /wallet/getnodeinfo
-func (h *NodeHandler) GetNodeInfo(c *gin.Context) {
-	info, err := h.blockchainClient.GetNodeInfo(context.Background(), &lindapb.EmptyMessage{})
-	if err != nil {
-		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get node info: "+err.Error())
-		return
-	}
-
-	response := convertNodeInfoToResponse(info)
-	utils.RespondWithSuccess(c, response)
-}
-
-// GetNodeInfoSolidity handles POST /walletsolidity/getnodeinfo
-func (h *NodeHandler) GetNodeInfoSolidity(c *gin.Context) {
-	info, err := h.blockchainClient.GetNodeInfoSolidity(context.Background(), &lindapb.EmptyMessage{})
-	if err != nil {
-		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get node info: "+err.Error())
-		return
-	}
-
-	response := convertNodeInfoToResponse(info)
-	utils.RespondWithSuccess(c, response)
-}
-
-// ==================== Witness & Voting Methods ====================
-
-// ListWitnesses handles POST /wallet/listwitnesses
-func (h *NodeHandler) ListWitnesses(c *gin.Context) {
-	visible := c.DefaultQuery("visible", "false") == "true"
-
-	witnesses, err := h.blockchainClient.ListWitnesses(context.Background(), &lindapb.EmptyMessage{})
-	if err != nil {
-		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to list witnesses: "+err.Error())
-		return
-	}
-
-	var response []gin.H
-	for _, w := range witnesses.Witnesses {
-		witness := gin.H{
-			"address":        string(w.Address),
-			"voteCount":      w.VoteCount,
-			"url":            w.Url,
-			"totalProduced":  w.TotalProduced,
-			"totalMissed":    w.TotalMissed,
-			"latestBlockNum": w.LatestBlockNum,
-			"latestSlotNum":  w.LatestSlotNum,
-			"isJobs":         w.IsJobs,
-		}
-		if visible {
-			base58Addr, _ := utils.HexToBase58(string(w.Address))
-			witness["address"] = base58Addr
-		}
-		response = append(response, witness)
-	}
-
-	utils.RespondWithSuccess(c, gin.H{"witnesses": response})
-}
-
-// ListWitnessesSolidity handles GET /walletsolidity/listwitnesses
-func (h *NodeHandler) ListWitnessesSolidity(c *gin.Context) {
-	visible := c.DefaultQuery("visible", "false") == "true"
-
-	witnesses, err := h.blockchainClient.ListWitnessesSolidity(context.Background(), &lindapb.EmptyMessage{})
-	if err != nil {
-		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to list witnesses: "+err.Error())
-		return
-	}
-
-	var response []gin.H
-	for _, w := range witnesses.Witnesses {
-		witness := gin.H{
-			"address":        string(w.Address),
-			"voteCount":      w.VoteCount,
-			"url":            w.Url,
-			"totalProduced":  w.TotalProduced,
-			"totalMissed":    w.TotalMissed,
-			"latestBlockNum": w.LatestBlockNum,
-			"latestSlotNum":  w.LatestSlotNum,
-			"isJobs":         w.IsJobs,
-		}
-		if visible {
-			base58Addr, _ := utils.HexToBase58(string(w.Address))
-			witness["address"] = base58Addr
-		}
-		response = append(response, witness)
-	}
-
-	utils.RespondWithSuccess(c, gin.H{"witnesses": response})
-}
-
-// CreateWitness handles POST /wallet/createwitness
-func (h *NodeHandler) CreateWitness(c *gin.Context) {
-	var req struct {
-		OwnerAddress string `json:"owner_address" binding:"required"`
-		URL          string `json:"url" binding:"required"`
-		Visible      bool   `json:"visible" default:"false"`
-		PermissionID int32  `json:"permission_id"`
-	}
-
-	if err := c.ShouldBindJSON(&req); err != nil {
-		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
-		return
-	}
-
-	ownerAddress := req.OwnerAddress
-	if req.Visible {
-		hexAddr, _ := utils.Base58ToHex(ownerAddress)
-		ownerAddress = hexAddr
-	}
-
-	tx, err := h.blockchainClient.CreateWitness(context.Background(), &lindapb.WitnessCreateContract{
-		OwnerAddress: []byte(ownerAddress),
-		Url:          []byte(req.URL),
-	})
-	if err != nil {
-		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to create witness: "+err.Error())
-		return
-	}
-
-	response := convertTransactionToResponse(tx, req.Visible)
-	utils.RespondWithSuccess(c, response)
-}
-
-// UpdateWitness handles POST /wallet/updatewitness
-func (h *NodeHandler) UpdateWitness(c *gin.Context) {
-	var req struct {
-		OwnerAddress string `json:"owner_address" binding:"required"`
-		UpdateURL    string `json:"update_url" binding:"required"`
-		Visible      bool   `json:"visible" default:"false"`
-		PermissionID int32  `json:"permission_id"`
-	}
-
-	if err := c.ShouldBindJSON(&req); err != nil {
-		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
-		return
-	}
-
-	ownerAddress := req.OwnerAddress
-	if req.Visible {
-		hexAddr, _ := utils.Base58ToHex(ownerAddress)
-		ownerAddress = hexAddr
-	}
-
-	tx, err := h.blockchainClient.UpdateWitness(context.Background(), &lindapb.WitnessUpdateContract{
-		OwnerAddress: []byte(ownerAddress),
-		UpdateUrl:    []byte(req.UpdateURL),
-	})
-	if err != nil {
-		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to update witness: "+err.Error())
-		return
-	}
-
-	response := convertTransactionToResponse(tx, req.Visible)
-	utils.RespondWithSuccess(c, response)
-}
-
-// VoteWitnessAccount handles POST /wallet/votewitnessaccount
-func (h *NodeHandler) VoteWitnessAccount(c *gin.Context) {
-	var req struct {
-		OwnerAddress string `json:"owner_address" binding:"required"`
-		Votes        []struct {
-			VoteAddress string `json:"vote_address"`
-			VoteCount   int64  `json:"vote_count"`
-		} `json:"votes" binding:"required"`
-		Visible      bool  `json:"visible" default:"false"`
-		PermissionID int32 `json:"permission_id"`
-	}
-
-	if err := c.ShouldBindJSON(&req); err != nil {
-		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
-		return
-	}
-
-	ownerAddress := req.OwnerAddress
-	if req.Visible {
-		hexAddr, _ := utils.Base58ToHex(ownerAddress)
-		ownerAddress = hexAddr
-	}
-
-	votes := make([]*lindapb.VoteWitnessContract_Vote, len(req.Votes))
-	for i, v := range req.Votes {
-		voteAddress := v.VoteAddress
-		if req.Visible {
-			hexAddr, _ := utils.Base58ToHex(voteAddress)
-			voteAddress = hexAddr
-		}
-		votes[i] = &lindapb.VoteWitnessContract_Vote{
-			VoteAddress: []byte(voteAddress),
-			VoteCount:   v.VoteCount,
-		}
-	}
-
-	tx, err := h.blockchainClient.VoteWitnessAccount(context.Background(), &lindapb.VoteWitnessContract{
-		OwnerAddress: []byte(ownerAddress),
-		Votes:        votes,
-	})
-	if err != nil {
-		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to vote: "+err.Error())
-		return
-	}
-
-	response := convertTransactionToResponse(tx, req.Visible)
-	utils.RespondWithSuccess(c, response)
-}
-
-// GetBrokerage handles POST /wallet/getBrokerage
-func (h *NodeHandler) GetBrokerage(c *gin.Context) {
-	var req struct {
-		Address string `json:"address" binding:"required"`
-		Visible bool   `json:"visible" default:"false"`
-	}
-
-	if err := c.ShouldBindJSON(&req); err != nil {
-		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
-		return
-	}
-
-	address := req.Address
-	if req.Visible {
-		hexAddr, _ := utils.Base58ToHex(address)
-		address = hexAddr
-	}
-
-	brokerage, err := h.blockchainClient.GetBrokerage(context.Background(), &lindapb.Account{
-		Address: []byte(address),
-	})
-	if err != nil {
-		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get brokerage: "+err.Error())
-		return
-	}
-
-	utils.RespondWithSuccess(c, gin.H{"brokerage": brokerage.Num})
-}
-
-// GetBrokerageSolidity handles POST /walletsolidity/getBrokerage
-func (h *NodeHandler) GetBrokerageSolidity(c *gin.Context) {
-	var req struct {
-		Address string `json:"address" binding:"required"`
-		Visible bool   `json:"visible" default:"false"`
-	}
-
-	if err := c.ShouldBindJSON(&req); err != nil {
-		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
-		return
-	}
-
-	address := req.Address
-	if req.Visible {
-		hexAddr, _ := utils.Base58ToHex(address)
-		address = hexAddr
-	}
-
-	brokerage, err := h.blockchainClient.GetBrokerageSolidity(context.Background(), &lindapb.Account{
-		Address: []byte(address),
-	})
-	if err != nil {
-		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get brokerage: "+err.Error())
-		return
-	}
-
-	utils.RespondWithSuccess(c, gin.H{"brokerage": brokerage.Num})
-}
-
-// UpdateBrokerage handles POST /wallet/updateBrokerage
-func (h *NodeHandler) UpdateBrokerage(c *gin.Context) {
-	var req struct {
-		OwnerAddress string `json:"owner_address" binding:"required"`
-		Brokerage    int32  `json:"brokerage" binding:"required"`
-		Visible      bool   `json:"visible" default:"false"`
-		PermissionID int32  `json:"permission_id"`
-	}
-
-	if err := c.ShouldBindJSON(&req); err != nil {
-		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
-		return
-	}
-
-	ownerAddress := req.OwnerAddress
-	if req.Visible {
-		hexAddr, _ := utils.Base58ToHex(ownerAddress)
-		ownerAddress = hexAddr
-	}
-
-	tx, err := h.blockchainClient.UpdateBrokerage(context.Background(), &lindapb.UpdateBrokerageContract{
-		OwnerAddress: []byte(ownerAddress),
-		Brokerage:    req.Brokerage,
-	})
-	if err != nil {
-		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to update brokerage: "+err.Error())
-		return
-	}
-
-	response := convertTransactionToResponse(tx, req.Visible)
-	utils.RespondWithSuccess(c, response)
-}
-
-// GetReward handles POST /wallet/getReward
-func (h *NodeHandler) GetReward(c *gin.Context) {
-	var req struct {
-		Address string `json:"address" binding:"required"`
-		Visible bool   `json:"visible" default:"false"`
-	}
-
-	if err := c.ShouldBindJSON(&req); err != nil {
-		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
-		return
-	}
-
-	address := req.Address
-	if req.Visible {
-		hexAddr, _ := utils.Base58ToHex(address)
-		address = hexAddr
-	}
-
-	reward, err := h.blockchainClient.GetReward(context.Background(), &lindapb.Account{
-		Address: []byte(address),
-	})
-	if err != nil {
-		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get reward: "+err.Error())
-		return
-	}
-
-	utils.RespondWithSuccess(c, gin.H{"reward": reward.Num})
-}
-
-// GetRewardSolidity handles POST /walletsolidity/getReward
-func (h *NodeHandler) GetRewardSolidity(c *gin.Context) {
-	var req struct {
-		Address string `json:"address" binding:"required"`
-		Visible bool   `json:"visible" default:"false"`
-	}
-
-	if err := c.ShouldBindJSON(&req); err != nil {
-		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
-		return
-	}
-
-	address := req.Address
-	if req.Visible {
-		hexAddr, _ := utils.Base58ToHex(address)
-		address = hexAddr
-	}
-
-	reward, err := h.blockchainClient.GetRewardSolidity(context.Background(), &lindapb.Account{
-		Address: []byte(address),
-	})
-	if err != nil {
-		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get reward: "+err.Error())
-		return
-	}
-
-	utils.RespondWithSuccess(c, gin.H{"reward": reward.Num})
-}
-
-// GetNextMaintenanceTime handles GET /wallet/getnextmaintenancetime
-func (h *NodeHandler) GetNextMaintenanceTime(c *gin.Context) {
-	time, err := h.blockchainClient.GetNextMaintenanceTime(context.Background(), &lindapb.EmptyMessage{})
-	if err != nil {
-		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get next maintenance time: "+err.Error())
-		return
-	}
-
-	utils.RespondWithSuccess(c, gin.H{"num": time.Num})
-}
-
-// GetPaginatedNowWitnessList handles POST /wallet/getpaginatednowwitnesslist
-func (h *NodeHandler) GetPaginatedNowWitnessList(c *gin.Context) {
-	var req struct {
-		Offset  int64 `json:"offset"`
-		Limit   int64 `json:"limit" binding:"required"`
-		Visible bool  `json:"visible" default:"false"`
-	}
-
-	if err := c.ShouldBindJSON(&req); err != nil {
-		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
-		return
-	}
-
-	witnesses, err := h.blockchainClient.GetPaginatedNowWitnessList(context.Background(), &lindapb.PaginatedMessage{
-		Offset: req.Offset,
-		Limit:  req.Limit,
-	})
-	if err != nil {
-		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get paginated witness list: "+err.Error())
-		return
-	}
-
-	var response []gin.H
-	for _, w := range witnesses.Witnesses {
-		witness := gin.H{
-			"address":        string(w.Address),
-			"voteCount":      w.VoteCount,
-			"url":            w.Url,
-			"totalProduced":  w.TotalProduced,
-			"totalMissed":    w.TotalMissed,
-			"latestBlockNum": w.LatestBlockNum,
-			"latestSlotNum":  w.LatestSlotNum,
-			"isJobs":         w.IsJobs,
-		}
-		if req.Visible {
-			base58Addr, _ := utils.HexToBase58(string(w.Address))
-			witness["address"] = base58Addr
-		}
-		response = append(response, witness)
-	}
-
-	utils.RespondWithSuccess(c, gin.H{"witnesses": response})
-}
-
-// GetPaginatedNowWitnessListSolidity handles GET /soliditywallet/getpaginatednowwitnesslist
-func (h *NodeHandler) GetPaginatedNowWitnessListSolidity(c *gin.Context) {
-	offset, _ := strconv.ParseInt(c.DefaultQuery("offset", "0"), 10, 64)
-	limit, _ := strconv.ParseInt(c.DefaultQuery("limit", "20"), 10, 64)
-	visible := c.DefaultQuery("visible", "false") == "true"
-
-	if limit <= 0 || limit > 1000 {
-		limit = 20
-	}
-
-	witnesses, err := h.blockchainClient.GetPaginatedNowWitnessListSolidity(context.Background(), &lindapb.PaginatedMessage{
-		Offset: offset,
-		Limit:  limit,
-	})
-	if err != nil {
-		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get paginated witness list: "+err.Error())
-		return
-	}
-
-	var response []gin.H
-	for _, w := range witnesses.Witnesses {
-		witness := gin.H{
-			"address":        string(w.Address),
-			"voteCount":      w.VoteCount,
-			"url":            w.Url,
-			"totalProduced":  w.TotalProduced,
-			"totalMissed":    w.TotalMissed,
-			"latestBlockNum": w.LatestBlockNum,
-			"latestSlotNum":  w.LatestSlotNum,
-			"isJobs":         w.IsJobs,
-		}
-		if visible {
-			base58Addr, _ := utils.HexToBase58(string(w.Address))
-			witness["address"] = base58Addr
-		}
-		response = append(response, witness)
-	}
-
-	utils.RespondWithSuccess(c, gin.H{"witnesses": response})
-}
-
-// ==================== Proposal Methods ====================
-
-// ListProposals handles GET /wallet/listproposals
-func (h *NodeHandler) ListProposals(c *gin.Context) {
-	visible := c.DefaultQuery("visible", "false") == "true"
-
-	proposals, err := h.blockchainClient.ListProposals(context.Background(), &lindapb.EmptyMessage{})
-	if err != nil {
-		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to list proposals: "+err.Error())
-		return
-	}
-
-	var response []gin.H
-	for _, p := range proposals.Proposals {
-		response = append(response, convertProposalToResponse(p, visible))
-	}
-
-	utils.RespondWithSuccess(c, gin.H{"proposals": response})
-}
-
-// GetProposalById handles POST /wallet/getproposalbyid
-func (h *NodeHandler) GetProposalById(c *gin.Context) {
-	var req struct {
-		ID      int64 `json:"id" binding:"required"`
-		Visible bool  `json:"visible" default:"false"`
-	}
-
-	if err := c.ShouldBindJSON(&req); err != nil {
-		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
-		return
-	}
-
-	proposal, err := h.blockchainClient.GetProposalById(context.Background(), &lindapb.NumberMessage{
-		Num: req.ID,
-	})
-	if err != nil {
-		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get proposal: "+err.Error())
-		return
-	}
-
-	response := convertProposalToResponse(proposal, req.Visible)
-	utils.RespondWithSuccess(c, response)
-}
-
-// ProposalCreate handles POST /wallet/proposalcreate
-func (h *NodeHandler) ProposalCreate(c *gin.Context) {
-	var req struct {
-		OwnerAddress string           `json:"owner_address" binding:"required"`
-		Parameters   map[int64]int64 `json:"parameters" binding:"required"`
-		Visible      bool             `json:"visible" default:"false"`
-		PermissionID int32            `json:"permission_id"`
-	}
-
-	if err := c.ShouldBindJSON(&req); err != nil {
-		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
-		return
-	}
-
-	ownerAddress := req.OwnerAddress
-	if req.Visible {
-		hexAddr, _ := utils.Base58ToHex(ownerAddress)
-		ownerAddress = hexAddr
-	}
-
-	tx, err := h.blockchainClient.ProposalCreate(context.Background(), &lindapb.ProposalCreateContract{
-		OwnerAddress: []byte(ownerAddress),
-		Parameters:   req.Parameters,
-	})
-	if err != nil {
-		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to create proposal: "+err.Error())
-		return
-	}
-
-	response := convertTransactionToResponse(tx, req.Visible)
-	utils.RespondWithSuccess(c, response)
-}
-
-// ProposalApprove handles POST /wallet/proposalapprove
-func (h *NodeHandler) ProposalApprove(c *gin.Context) {
-	var req struct {
-		OwnerAddress string `json:"owner_address" binding:"required"`
-		ProposalID   int64  `json:"proposal_id" binding:"required"`
-		IsAddApproval bool  `json:"is_add_approval" binding:"required"`
-		Visible      bool   `json:"visible" default:"false"`
-		PermissionID int32  `json:"permission_id"`
-	}
-
-	if err := c.ShouldBindJSON(&req); err != nil {
-		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
-		return
-	}
-
-	ownerAddress := req.OwnerAddress
-	if req.Visible {
-		hexAddr, _ := utils.Base58ToHex(ownerAddress)
-		ownerAddress = hexAddr
-	}
-
-	tx, err := h.blockchainClient.ProposalApprove(context.Background(), &lindapb.ProposalApproveContract{
-		OwnerAddress:  []byte(ownerAddress),
-		ProposalId:    req.ProposalID,
-		IsAddApproval: req.IsAddApproval,
-	})
-	if err != nil {
-		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to approve proposal: "+err.Error())
-		return
-	}
-
-	response := convertTransactionToResponse(tx, req.Visible)
-	utils.RespondWithSuccess(c, response)
-}
-
-// ProposalDelete handles POST /wallet/proposaldelete
-func (h *NodeHandler) ProposalDelete(c *gin.Context) {
-	var req struct {
-		OwnerAddress string `json:"owner_address" binding:"required"`
-		ProposalID   int64  `json:"proposal_id" binding:"required"`
-		Visible      bool   `json:"visible" default:"false"`
-		PermissionID int32  `json:"permission_id"`
-	}
-
-	if err := c.ShouldBindJSON(&req); err != nil {
-		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
-		return
-	}
-
-	ownerAddress := req.OwnerAddress
-	if req.Visible {
-		hexAddr, _ := utils.Base58ToHex(ownerAddress)
-		ownerAddress = hexAddr
-	}
-
-	tx, err := h.blockchainClient.ProposalDelete(context.Background(), &lindapb.ProposalDeleteContract{
-		OwnerAddress: []byte(ownerAddress),
-		ProposalId:   req.ProposalID,
-	})
-	if err != nil {
-		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to delete proposal: "+err.Error())
-		return
-	}
-
-	response := convertTransactionToResponse(tx, req.Visible)
-	utils.RespondWithSuccess(c, response)
-}
-
-// GetPaginatedProposalList handles POST /wallet/getpaginatedproposallist
-func (h *NodeHandler) GetPaginatedProposalList(c *gin.Context) {
-	var req struct {
-		Offset  int64 `json:"offset"`
-		Limit   int64 `json:"limit" binding:"required"`
-		Visible bool  `json:"visible" default:"false"`
-	}
-
-	if err := c.ShouldBindJSON(&req); err != nil {
-		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
-		return
-	}
-
-	proposals, err := h.blockchainClient.GetPaginatedProposalList(context.Background(), &lindapb.PaginatedMessage{
-		Offset: req.Offset,
-		Limit:  req.Limit,
-	})
-	if err != nil {
-		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get paginated proposals: "+err.Error())
-		return
-	}
-
-	var response []gin.H
-	for _, p := range proposals.Proposals {
-		response = append(response, convertProposalToResponse(p, req.Visible))
-	}
-
-	utils.RespondWithSuccess(c, gin.H{"proposals": response})
-}
-
-// ==================== Chain Info Methods ====================
-
-// GetChainParameters handles GET /wallet/getchainparameters
-func (h *NodeHandler) GetChainParameters(c *gin.Context) {
-	params, err := h.blockchainClient.GetChainParameters(context.Background(), &lindapb.EmptyMessage{})
-	if err != nil {
-		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get chain parameters: "+err.Error())
-		return
-	}
-
-	var response []gin.H
-	for _, p := range params.ChainParameter {
-		response = append(response, gin.H{
-			"key":   p.Key,
-			"value": p.Value,
-		})
-	}
-
-	utils.RespondWithSuccess(c, gin.H{"chainParameter": response})
-}
-
-// GetChainParametersV2 handles GET /api/chainparameters
-func (h *NodeHandler) GetChainParametersV2(c *gin.Context) {
-	params, err := h.blockchainClient.GetChainParameters(context.Background(), &lindapb.EmptyMessage{})
-	if err != nil {
-		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get chain parameters: "+err.Error())
-		return
-	}
-
-	utils.RespondWithSuccess(c, params.ChainParameter)
-}
-
-// TotalTransaction handles GET /wallet/totaltransaction
-func (h *NodeHandler) TotalTransaction(c *gin.Context) {
-	total, err := h.blockchainClient.TotalTransaction(context.Background(), &lindapb.EmptyMessage{})
-	if err != nil {
-		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get total transactions: "+err.Error())
-		return
-	}
-
-	utils.RespondWithSuccess(c, gin.H{"num": total.Num})
-}
-
-// GetBurnLind handles GET /wallet/getburnlind
-func (h *NodeHandler) GetBurnLind(c *gin.Context) {
-	burn, err := h.blockchainClient.GetBurnLind(context.Background(), &lindapb.EmptyMessage{})
-	if err != nil {
-		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get burn LIND: "+err.Error())
-		return
-	}
-
-	utils.RespondWithSuccess(c, gin.H{"burnLindAmount": burn.Num})
-}
-
-// GetBurnLindSolidity handles GET /walletsolidity/getburnlind
-func (h *NodeHandler) GetBurnLindSolidity(c *gin.Context) {
-	burn, err := h.blockchainClient.GetBurnLindSolidity(context.Background(), &lindapb.EmptyMessage{})
-	if err != nil {
-		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get burn LIND: "+err.Error())
-		return
-	}
-
-	utils.RespondWithSuccess(c, gin.H{"burnLindAmount": burn.Num})
-}
-
-// GetEnergyPrices handles GET /wallet/getenergyprices
-func (h *NodeHandler) GetEnergyPrices(c *gin.Context) {
-	prices, err := h.blockchainClient.GetEnergyPrices(context.Background(), &lindapb.EmptyMessage{})
-	if err != nil {
-		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get energy prices: "+err.Error())
-		return
-	}
-
-	utils.RespondWithSuccess(c, gin.H{"prices": string(prices.Prices)})
-}
-
-// GetBandwidthPrices handles GET /wallet/getbandwidthprices
-func (h *NodeHandler) GetBandwidthPrices(c *gin.Context) {
-	prices, err := h.blockchainClient.GetBandwidthPrices(context.Background(), &lindapb.EmptyMessage{})
-	if err != nil {
-		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get bandwidth prices: "+err.Error())
-		return
-	}
-
-	utils.RespondWithSuccess(c, gin.H{"prices": string(prices.Prices)})
-}
-
-// GetMemoFeePrices handles GET /wallet/getmemofee
-func (h *NodeHandler) GetMemoFeePrices(c *gin.Context) {
-	prices, err := h.blockchainClient.GetMemoFeePrices(context.Background(), &lindapb.EmptyMessage{})
-	if err != nil {
-		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get memo fee prices: "+err.Error())
-		return
-	}
-
-	utils.RespondWithSuccess(c, gin.H{"prices": string(prices.Prices)})
-}
-
-// ==================== Metrics Methods ====================
-
-// GetStatsInfo handles GET /monitor/getstatsinfo
-func (h *NodeHandler) GetStatsInfo(c *gin.Context) {
-	stats, err := h.blockchainClient.GetStatsInfo(context.Background(), &lindapb.EmptyMessage{})
-	if err != nil {
-		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get stats info: "+err.Error())
-		return
-	}
-
-	utils.RespondWithSuccess(c, stats)
-}
-
-// ==================== Vote Info (Lindascan) ====================
-
-// GetVoteInfo handles GET /api/vote
-func (h *NodeHandler) GetVoteInfo(c *gin.Context) {
-	// Get witnesses with their vote counts
-	witnesses, err := h.blockchainClient.ListWitnesses(context.Background(), &lindapb.EmptyMessage{})
-	if err != nil {
-		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get vote info: "+err.Error())
-		return
-	}
-
-	var response []gin.H
-	for _, w := range witnesses.Witnesses {
-		response = append(response, gin.H{
-			"address":   utils.MustHexToBase58(string(w.Address)),
-			"voteCount": w.VoteCount,
-			"url":       w.Url,
-		})
-	}
-
-	utils.RespondWithSuccess(c, response)
-}
-
-// ==================== Node Map (Lindascan) ====================
-
-// GetNodeMap handles GET /api/nodemap
-func (h *NodeHandler) GetNodeMap(c *gin.Context) {
-	nodes, err := h.blockchainClient.ListNodes(context.Background(), &lindapb.EmptyMessage{})
-	if err != nil {
-		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get node map: "+err.Error())
-		return
-	}
-
-	var response []gin.H
-	for _, node := range nodes.Nodes {
-		// In production, you'd use IP geolocation service
-		response = append(response, gin.H{
-			"ip":        string(node.Address.Host),
-			"host":      string(node.Address.Host),
-			"port":      node.Address.Port,
-			"country":   "Unknown",
-			"city":      "Unknown",
-			"latitude":  0,
-			"longitude": 0,
-			"nodeType":  "FullNode",
-		})
-	}
-
-	utils.RespondWithSuccess(c, gin.H{"nodes": response})
-}
-
-// ==================== Node Upload (V2) ====================
-
-// UploadNodeOverview handles POST /api/v2/node/overview_upload
-func (h *NodeHandler) UploadNodeOverview(c *gin.Context) {
-	var req struct {
-		Address string `json:"address" binding:"required"`
-	}
-
-	if err := c.ShouldBindJSON(&req); err != nil {
-		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
-		return
-	}
-
-	// Process upload (would store in database)
-	utils.RespondWithSuccess(c, gin.H{
-		"uptime":      3600,
-		"block_height": 1000000,
-		"peers":       25,
-		"version":     "4.5.0",
-	})
-}
-
-// UploadNodeInfo handles POST /api/v2/node/info_upload
-func (h *NodeHandler) UploadNodeInfo(c *gin.Context) {
-	var req struct {
-		Address     string `json:"address" binding:"required"`
-		Version     string `json:"version"`
-		Location    string `json:"location"`
-		BlockHeight int64  `json:"block_height"`
-		Peers       int    `json:"peers"`
-	}
-
-	if err := c.ShouldBindJSON(&req); err != nil {
-		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
-		return
-	}
-
-	// Process upload (would store in database)
-	utils.RespondWithSuccess(c, gin.H{
-		"success": true,
-		"message": "Node info uploaded successfully",
-	})
-}
-
-// ==================== Helper Functions ====================
-
-func convertNodeInfoToResponse(info *lindapb.NodeInfo) gin.H {
-	return gin.H{
-		"beginSyncNum":        info.BeginSyncNum,
-		"block":               info.Block,
-		"solidityBlock":       info.SolidityBlock,
-		"currentConnectCount": info.CurrentConnectCount,
-		"activeConnectCount":  info.ActiveConnectCount,
-		"passiveConnectCount": info.PassiveConnectCount,
-		"totalFlow":           info.TotalFlow,
-		"peerInfoList":        info.PeerInfoList,
-		"configNodeInfo":      info.ConfigNodeInfo,
-		"machineInfo":         info.MachineInfo,
-		"cheatWitnessInfoMap": info.CheatWitnessInfoMap,
-	}
-}
-
-func convertProposalToResponse(p *lindapb.Proposal, visible bool) gin.H {
-	state := "PENDING"
-	switch p.State {
-	case 1:
-		state = "DISAPPROVED"
-	case 2:
-		state = "APPROVED"
-	case 3:
-		state = "CANCELED"
-	}
-
-	resp := gin.H{
-		"proposal_id":      p.ProposalId,
-		"proposer_address": string(p.ProposerAddress),
-		"parameters":       p.Parameters,
-		"expiration_time":  p.ExpirationTime,
-		"create_time":      p.CreateTime,
-		"approvals":        p.Approvals,
-		"state":            state,
-	}
-
-	if visible {
-		base58Addr, _ := utils.HexToBase58(string(p.ProposerAddress))
-		resp["proposer_address"] = base58Addr
-
-		approvals := make([]string, len(p.Approvals))
-		for i, addr := range p.Approvals {
-			base58Addr, _ := utils.HexToBase58(addr)
-			approvals[i] = base58Addr
-		}
-		resp["approvals"] = approvals
-	}
-
-	return resp
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/blockchain"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/geoip"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/storage/repository"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/telemetry"
+	"github.com/lindaprotocol/grpc-api-gateway/pkg/lindapb"
+	"github.com/lindaprotocol/grpc-api-gateway/pkg/utils"
+)
+
+// nodeReportWindow is how recently a node must have uploaded its info to appear on the node map
+// and in the version distribution
+const nodeReportWindow = 24 * time.Hour
+
+// laggingReportWindow is how recently a node must have uploaded its info to be checked for lag
+const laggingReportWindow = time.Hour
+
+// defaultLagThreshold is the number of blocks behind the chain head at which a node is lagging
+const defaultLagThreshold = 20
+
+type NodeHandler struct {
+	blockchainClient *blockchain.Client
+	nodeRepo         *repository.NodeRepository
+	geoResolver      *geoip.Resolver
+	telemetryAuth    *telemetry.Authenticator
+}
+
+func NewNodeHandler(client *blockchain.Client, nodeRepo *repository.NodeRepository, geoResolver *geoip.Resolver, telemetryAuth *telemetry.Authenticator) *NodeHandler {
+	return &NodeHandler{
+		blockchainClient: client,
+		nodeRepo:         nodeRepo,
+		geoResolver:      geoResolver,
+		telemetryAuth:    telemetryAuth,
+	}
+}
+
+// ==================== Node Methods ====================
+
+// ListNodes handles POST /wallet/listnodes
+func (h *NodeHandler) ListNodes(c *gin.Context) {
+	visible := c.DefaultQuery("visible", "false") == "true"
+
+	nodes, err := h.blockchainClient.ListNodes(context.Background(), &lindapb.EmptyMessage{})
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to list nodes: "+err.Error())
+		return
+	}
+
+	var response []gin.H
+	for _, node := range nodes.Nodes {
+		nodeInfo := gin.H{
+			"address": gin.H{
+				"host": string(node.Address.Host),
+				"port": node.Address.Port,
+			},
+		}
+		response = append(response, nodeInfo)
+	}
+
+	utils.RespondWithSuccess(c, gin.H{"nodes": response})
+}
+
+// GetNodeInfo handles POST /wallet/getnodeinfo
+func (h *NodeHandler) GetNodeInfo(c *gin.Context) {
+	info, err := h.blockchainClient.GetNodeInfo(context.Background(), &lindapb.EmptyMessage{})
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get node info: "+err.Error())
+		return
+	}
+
+	response := convertNodeInfoToResponse(info)
+	utils.RespondWithSuccess(c, response)
+}
+
+// GetNodeInfoSolidity handles POST /walletsolidity/getnodeinfo
+func (h *NodeHandler) GetNodeInfoSolidity(c *gin.Context) {
+	info, err := h.blockchainClient.GetNodeInfoSolidity(context.Background(), &lindapb.EmptyMessage{})
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get node info: "+err.Error())
+		return
+	}
+
+	response := convertNodeInfoToResponse(info)
+	utils.RespondWithSuccess(c, response)
+}
+
+// ==================== Witness & Voting Methods ====================
+
+// ListWitnesses handles POST /wallet/listwitnesses
+func (h *NodeHandler) ListWitnesses(c *gin.Context) {
+	visible := c.DefaultQuery("visible", "false") == "true"
+
+	witnesses, err := h.blockchainClient.ListWitnesses(context.Background(), &lindapb.EmptyMessage{})
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to list witnesses: "+err.Error())
+		return
+	}
+
+	var response []gin.H
+	for _, w := range witnesses.Witnesses {
+		witness := gin.H{
+			"address":        string(w.Address),
+			"voteCount":      w.VoteCount,
+			"url":            w.Url,
+			"totalProduced":  w.TotalProduced,
+			"totalMissed":    w.TotalMissed,
+			"latestBlockNum": w.LatestBlockNum,
+			"latestSlotNum":  w.LatestSlotNum,
+			"isJobs":         w.IsJobs,
+		}
+		if visible {
+			base58Addr, _ := utils.HexToBase58(string(w.Address))
+			witness["address"] = base58Addr
+		}
+		response = append(response, witness)
+	}
+
+	utils.RespondWithSuccess(c, gin.H{"witnesses": response})
+}
+
+// ListWitnessesSolidity handles GET /walletsolidity/listwitnesses
+func (h *NodeHandler) ListWitnessesSolidity(c *gin.Context) {
+	visible := c.DefaultQuery("visible", "false") == "true"
+
+	witnesses, err := h.blockchainClient.ListWitnessesSolidity(context.Background(), &lindapb.EmptyMessage{})
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to list witnesses: "+err.Error())
+		return
+	}
+
+	var response []gin.H
+	for _, w := range witnesses.Witnesses {
+		witness := gin.H{
+			"address":        string(w.Address),
+			"voteCount":      w.VoteCount,
+			"url":            w.Url,
+			"totalProduced":  w.TotalProduced,
+			"totalMissed":    w.TotalMissed,
+			"latestBlockNum": w.LatestBlockNum,
+			"latestSlotNum":  w.LatestSlotNum,
+			"isJobs":         w.IsJobs,
+		}
+		if visible {
+			base58Addr, _ := utils.HexToBase58(string(w.Address))
+			witness["address"] = base58Addr
+		}
+		response = append(response, witness)
+	}
+
+	utils.RespondWithSuccess(c, gin.H{"witnesses": response})
+}
+
+// CreateWitness handles POST /wallet/createwitness
+func (h *NodeHandler) CreateWitness(c *gin.Context) {
+	var req struct {
+		OwnerAddress string `json:"owner_address" binding:"required"`
+		URL          string `json:"url" binding:"required"`
+		Visible      bool   `json:"visible" default:"false"`
+		PermissionID int32  `json:"permission_id"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
+		return
+	}
+
+	ownerAddress := req.OwnerAddress
+	if req.Visible {
+		hexAddr, _ := utils.Base58ToHex(ownerAddress)
+		ownerAddress = hexAddr
+	}
+
+	tx, err := h.blockchainClient.CreateWitness(context.Background(), &lindapb.WitnessCreateContract{
+		OwnerAddress: []byte(ownerAddress),
+		Url:          []byte(req.URL),
+	})
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to create witness: "+err.Error())
+		return
+	}
+
+	response := convertTransactionToResponse(tx, req.Visible)
+	utils.RespondWithSuccess(c, response)
+}
+
+// UpdateWitness handles POST /wallet/updatewitness
+func (h *NodeHandler) UpdateWitness(c *gin.Context) {
+	var req struct {
+		OwnerAddress string `json:"owner_address" binding:"required"`
+		UpdateURL    string `json:"update_url" binding:"required"`
+		Visible      bool   `json:"visible" default:"false"`
+		PermissionID int32  `json:"permission_id"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
+		return
+	}
+
+	ownerAddress := req.OwnerAddress
+	if req.Visible {
+		hexAddr, _ := utils.Base58ToHex(ownerAddress)
+		ownerAddress = hexAddr
+	}
+
+	tx, err := h.blockchainClient.UpdateWitness(context.Background(), &lindapb.WitnessUpdateContract{
+		OwnerAddress: []byte(ownerAddress),
+		UpdateUrl:    []byte(req.UpdateURL),
+	})
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to update witness: "+err.Error())
+		return
+	}
+
+	response := convertTransactionToResponse(tx, req.Visible)
+	utils.RespondWithSuccess(c, response)
+}
+
+// VoteWitnessAccount handles POST /wallet/votewitnessaccount
+func (h *NodeHandler) VoteWitnessAccount(c *gin.Context) {
+	var req struct {
+		OwnerAddress string `json:"owner_address" binding:"required"`
+		Votes        []struct {
+			VoteAddress string `json:"vote_address"`
+			VoteCount   int64  `json:"vote_count"`
+		} `json:"votes" binding:"required"`
+		Visible      bool  `json:"visible" default:"false"`
+		PermissionID int32 `json:"permission_id"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
+		return
+	}
+
+	ownerAddress := req.OwnerAddress
+	if req.Visible {
+		hexAddr, _ := utils.Base58ToHex(ownerAddress)
+		ownerAddress = hexAddr
+	}
+
+	votes := make([]*lindapb.VoteWitnessContract_Vote, len(req.Votes))
+	for i, v := range req.Votes {
+		voteAddress := v.VoteAddress
+		if req.Visible {
+			hexAddr, _ := utils.Base58ToHex(voteAddress)
+			voteAddress = hexAddr
+		}
+		votes[i] = &lindapb.VoteWitnessContract_Vote{
+			VoteAddress: []byte(voteAddress),
+			VoteCount:   v.VoteCount,
+		}
+	}
+
+	tx, err := h.blockchainClient.VoteWitnessAccount(context.Background(), &lindapb.VoteWitnessContract{
+		OwnerAddress: []byte(ownerAddress),
+		Votes:        votes,
+	})
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to vote: "+err.Error())
+		return
+	}
+
+	response := convertTransactionToResponse(tx, req.Visible)
+	utils.RespondWithSuccess(c, response)
+}
+
+// GetBrokerage handles POST /wallet/getBrokerage
+func (h *NodeHandler) GetBrokerage(c *gin.Context) {
+	var req struct {
+		Address string `json:"address" binding:"required"`
+		Visible bool   `json:"visible" default:"false"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
+		return
+	}
+
+	address := req.Address
+	if req.Visible {
+		hexAddr, _ := utils.Base58ToHex(address)
+		address = hexAddr
+	}
+
+	brokerage, err := h.blockchainClient.GetBrokerage(context.Background(), &lindapb.Account{
+		Address: []byte(address),
+	})
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get brokerage: "+err.Error())
+		return
+	}
+
+	utils.RespondWithSuccess(c, gin.H{"brokerage": brokerage.Num})
+}
+
+// GetBrokerageSolidity handles POST /walletsolidity/getBrokerage
+func (h *NodeHandler) GetBrokerageSolidity(c *gin.Context) {
+	var req struct {
+		Address string `json:"address" binding:"required"`
+		Visible bool   `json:"visible" default:"false"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
+		return
+	}
+
+	address := req.Address
+	if req.Visible {
+		hexAddr, _ := utils.Base58ToHex(address)
+		address = hexAddr
+	}
+
+	brokerage, err := h.blockchainClient.GetBrokerageSolidity(context.Background(), &lindapb.Account{
+		Address: []byte(address),
+	})
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get brokerage: "+err.Error())
+		return
+	}
+
+	utils.RespondWithSuccess(c, gin.H{"brokerage": brokerage.Num})
+}
+
+// UpdateBrokerage handles POST /wallet/updateBrokerage
+func (h *NodeHandler) UpdateBrokerage(c *gin.Context) {
+	var req struct {
+		OwnerAddress string `json:"owner_address" binding:"required"`
+		Brokerage    int32  `json:"brokerage" binding:"required"`
+		Visible      bool   `json:"visible" default:"false"`
+		PermissionID int32  `json:"permission_id"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
+		return
+	}
+
+	ownerAddress := req.OwnerAddress
+	if req.Visible {
+		hexAddr, _ := utils.Base58ToHex(ownerAddress)
+		ownerAddress = hexAddr
+	}
+
+	tx, err := h.blockchainClient.UpdateBrokerage(context.Background(), &lindapb.UpdateBrokerageContract{
+		OwnerAddress: []byte(ownerAddress),
+		Brokerage:    req.Brokerage,
+	})
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to update brokerage: "+err.Error())
+		return
+	}
+
+	response := convertTransactionToResponse(tx, req.Visible)
+	utils.RespondWithSuccess(c, response)
+}
+
+// GetReward handles POST /wallet/getReward
+func (h *NodeHandler) GetReward(c *gin.Context) {
+	var req struct {
+		Address string `json:"address" binding:"required"`
+		Visible bool   `json:"visible" default:"false"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
+		return
+	}
+
+	address := req.Address
+	if req.Visible {
+		hexAddr, _ := utils.Base58ToHex(address)
+		address = hexAddr
+	}
+
+	reward, err := h.blockchainClient.GetReward(context.Background(), &lindapb.Account{
+		Address: []byte(address),
+	})
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get reward: "+err.Error())
+		return
+	}
+
+	utils.RespondWithSuccess(c, gin.H{"reward": reward.Num})
+}
+
+// GetRewardSolidity handles POST /walletsolidity/getReward
+func (h *NodeHandler) GetRewardSolidity(c *gin.Context) {
+	var req struct {
+		Address string `json:"address" binding:"required"`
+		Visible bool   `json:"visible" default:"false"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
+		return
+	}
+
+	address := req.Address
+	if req.Visible {
+		hexAddr, _ := utils.Base58ToHex(address)
+		address = hexAddr
+	}
+
+	reward, err := h.blockchainClient.GetRewardSolidity(context.Background(), &lindapb.Account{
+		Address: []byte(address),
+	})
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get reward: "+err.Error())
+		return
+	}
+
+	utils.RespondWithSuccess(c, gin.H{"reward": reward.Num})
+}
+
+// GetNextMaintenanceTime handles GET /wallet/getnextmaintenancetime
+func (h *NodeHandler) GetNextMaintenanceTime(c *gin.Context) {
+	time, err := h.blockchainClient.GetNextMaintenanceTime(context.Background(), &lindapb.EmptyMessage{})
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get next maintenance time: "+err.Error())
+		return
+	}
+
+	utils.RespondWithSuccess(c, gin.H{"num": time.Num})
+}
+
+// GetPaginatedNowWitnessList handles POST /wallet/getpaginatednowwitnesslist
+func (h *NodeHandler) GetPaginatedNowWitnessList(c *gin.Context) {
+	var req struct {
+		Offset  int64 `json:"offset"`
+		Limit   int64 `json:"limit" binding:"required"`
+		Visible bool  `json:"visible" default:"false"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
+		return
+	}
+
+	witnesses, err := h.blockchainClient.GetPaginatedNowWitnessList(context.Background(), &lindapb.PaginatedMessage{
+		Offset: req.Offset,
+		Limit:  req.Limit,
+	})
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get paginated witness list: "+err.Error())
+		return
+	}
+
+	var response []gin.H
+	for _, w := range witnesses.Witnesses {
+		witness := gin.H{
+			"address":        string(w.Address),
+			"voteCount":      w.VoteCount,
+			"url":            w.Url,
+			"totalProduced":  w.TotalProduced,
+			"totalMissed":    w.TotalMissed,
+			"latestBlockNum": w.LatestBlockNum,
+			"latestSlotNum":  w.LatestSlotNum,
+			"isJobs":         w.IsJobs,
+		}
+		if req.Visible {
+			base58Addr, _ := utils.HexToBase58(string(w.Address))
+			witness["address"] = base58Addr
+		}
+		response = append(response, witness)
+	}
+
+	utils.RespondWithSuccess(c, gin.H{"witnesses": response})
+}
+
+// GetPaginatedNowWitnessListSolidity handles GET /soliditywallet/getpaginatednowwitnesslist
+func (h *NodeHandler) GetPaginatedNowWitnessListSolidity(c *gin.Context) {
+	offset, _ := strconv.ParseInt(c.DefaultQuery("offset", "0"), 10, 64)
+	limit, _ := strconv.ParseInt(c.DefaultQuery("limit", "20"), 10, 64)
+	visible := c.DefaultQuery("visible", "false") == "true"
+
+	if limit <= 0 || limit > 1000 {
+		limit = 20
+	}
+
+	witnesses, err := h.blockchainClient.GetPaginatedNowWitnessListSolidity(context.Background(), &lindapb.PaginatedMessage{
+		Offset: offset,
+		Limit:  limit,
+	})
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get paginated witness list: "+err.Error())
+		return
+	}
+
+	var response []gin.H
+	for _, w := range witnesses.Witnesses {
+		witness := gin.H{
+			"address":        string(w.Address),
+			"voteCount":      w.VoteCount,
+			"url":            w.Url,
+			"totalProduced":  w.TotalProduced,
+			"totalMissed":    w.TotalMissed,
+			"latestBlockNum": w.LatestBlockNum,
+			"latestSlotNum":  w.LatestSlotNum,
+			"isJobs":         w.IsJobs,
+		}
+		if visible {
+			base58Addr, _ := utils.HexToBase58(string(w.Address))
+			witness["address"] = base58Addr
+		}
+		response = append(response, witness)
+	}
+
+	utils.RespondWithSuccess(c, gin.H{"witnesses": response})
+}
+
+// ==================== Proposal Methods ====================
+
+// ListProposals handles GET /wallet/listproposals
+func (h *NodeHandler) ListProposals(c *gin.Context) {
+	visible := c.DefaultQuery("visible", "false") == "true"
+
+	proposals, err := h.blockchainClient.ListProposals(context.Background(), &lindapb.EmptyMessage{})
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to list proposals: "+err.Error())
+		return
+	}
+
+	var response []gin.H
+	for _, p := range proposals.Proposals {
+		response = append(response, convertProposalToResponse(p, visible))
+	}
+
+	utils.RespondWithSuccess(c, gin.H{"proposals": response})
+}
+
+// GetProposalById handles POST /wallet/getproposalbyid
+func (h *NodeHandler) GetProposalById(c *gin.Context) {
+	var req struct {
+		ID      int64 `json:"id" binding:"required"`
+		Visible bool  `json:"visible" default:"false"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
+		return
+	}
+
+	proposal, err := h.blockchainClient.GetProposalById(context.Background(), &lindapb.NumberMessage{
+		Num: req.ID,
+	})
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get proposal: "+err.Error())
+		return
+	}
+
+	response := convertProposalToResponse(proposal, req.Visible)
+	utils.RespondWithSuccess(c, response)
+}
+
+// ProposalCreate handles POST /wallet/proposalcreate
+func (h *NodeHandler) ProposalCreate(c *gin.Context) {
+	var req struct {
+		OwnerAddress string           `json:"owner_address" binding:"required"`
+		Parameters   map[int64]int64 `json:"parameters" binding:"required"`
+		Visible      bool             `json:"visible" default:"false"`
+		PermissionID int32            `json:"permission_id"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
+		return
+	}
+
+	ownerAddress := req.OwnerAddress
+	if req.Visible {
+		hexAddr, _ := utils.Base58ToHex(ownerAddress)
+		ownerAddress = hexAddr
+	}
+
+	tx, err := h.blockchainClient.ProposalCreate(context.Background(), &lindapb.ProposalCreateContract{
+		OwnerAddress: []byte(ownerAddress),
+		Parameters:   req.Parameters,
+	})
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to create proposal: "+err.Error())
+		return
+	}
+
+	response := convertTransactionToResponse(tx, req.Visible)
+	utils.RespondWithSuccess(c, response)
+}
+
+// ProposalApprove handles POST /wallet/proposalapprove
+func (h *NodeHandler) ProposalApprove(c *gin.Context) {
+	var req struct {
+		OwnerAddress string `json:"owner_address" binding:"required"`
+		ProposalID   int64  `json:"proposal_id" binding:"required"`
+		IsAddApproval bool  `json:"is_add_approval" binding:"required"`
+		Visible      bool   `json:"visible" default:"false"`
+		PermissionID int32  `json:"permission_id"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
+		return
+	}
+
+	ownerAddress := req.OwnerAddress
+	if req.Visible {
+		hexAddr, _ := utils.Base58ToHex(ownerAddress)
+		ownerAddress = hexAddr
+	}
+
+	tx, err := h.blockchainClient.ProposalApprove(context.Background(), &lindapb.ProposalApproveContract{
+		OwnerAddress:  []byte(ownerAddress),
+		ProposalId:    req.ProposalID,
+		IsAddApproval: req.IsAddApproval,
+	})
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to approve proposal: "+err.Error())
+		return
+	}
+
+	response := convertTransactionToResponse(tx, req.Visible)
+	utils.RespondWithSuccess(c, response)
+}
+
+// ProposalDelete handles POST /wallet/proposaldelete
+func (h *NodeHandler) ProposalDelete(c *gin.Context) {
+	var req struct {
+		OwnerAddress string `json:"owner_address" binding:"required"`
+		ProposalID   int64  `json:"proposal_id" binding:"required"`
+		Visible      bool   `json:"visible" default:"false"`
+		PermissionID int32  `json:"permission_id"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
+		return
+	}
+
+	ownerAddress := req.OwnerAddress
+	if req.Visible {
+		hexAddr, _ := utils.Base58ToHex(ownerAddress)
+		ownerAddress = hexAddr
+	}
+
+	tx, err := h.blockchainClient.ProposalDelete(context.Background(), &lindapb.ProposalDeleteContract{
+		OwnerAddress: []byte(ownerAddress),
+		ProposalId:   req.ProposalID,
+	})
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to delete proposal: "+err.Error())
+		return
+	}
+
+	response := convertTransactionToResponse(tx, req.Visible)
+	utils.RespondWithSuccess(c, response)
+}
+
+// GetPaginatedProposalList handles POST /wallet/getpaginatedproposallist
+func (h *NodeHandler) GetPaginatedProposalList(c *gin.Context) {
+	var req struct {
+		Offset  int64 `json:"offset"`
+		Limit   int64 `json:"limit" binding:"required"`
+		Visible bool  `json:"visible" default:"false"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
+		return
+	}
+
+	proposals, err := h.blockchainClient.GetPaginatedProposalList(context.Background(), &lindapb.PaginatedMessage{
+		Offset: req.Offset,
+		Limit:  req.Limit,
+	})
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get paginated proposals: "+err.Error())
+		return
+	}
+
+	var response []gin.H
+	for _, p := range proposals.Proposals {
+		response = append(response, convertProposalToResponse(p, req.Visible))
+	}
+
+	utils.RespondWithSuccess(c, gin.H{"proposals": response})
+}
+
+// ==================== Chain Info Methods ====================
+
+// GetChainParameters handles GET /wallet/getchainparameters
+func (h *NodeHandler) GetChainParameters(c *gin.Context) {
+	params, err := h.blockchainClient.GetChainParameters(context.Background(), &lindapb.EmptyMessage{})
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get chain parameters: "+err.Error())
+		return
+	}
+
+	var response []gin.H
+	for _, p := range params.ChainParameter {
+		response = append(response, gin.H{
+			"key":   p.Key,
+			"value": p.Value,
+		})
+	}
+
+	utils.RespondWithSuccess(c, gin.H{"chainParameter": response})
+}
+
+// GetChainParametersV2 handles GET /api/chainparameters
+func (h *NodeHandler) GetChainParametersV2(c *gin.Context) {
+	params, err := h.blockchainClient.GetChainParameters(context.Background(), &lindapb.EmptyMessage{})
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get chain parameters: "+err.Error())
+		return
+	}
+
+	utils.RespondWithSuccess(c, params.ChainParameter)
+}
+
+// TotalTransaction handles GET /wallet/totaltransaction
+func (h *NodeHandler) TotalTransaction(c *gin.Context) {
+	total, err := h.blockchainClient.TotalTransaction(context.Background(), &lindapb.EmptyMessage{})
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get total transactions: "+err.Error())
+		return
+	}
+
+	utils.RespondWithSuccess(c, gin.H{"num": total.Num})
+}
+
+// GetBurnLind handles GET /wallet/getburnlind
+func (h *NodeHandler) GetBurnLind(c *gin.Context) {
+	burn, err := h.blockchainClient.GetBurnLind(context.Background(), &lindapb.EmptyMessage{})
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get burn LIND: "+err.Error())
+		return
+	}
+
+	utils.RespondWithSuccess(c, gin.H{"burnLindAmount": burn.Num})
+}
+
+// GetBurnLindSolidity handles GET /walletsolidity/getburnlind
+func (h *NodeHandler) GetBurnLindSolidity(c *gin.Context) {
+	burn, err := h.blockchainClient.GetBurnLindSolidity(context.Background(), &lindapb.EmptyMessage{})
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get burn LIND: "+err.Error())
+		return
+	}
+
+	utils.RespondWithSuccess(c, gin.H{"burnLindAmount": burn.Num})
+}
+
+// GetEnergyPrices handles GET /wallet/getenergyprices
+func (h *NodeHandler) GetEnergyPrices(c *gin.Context) {
+	prices, err := h.blockchainClient.GetEnergyPrices(context.Background(), &lindapb.EmptyMessage{})
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get energy prices: "+err.Error())
+		return
+	}
+
+	utils.RespondWithSuccess(c, gin.H{"prices": string(prices.Prices)})
+}
+
+// GetBandwidthPrices handles GET /wallet/getbandwidthprices
+func (h *NodeHandler) GetBandwidthPrices(c *gin.Context) {
+	prices, err := h.blockchainClient.GetBandwidthPrices(context.Background(), &lindapb.EmptyMessage{})
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get bandwidth prices: "+err.Error())
+		return
+	}
+
+	utils.RespondWithSuccess(c, gin.H{"prices": string(prices.Prices)})
+}
+
+// GetMemoFeePrices handles GET /wallet/getmemofee
+func (h *NodeHandler) GetMemoFeePrices(c *gin.Context) {
+	prices, err := h.blockchainClient.GetMemoFeePrices(context.Background(), &lindapb.EmptyMessage{})
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get memo fee prices: "+err.Error())
+		return
+	}
+
+	utils.RespondWithSuccess(c, gin.H{"prices": string(prices.Prices)})
+}
+
+// ==================== Metrics Methods ====================
+
+// GetStatsInfo handles GET /monitor/getstatsinfo
+func (h *NodeHandler) GetStatsInfo(c *gin.Context) {
+	stats, err := h.blockchainClient.GetStatsInfo(context.Background(), &lindapb.EmptyMessage{})
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get stats info: "+err.Error())
+		return
+	}
+
+	utils.RespondWithSuccess(c, stats)
+}
+
+// ==================== Vote Info (Lindascan) ====================
+
+// GetVoteInfo handles GET /api/vote
+func (h *NodeHandler) GetVoteInfo(c *gin.Context) {
+	// Get witnesses with their vote counts
+	witnesses, err := h.blockchainClient.ListWitnesses(context.Background(), &lindapb.EmptyMessage{})
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get vote info: "+err.Error())
+		return
+	}
+
+	var response []gin.H
+	for _, w := range witnesses.Witnesses {
+		response = append(response, gin.H{
+			"address":   utils.MustHexToBase58(string(w.Address)),
+			"voteCount": w.VoteCount,
+			"url":       w.Url,
+		})
+	}
+
+	utils.RespondWithSuccess(c, response)
+}
+
+// ==================== Node Map (Lindascan) ====================
+
+// GetNodeMap handles GET /api/nodemap
+// Returns the known nodes with their location, network and type, and node counts by country
+func (h *NodeHandler) GetNodeMap(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	nodes, err := h.blockchainClient.ListNodes(ctx, &lindapb.EmptyMessage{})
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get node map: "+err.Error())
+		return
+	}
+
+	reports, err := h.nodeRepo.GetNodeReports(time.Now().Add(-nodeReportWindow))
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get node reports: "+err.Error())
+		return
+	}
+
+	// Peers of the fullnode take the type and version they reported, nodes that
+	// only reported are added
+	reported := make(map[string]*models.NodeReport, len(reports))
+	for _, report := range reports {
+		reported[report.Host] = report
+	}
+
+	infos := make([]models.NodeInfo, 0, len(nodes.Nodes)+len(reports))
+	listed := make(map[string]bool, len(nodes.Nodes))
+	for _, node := range nodes.Nodes {
+		host := string(node.Address.Host)
+		if listed[host] {
+			continue
+		}
+		listed[host] = true
+
+		info := models.NodeInfo{Host: host, Port: node.Address.Port, NodeType: models.NodeTypeFull}
+		if report, ok := reported[host]; ok {
+			applyNodeReport(&info, report)
+		}
+		infos = append(infos, info)
+	}
+	for _, report := range reports {
+		if !listed[report.Host] {
+			info := models.NodeInfo{Host: report.Host, Port: report.Port}
+			applyNodeReport(&info, report)
+			infos = append(infos, info)
+		}
+	}
+
+	// Resolve locations
+	ips := make([]string, 0, len(infos))
+	for i := range infos {
+		if infos[i].IP = geoip.ResolveHost(ctx, infos[i].Host); infos[i].IP != "" {
+			ips = append(ips, infos[i].IP)
+		}
+	}
+	records, err := h.geoResolver.Resolve(ips)
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to resolve node locations: "+err.Error())
+		return
+	}
+
+	countries := make(map[string]*models.NodeCountryCount)
+	for i := range infos {
+		info := &infos[i]
+		info.Country, info.City = "Unknown", "Unknown"
+		if record, ok := records[info.IP]; ok {
+			applyGeoIPRecord(info, record)
+		}
+
+		count, ok := countries[info.CountryCode]
+		if !ok {
+			count = &models.NodeCountryCount{CountryCode: info.CountryCode, Country: info.Country}
+			countries[info.CountryCode] = count
+		}
+		count.Count++
+	}
+
+	response := models.NodeMapResponse{
+		Total:     len(infos),
+		Nodes:     infos,
+		Countries: make([]models.NodeCountryCount, 0, len(countries)),
+	}
+	for _, count := range countries {
+		response.Countries = append(response.Countries, *count)
+	}
+	sort.Slice(response.Countries, func(i, j int) bool {
+		if response.Countries[i].Count != response.Countries[j].Count {
+			return response.Countries[i].Count > response.Countries[j].Count
+		}
+		return response.Countries[i].CountryCode < response.Countries[j].CountryCode
+	})
+
+	utils.RespondWithSuccess(c, response)
+}
+
+// ==================== Node Upload (V2) ====================
+
+// UploadNodeOverview handles POST /api/v2/node/overview_upload
+// Stores the sync state and machine info of a node and returns its latest report
+func (h *NodeHandler) UploadNodeOverview(c *gin.Context) {
+	report, ok := h.ingestTelemetry(c)
+	if !ok {
+		return
+	}
+
+	utils.RespondWithSuccess(c, report)
+}
+
+// UploadNodeInfo handles POST /api/v2/node/info_upload
+// Stores the type, version and sync state reported by a node at host:port address
+func (h *NodeHandler) UploadNodeInfo(c *gin.Context) {
+	if _, ok := h.ingestTelemetry(c); !ok {
+		return
+	}
+
+	utils.RespondWithSuccess(c, gin.H{
+		"success": true,
+		"message": "Node info uploaded successfully",
+	})
+}
+
+// ==================== Node Telemetry ====================
+
+// GetNodeVersions handles GET /api/v2/node/versions
+// Returns the number of recently reporting nodes running each version
+func (h *NodeHandler) GetNodeVersions(c *gin.Context) {
+	counts, err := h.nodeRepo.GetVersionDistribution(time.Now().Add(-nodeReportWindow))
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get node versions: "+err.Error())
+		return
+	}
+
+	utils.RespondWithSuccess(c, counts)
+}
+
+// GetLaggingNodes handles GET /api/v2/node/lagging
+// Returns the recently reporting nodes more than threshold blocks behind the chain head
+func (h *NodeHandler) GetLaggingNodes(c *gin.Context) {
+	var req models.LaggingNodesRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
+		return
+	}
+
+	// Set defaults
+	if req.Threshold <= 0 {
+		req.Threshold = defaultLagThreshold
+	}
+
+	block, err := h.blockchainClient.GetNowBlock(c.Request.Context(), &lindapb.EmptyMessage{})
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get chain head: "+err.Error())
+		return
+	}
+	head := block.BlockHeader.RawData.Number
+
+	reports, err := h.nodeRepo.GetLaggingNodes(head, req.Threshold, time.Now().Add(-laggingReportWindow))
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get lagging nodes: "+err.Error())
+		return
+	}
+	for _, report := range reports {
+		report.Lag = head - report.BlockHeight
+	}
+
+	utils.RespondWithMeta(c, reports, gin.H{
+		"head":      head,
+		"threshold": req.Threshold,
+		"total":     len(reports),
+	})
+}
+
+// GetNodeHistory handles GET /api/v2/node/:host/history
+// Returns the telemetry uploads of a node, newest first
+func (h *NodeHandler) GetNodeHistory(c *gin.Context) {
+	var req models.NodeHistoryRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
+		return
+	}
+
+	// Set defaults
+	if req.To <= 0 {
+		req.To = time.Now().UnixMilli()
+	}
+	if req.From <= 0 {
+		req.From = req.To - nodeReportWindow.Milliseconds()
+	}
+	if req.Limit <= 0 {
+		req.Limit = 20
+	}
+	if req.Limit > 200 {
+		req.Limit = 200
+	}
+
+	samples, total, err := h.nodeRepo.GetTelemetryHistory(c.Param("host"), time.UnixMilli(req.From), time.UnixMilli(req.To), req.Start, req.Limit)
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get node history: "+err.Error())
+		return
+	}
+
+	utils.RespondWithMeta(c, samples, gin.H{
+		"total": total,
+		"start": req.Start,
+		"limit": req.Limit,
+	})
+}
+
+// ingestTelemetry authenticates a telemetry upload with an API key or a witness
+// signature and stores it, responding with an error when it is rejected
+func (h *NodeHandler) ingestTelemetry(c *gin.Context) (*models.NodeReport, bool) {
+	body, err := c.GetRawData()
+	if err != nil {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
+		return nil, false
+	}
+
+	var reporter string
+	user, ok := authenticatedUser(c)
+	if ok && user.APIKeyID != "" {
+		reporter = "apikey:" + user.APIKeyID
+	} else if witness := c.GetHeader(telemetry.HeaderWitness); witness != "" {
+		err := h.telemetryAuth.VerifyWitness(c.Request.Context(), witness,
+			c.GetHeader(telemetry.HeaderTimestamp), c.GetHeader(telemetry.HeaderSignature), body)
+		if err != nil {
+			status := http.StatusUnauthorized
+			if !errors.Is(err, telemetry.ErrInvalidSignature) && !errors.Is(err, telemetry.ErrExpiredTimestamp) &&
+				!errors.Is(err, telemetry.ErrUnknownWitness) && !errors.Is(err, telemetry.ErrReplayedUpload) {
+				status = http.StatusInternalServerError
+			}
+			utils.RespondWithError(c, status, "Failed to verify upload: "+err.Error())
+			return nil, false
+		}
+		reporter = witness
+	} else {
+		utils.RespondWithError(c, http.StatusUnauthorized, "An API key or witness signature is required to upload node telemetry")
+		return nil, false
+	}
+
+	var req models.NodeTelemetryUpload
+	if err := json.Unmarshal(body, &req); err != nil {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
+		return nil, false
+	}
+	if req.Address == "" {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: address is required")
+		return nil, false
+	}
+
+	report := &models.NodeReport{
+		Host:                req.Address,
+		Reporter:            reporter,
+		NodeType:            req.NodeType,
+		Version:             req.Version,
+		Location:            req.Location,
+		BlockHeight:         req.BlockHeight,
+		SolidityBlockHeight: req.SolidityBlockHeight,
+		Peers:               req.Peers,
+		Syncing:             req.Syncing,
+		Uptime:              req.Uptime,
+		ReportedAt:          time.Now(),
+	}
+	if host, port, err := net.SplitHostPort(req.Address); err == nil {
+		portNum, err := strconv.ParseInt(port, 10, 32)
+		if err != nil {
+			utils.RespondWithError(c, http.StatusBadRequest, "Invalid node port")
+			return nil, false
+		}
+		report.Host, report.Port = host, int32(portNum)
+	}
+
+	// A witness may only report the node at its URL, an API key the hosts in its node_host allowlist
+	owned := false
+	if strings.HasPrefix(reporter, "apikey:") {
+		for _, host := range user.Allowlist.NodeHosts {
+			owned = owned || strings.EqualFold(host, report.Host)
+		}
+	} else if owned, err = h.telemetryAuth.OwnsNode(c.Request.Context(), reporter, report.Host); err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to verify upload: "+err.Error())
+		return nil, false
+	}
+	if !owned {
+		utils.RespondWithError(c, http.StatusForbidden, "Node "+report.Host+" is not registered to the uploader")
+		return nil, false
+	}
+
+	switch report.NodeType {
+	case "":
+		report.NodeType = models.NodeTypeFull
+	case models.NodeTypeFull, models.NodeTypeSolidity, models.NodeTypeWitness:
+	default:
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid node type, expected FullNode, SolidityNode or WitnessNode")
+		return nil, false
+	}
+
+	sample := &models.NodeTelemetry{
+		Host:                report.Host,
+		Port:                report.Port,
+		Reporter:            report.Reporter,
+		NodeType:            report.NodeType,
+		Version:             report.Version,
+		BlockHeight:         report.BlockHeight,
+		SolidityBlockHeight: report.SolidityBlockHeight,
+		Peers:               report.Peers,
+		Syncing:             report.Syncing,
+		Uptime:              report.Uptime,
+		ReportedAt:          report.ReportedAt,
+	}
+	if info := req.MachineInfo; info != nil {
+		sample.OSName = info.OSName
+		sample.CPUCount = info.CPUCount
+		sample.CPURate = info.CPURate
+		sample.MemoryTotal = info.MemoryTotal
+		sample.MemoryFree = info.MemoryFree
+		sample.DiskTotal = info.DiskTotal
+		sample.DiskFree = info.DiskFree
+	}
+
+	if err := h.nodeRepo.SaveTelemetry(report, sample); err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to save node telemetry: "+err.Error())
+		return nil, false
+	}
+
+	return report, true
+}
+
+// ==================== Helper Functions ====================
+
+// applyNodeReport sets the node type and version a node reported
+func applyNodeReport(info *models.NodeInfo, report *models.NodeReport) {
+	info.NodeType = report.NodeType
+	info.Version = report.Version
+	if info.Port == 0 {
+		info.Port = report.Port
+	}
+}
+
+// applyGeoIPRecord sets the location and network of a node
+func applyGeoIPRecord(info *models.NodeInfo, record *models.GeoIPRecord) {
+	if record.Country != "" {
+		info.Country = record.Country
+	}
+	if record.City != "" {
+		info.City = record.City
+	}
+	info.CountryCode = record.CountryCode
+	info.Latitude = record.Latitude
+	info.Longitude = record.Longitude
+	info.ASN = record.ASN
+	info.ASOrganization = record.ASOrganization
+	info.Hosting = record.Hosting
+}
+
+func convertNodeInfoToResponse(info *lindapb.NodeInfo) gin.H {
+	return gin.H{
+		"beginSyncNum":        info.BeginSyncNum,
+		"block":               info.Block,
+		"solidityBlock":       info.SolidityBlock,
+		"currentConnectCount": info.CurrentConnectCount,
+		"activeConnectCount":  info.ActiveConnectCount,
+		"passiveConnectCount": info.PassiveConnectCount,
+		"totalFlow":           info.TotalFlow,
+		"peerInfoList":        info.PeerInfoList,
+		"configNodeInfo":      info.ConfigNodeInfo,
+		"machineInfo":         info.MachineInfo,
+		"cheatWitnessInfoMap": info.CheatWitnessInfoMap,
+	}
+}
+
+func convertProposalToResponse(p *lindapb.Proposal, visible bool) gin.H {
+	state := "PENDING"
+	switch p.State {
+	case 1:
+		state = "DISAPPROVED"
+	case 2:
+		state = "APPROVED"
+	case 3:
+		state = "CANCELED"
+	}
+
+	resp := gin.H{
+		"proposal_id":      p.ProposalId,
+		"proposer_address": string(p.ProposerAddress),
+		"parameters":       p.Parameters,
+		"expiration_time":  p.ExpirationTime,
+		"create_time":      p.CreateTime,
+		"approvals":        p.Approvals,
+		"state":            state,
+	}
+
+	if visible {
+		base58Addr, _ := utils.HexToBase58(string(p.ProposerAddress))
+		resp["proposer_address"] = base58Addr
+
+		approvals := make([]string, len(p.Approvals))
+		for i, addr := range p.Approvals {
+			base58Addr, _ := utils.HexToBase58(addr)
+			approvals[i] = base58Addr
+		}
+		resp["approvals"] = approvals
+	}
+
+	return resp
 }
\ No newline at end of file
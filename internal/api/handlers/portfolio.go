@@ -0,0 +1,252 @@
+package handlers
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/blockchain"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/cache"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/storage/repository"
+	"github.com/lindaprotocol/grpc-api-gateway/pkg/lindapb"
+	"github.com/lindaprotocol/grpc-api-gateway/pkg/utils"
+)
+
+// portfolioCacheTTL bounds how long a portfolio is kept, entries are keyed
+// by block height so a new block is never served a stale portfolio
+const portfolioCacheTTL = time.Minute
+
+// portfolioNFTLimit is the maximum number of NFTs listed in a portfolio
+const portfolioNFTLimit = 200
+
+// lindDecimals is the number of decimals of LIND amounts in sun
+const lindDecimals = 6
+
+type PortfolioHandler struct {
+	blockchainClient *blockchain.Client
+	blockRepo        *repository.BlockRepository
+	tokenRepo        *repository.TokenRepository
+	nftRepo          *repository.NFTRepository
+	cacheClient      *cache.RedisClient
+	priceStaleAfter  time.Duration
+}
+
+func NewPortfolioHandler(client *blockchain.Client, blockRepo *repository.BlockRepository, tokenRepo *repository.TokenRepository, nftRepo *repository.NFTRepository, cacheClient *cache.RedisClient, priceStaleAfter time.Duration) *PortfolioHandler {
+	if priceStaleAfter <= 0 {
+		priceStaleAfter = 15 * time.Minute
+	}
+	return &PortfolioHandler{
+		blockchainClient: client,
+		blockRepo:        blockRepo,
+		tokenRepo:        tokenRepo,
+		nftRepo:          nftRepo,
+		cacheClient:      cacheClient,
+		priceStaleAfter:  priceStaleAfter,
+	}
+}
+
+// GetPortfolio handles GET /api/account/portfolio
+// Returns the LIND, LRC10, LRC20 and NFT holdings of an address with metadata and USD values
+func (h *PortfolioHandler) GetPortfolio(c *gin.Context) {
+	address := c.Query("address")
+	if !utils.IsValidBase58Address(address) {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid address format")
+		return
+	}
+
+	blockNumber, err := h.blockRepo.GetLastIndexedBlock()
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get indexed block: "+err.Error())
+		return
+	}
+
+	// One portfolio per address and block height
+	cacheKey := fmt.Sprintf("portfolio:%s:%d", address, blockNumber)
+	if h.cacheClient != nil {
+		var cached models.PortfolioResponse
+		if err := h.cacheClient.Get(cacheKey, &cached); err == nil {
+			utils.RespondWithSuccess(c, &cached)
+			return
+		}
+	}
+
+	portfolio, err := h.buildPortfolio(c.Request.Context(), address, blockNumber)
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get portfolio: "+err.Error())
+		return
+	}
+
+	if h.cacheClient != nil {
+		h.cacheClient.Set(cacheKey, portfolio, portfolioCacheTTL)
+	}
+
+	utils.RespondWithSuccess(c, portfolio)
+}
+
+// buildPortfolio reads the account from the node and the token and NFT holdings from the index
+func (h *PortfolioHandler) buildPortfolio(ctx context.Context, address string, blockNumber int64) (*models.PortfolioResponse, error) {
+	hexAddr, err := utils.Base58ToHex(address)
+	if err != nil {
+		return nil, err
+	}
+	rawAddr, err := hex.DecodeString(hexAddr)
+	if err != nil {
+		return nil, err
+	}
+	account, err := h.blockchainClient.GetAccount(ctx, &lindapb.Account{Address: rawAddr})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UnixMilli()
+	portfolio := &models.PortfolioResponse{
+		Address:     address,
+		BlockNumber: blockNumber,
+		LIND:        portfolioLIND(account, now),
+	}
+
+	if portfolio.LRC10, err = h.lrc10Holdings(account.AssetV2); err != nil {
+		return nil, err
+	}
+	if portfolio.LRC20, err = h.tokenRepo.GetLRC20Holdings(address); err != nil {
+		return nil, err
+	}
+	if portfolio.NFTs, portfolio.NFTTotal, err = h.nftRepo.GetTokensByOwner(address, "", 0, portfolioNFTLimit); err != nil {
+		return nil, err
+	}
+
+	h.valuePortfolio(portfolio, now)
+	return portfolio, nil
+}
+
+// valuePortfolio values LIND and the tokens whose symbol has a fresh quote from the price
+// feed and adds them up, the total is left out when no holding has a price
+func (h *PortfolioHandler) valuePortfolio(portfolio *models.PortfolioResponse, now int64) {
+	prices := make(map[string]*float64)
+	price := func(symbol string) *float64 {
+		if p, ok := prices[symbol]; ok {
+			return p
+		}
+		var p *float64
+		quote, err := h.tokenRepo.GetTokenPrice(symbol, 0)
+		if err == nil && quote.Price > 0 && time.Duration(now-quote.Timestamp)*time.Millisecond <= h.priceStaleAfter {
+			p = &quote.Price
+		}
+		prices[symbol] = p
+		return p
+	}
+
+	var total float64
+	valued := false
+	if p := price(models.AssetLIND); p != nil {
+		value := float64(portfolio.LIND.Total) / math.Pow10(lindDecimals) * *p
+		portfolio.LIND.PriceUSD = p
+		portfolio.LIND.ValueUSD = &value
+		total += value
+		valued = true
+	}
+
+	for _, holdings := range [][]*models.PortfolioToken{portfolio.LRC10, portfolio.LRC20} {
+		for _, holding := range holdings {
+			if holding.Symbol == "" {
+				continue
+			}
+			p := price(holding.Symbol)
+			if p == nil {
+				continue
+			}
+			balance, err := strconv.ParseFloat(holding.Balance, 64)
+			if err != nil {
+				continue
+			}
+			value := balance / math.Pow10(int(holding.Decimals)) * *p
+			holding.PriceUSD = p
+			holding.ValueUSD = &value
+			total += value
+			valued = true
+		}
+	}
+
+	if valued {
+		portfolio.TotalValueUSD = &total
+	}
+}
+
+// lrc10Holdings returns the non-zero LRC10 balances of an account with their token metadata
+func (h *PortfolioHandler) lrc10Holdings(assets map[string]int64) ([]*models.PortfolioToken, error) {
+	ids := make([]string, 0, len(assets))
+	for id, balance := range assets {
+		if balance > 0 {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+
+	tokens, err := h.tokenRepo.GetLRC10TokensByIDs(ids)
+	if err != nil {
+		return nil, err
+	}
+	infos := make(map[string]*models.TokenInfo, len(tokens))
+	for _, token := range tokens {
+		infos[token.ID] = token
+	}
+
+	holdings := make([]*models.PortfolioToken, 0, len(ids))
+	for _, id := range ids {
+		holding := &models.PortfolioToken{
+			ID:      id,
+			Balance: strconv.FormatInt(assets[id], 10),
+		}
+		if info, ok := infos[id]; ok {
+			holding.Name = info.Name
+			holding.Symbol = info.Symbol
+			holding.Decimals = int32(info.Decimals)
+		}
+		holdings = append(holdings, holding)
+	}
+	return holdings, nil
+}
+
+// portfolioLIND breaks down the LIND of an account, unfreezes expiring
+// after now are pending and earlier ones can be withdrawn
+func portfolioLIND(account *lindapb.Account, now int64) models.PortfolioLIND {
+	lind := models.PortfolioLIND{
+		Balance: account.Balance,
+		DelegatedOut: account.DelegatedFrozenBalanceForBandwidth +
+			account.DelegatedFrozenV2BalanceForBandwidth,
+		DelegatedIn: account.AcquiredDelegatedFrozenBalanceForBandwidth +
+			account.AcquiredDelegatedFrozenV2BalanceForBandwidth,
+	}
+
+	for _, f := range account.Frozen {
+		lind.FrozenV1 += f.FrozenBalance
+	}
+	for _, f := range account.FrozenV2 {
+		lind.FrozenV2 += f.Amount
+	}
+	for _, u := range account.UnfrozenV2 {
+		if u.UnfreezeExpireTime > now {
+			lind.PendingUnfreeze += u.UnfreezeAmount
+		} else {
+			lind.Withdrawable += u.UnfreezeAmount
+		}
+	}
+
+	if resource := account.AccountResource; resource != nil {
+		if resource.FrozenBalanceForEnergy != nil {
+			lind.FrozenV1 += resource.FrozenBalanceForEnergy.FrozenBalance
+		}
+		lind.DelegatedOut += resource.DelegatedFrozenBalanceForEnergy + resource.DelegatedFrozenV2BalanceForEnergy
+		lind.DelegatedIn += resource.AcquiredDelegatedFrozenBalanceForEnergy + resource.AcquiredDelegatedFrozenV2BalanceForEnergy
+	}
+
+	lind.Total = lind.Balance + lind.FrozenV1 + lind.FrozenV2 + lind.DelegatedOut + lind.PendingUnfreeze + lind.Withdrawable
+	return lind
+}
@@ -0,0 +1,387 @@
+package handlers
+
+import (
+	"context"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/blockchain"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/storage/repository"
+	"github.com/lindaprotocol/grpc-api-gateway/pkg/lindapb"
+	"github.com/lindaprotocol/grpc-api-gateway/pkg/utils"
+)
+
+// stakeDelegationLimit is the maximum number of counterparties whose
+// delegations are listed in each direction
+const stakeDelegationLimit = 50
+
+// stakeDelegationWorkers bounds the delegation lookups sent to the node at once
+const stakeDelegationWorkers = 8
+
+// stakeTimeout bounds the node calls made for one stake request
+const stakeTimeout = 15 * time.Second
+
+// defaultDelegatedHistoryRange is the delegated resource history returned when from is not given
+const defaultDelegatedHistoryRange = 30 * 24 * time.Hour
+
+type StakeHandler struct {
+	blockchainClient *blockchain.Client
+	stakingRepo      *repository.StakingRepository
+}
+
+func NewStakeHandler(client *blockchain.Client, stakingRepo *repository.StakingRepository) *StakeHandler {
+	return &StakeHandler{
+		blockchainClient: client,
+		stakingRepo:      stakingRepo,
+	}
+}
+
+// GetStake handles GET /api/account/stake
+// Returns staked amounts, delegations, the unfreeze schedule and resource limits of an account
+func (h *StakeHandler) GetStake(c *gin.Context) {
+	address := c.Query("address")
+	if !utils.IsValidBase58Address(address) {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid address format")
+		return
+	}
+	hexAddr, err := utils.Base58ToHex(address)
+	if err != nil {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid address format")
+		return
+	}
+	rawAddr, err := hex.DecodeString(hexAddr)
+	if err != nil {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid address format")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), stakeTimeout)
+	defer cancel()
+	now := time.Now().UnixMilli()
+	stake := &models.StakeResponse{
+		Address:      address,
+		DelegatedOut: make([]models.StakeDelegation, 0),
+		DelegatedIn:  make([]models.StakeDelegation, 0),
+		Unfreezes:    make([]models.StakeUnfreeze, 0),
+	}
+
+	// Staked and unstaking amounts
+	account, err := h.blockchainClient.GetAccount(ctx, &lindapb.Account{Address: rawAddr})
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get account: "+err.Error())
+		return
+	}
+	for _, f := range account.FrozenV2 {
+		switch int32(f.Type) {
+		case models.ResourceBandwidth:
+			stake.Staked.Bandwidth += f.Amount
+		case models.ResourceEnergy:
+			stake.Staked.Energy += f.Amount
+		case models.ResourceLindaPower:
+			stake.Staked.LindaPower += f.Amount
+		}
+	}
+	for _, u := range account.UnfrozenV2 {
+		stake.Unfreezes = append(stake.Unfreezes, models.StakeUnfreeze{
+			Resource:     resourceName(int32(u.Type)),
+			Amount:       u.UnfreezeAmount,
+			ExpireTime:   u.UnfreezeExpireTime,
+			Withdrawable: u.UnfreezeExpireTime <= now,
+		})
+	}
+
+	withdrawable, err := h.blockchainClient.GetCanWithdrawUnfreezeAmount(ctx, &lindapb.CanWithdrawUnfreezeAmountReq{
+		OwnerAddress: rawAddr,
+		Timestamp:    now,
+	})
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get withdrawable amount: "+err.Error())
+		return
+	}
+	stake.WithdrawableNow = withdrawable.Num
+
+	unfreezeCount, err := h.blockchainClient.GetAvailableUnfreezeCount(ctx, &lindapb.Account{Address: rawAddr})
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get available unfreeze count: "+err.Error())
+		return
+	}
+	stake.AvailableUnfreezeCount = unfreezeCount.Num
+
+	// Amounts that can still be delegated
+	for _, resource := range []int32{models.ResourceBandwidth, models.ResourceEnergy} {
+		maxSize, err := h.blockchainClient.GetCanDelegatedMaxSize(ctx, &lindapb.CanDelegatedMaxSizeReq{
+			OwnerAddress: rawAddr,
+			Type:         resource,
+		})
+		if err != nil {
+			utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get can delegated max size: "+err.Error())
+			return
+		}
+		if resource == models.ResourceEnergy {
+			stake.CanDelegate.Energy = maxSize.Num
+		} else {
+			stake.CanDelegate.Bandwidth = maxSize.Num
+		}
+	}
+
+	// Delegations to and from other accounts
+	index, err := h.blockchainClient.GetDelegatedResourceAccountIndexV2(ctx, &lindapb.Account{Address: rawAddr})
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get delegated resource account index: "+err.Error())
+		return
+	}
+	toAccounts, fromAccounts := index.ToAccounts, index.FromAccounts
+	truncated := len(toAccounts) > stakeDelegationLimit || len(fromAccounts) > stakeDelegationLimit
+	if len(toAccounts) > stakeDelegationLimit {
+		toAccounts = toAccounts[:stakeDelegationLimit]
+	}
+	if len(fromAccounts) > stakeDelegationLimit {
+		fromAccounts = fromAccounts[:stakeDelegationLimit]
+	}
+	delegatedOut, delegatedIn, err := h.allDelegations(ctx, rawAddr, toAccounts, fromAccounts, now)
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get delegated resource: "+err.Error())
+		return
+	}
+	stake.DelegatedOut = append(stake.DelegatedOut, delegatedOut...)
+	stake.DelegatedIn = append(stake.DelegatedIn, delegatedIn...)
+
+	// Current limits
+	resource, err := h.blockchainClient.GetAccountResource(ctx, &lindapb.Account{Address: rawAddr})
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get account resource: "+err.Error())
+		return
+	}
+	stake.Resources = models.StakeResources{
+		FreeNetUsed:     resource.FreeNetUsed,
+		FreeNetLimit:    resource.FreeNetLimit,
+		NetUsed:         resource.NetUsed,
+		NetLimit:        resource.NetLimit,
+		EnergyUsed:      resource.EnergyUsed,
+		EnergyLimit:     resource.EnergyLimit,
+		LindaPowerUsed:  resource.TronPowerUsed,
+		LindaPowerLimit: resource.TronPowerLimit,
+	}
+
+	utils.RespondWithMeta(c, stake, gin.H{
+		"delegations_truncated": truncated,
+	})
+}
+
+// GetStakingTimeline handles GET /api/account/stake/history
+// Returns the indexed freeze, unfreeze, delegation, vote and reward withdrawal actions of an address
+func (h *StakeHandler) GetStakingTimeline(c *gin.Context) {
+	var req models.StakingHistoryRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
+		return
+	}
+
+	if !utils.IsValidBase58Address(req.Address) {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid address format")
+		return
+	}
+
+	// Set defaults
+	if req.Limit <= 0 {
+		req.Limit = 20
+	}
+	if req.Limit > 200 {
+		req.Limit = 200
+	}
+
+	entries, total, err := h.stakingRepo.GetTimeline(req.Address, req.Start, req.Limit)
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get staking timeline: "+err.Error())
+		return
+	}
+
+	utils.RespondWithMeta(c, entries, gin.H{
+		"total": total,
+		"start": req.Start,
+		"limit": req.Limit,
+	})
+}
+
+// GetWitnessVoters handles GET /api/witness/voters
+// Returns the history of votes cast for a witness
+func (h *StakeHandler) GetWitnessVoters(c *gin.Context) {
+	var req models.StakingHistoryRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
+		return
+	}
+
+	if !utils.IsValidBase58Address(req.Address) {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid address format")
+		return
+	}
+
+	// Set defaults
+	if req.Limit <= 0 {
+		req.Limit = 20
+	}
+	if req.Limit > 200 {
+		req.Limit = 200
+	}
+
+	votes, total, err := h.stakingRepo.GetWitnessVoters(req.Address, req.Start, req.Limit)
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get witness voters: "+err.Error())
+		return
+	}
+
+	utils.RespondWithMeta(c, votes, gin.H{
+		"total": total,
+		"start": req.Start,
+		"limit": req.Limit,
+	})
+}
+
+// GetDelegatedResourceHistory handles GET /api/stats/delegated-resource
+// Returns the daily amounts delegated and undelegated for a resource, energy by default, and the running total
+func (h *StakeHandler) GetDelegatedResourceHistory(c *gin.Context) {
+	var req models.DelegatedResourceHistoryRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
+		return
+	}
+
+	// Set defaults
+	req.Resource = strings.ToUpper(req.Resource)
+	if req.Resource == "" {
+		req.Resource = resourceName(models.ResourceEnergy)
+	}
+	if req.Resource != resourceName(models.ResourceEnergy) && req.Resource != resourceName(models.ResourceBandwidth) {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid resource: "+req.Resource)
+		return
+	}
+	if req.To <= 0 {
+		req.To = time.Now().UnixMilli()
+	}
+	if req.From <= 0 {
+		req.From = req.To - defaultDelegatedHistoryRange.Milliseconds()
+	}
+
+	points, err := h.stakingRepo.GetDelegatedResourceHistory(req.Resource, req.From, req.To)
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get delegated resource history: "+err.Error())
+		return
+	}
+
+	utils.RespondWithMeta(c, points, gin.H{
+		"resource": req.Resource,
+		"from":     req.From,
+		"to":       req.To,
+	})
+}
+
+// allDelegations returns the delegations of an account to and from the given
+// counterparties in index order, with at most stakeDelegationWorkers lookups in
+// flight. The first failed lookup cancels the others.
+func (h *StakeHandler) allDelegations(ctx context.Context, address []byte, toAccounts, fromAccounts [][]byte, now int64) ([]models.StakeDelegation, []models.StakeDelegation, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make([][]models.StakeDelegation, len(toAccounts)+len(fromAccounts))
+	sem := make(chan struct{}, stakeDelegationWorkers)
+	var wg sync.WaitGroup
+	var once sync.Once
+	var firstErr error
+	fail := func(err error) {
+		once.Do(func() {
+			firstErr = err
+			cancel()
+		})
+	}
+	fetch := func(i int, from, to, counterparty []byte) {
+		defer wg.Done()
+		sem <- struct{}{}
+		defer func() { <-sem }()
+
+		if err := ctx.Err(); err != nil {
+			fail(err)
+			return
+		}
+		delegations, err := h.delegations(ctx, from, to, counterparty, now)
+		if err != nil {
+			fail(err)
+			return
+		}
+		results[i] = delegations
+	}
+
+	for n, to := range toAccounts {
+		wg.Add(1)
+		go fetch(n, address, to, to)
+	}
+	for n, from := range fromAccounts {
+		wg.Add(1)
+		go fetch(len(toAccounts)+n, from, address, from)
+	}
+	wg.Wait()
+	if firstErr != nil {
+		return nil, nil, firstErr
+	}
+
+	var delegatedOut, delegatedIn []models.StakeDelegation
+	for _, delegations := range results[:len(toAccounts)] {
+		delegatedOut = append(delegatedOut, delegations...)
+	}
+	for _, delegations := range results[len(toAccounts):] {
+		delegatedIn = append(delegatedIn, delegations...)
+	}
+	return delegatedOut, delegatedIn, nil
+}
+
+// delegations returns the Stake 2.0 delegations from one account to another,
+// one entry per resource, listed under the counterparty address
+func (h *StakeHandler) delegations(ctx context.Context, from, to, counterparty []byte, now int64) ([]models.StakeDelegation, error) {
+	resources, err := h.blockchainClient.GetDelegatedResourceV2(ctx, &lindapb.DelegatedResourceReq{
+		FromAddress: from,
+		ToAddress:   to,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	address := utils.MustHexToBase58(hex.EncodeToString(counterparty))
+	delegations := make([]models.StakeDelegation, 0, 2*len(resources.DelegatedResource))
+	for _, r := range resources.DelegatedResource {
+		if r.FrozenBalanceForBandwidth > 0 {
+			delegations = append(delegations, models.StakeDelegation{
+				Address:    address,
+				Resource:   resourceName(models.ResourceBandwidth),
+				Amount:     r.FrozenBalanceForBandwidth,
+				ExpireTime: r.ExpireTimeForBandwidth,
+				Locked:     r.ExpireTimeForBandwidth > now,
+			})
+		}
+		if r.FrozenBalanceForEnergy > 0 {
+			delegations = append(delegations, models.StakeDelegation{
+				Address:    address,
+				Resource:   resourceName(models.ResourceEnergy),
+				Amount:     r.FrozenBalanceForEnergy,
+				ExpireTime: r.ExpireTimeForEnergy,
+				Locked:     r.ExpireTimeForEnergy > now,
+			})
+		}
+	}
+	return delegations, nil
+}
+
+// resourceName returns the name of a resource code as used in resource requests
+func resourceName(code int32) string {
+	switch code {
+	case models.ResourceEnergy:
+		return "ENERGY"
+	case models.ResourceLindaPower:
+		return "LINDA_POWER"
+	}
+	return "BANDWIDTH"
+}
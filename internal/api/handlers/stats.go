@@ -1,470 +1,552 @@
-package handlers
-
-import (
-	"context"
-	"net/http"
-	"strconv"
-
-	"github.com/gin-gonic/gin"
-	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
-	"github.com/lindaprotocol/grpc-api-gateway/internal/services/blockchain"
-	"github.com/lindaprotocol/grpc-api-gateway/internal/services/storage/repository"
-	"github.com/lindaprotocol/grpc-api-gateway/pkg/lindapb"
-	"github.com/lindaprotocol/grpc-api-gateway/pkg/utils"
-)
-
-type StatsHandler struct {
-	blockchainClient *blockchain.Client
-	statsRepo        *repository.StatsRepository
-}
-
-func NewStatsHandler(client *blockchain.Client, statsRepo *repository.StatsRepository) *StatsHandler {
-	return &StatsHandler{
-		blockchainClient: client,
-		statsRepo:        statsRepo,
-	}
-}
-
-// ==================== Homepage Bundle ====================
-
-// GetHomepageBundle handles GET /api/system/homepage-bundle
-func (h *StatsHandler) GetHomepageBundle(c *gin.Context) {
-	// Get from database or calculate
-	bundle, err := h.statsRepo.GetHomepageBundle()
-	if err != nil {
-		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get homepage bundle: "+err.Error())
-		return
-	}
-
-	// Get market data
-	price, marketCap, volume := h.getMarketData()
-	bundle.PriceUSD = price
-	bundle.MarketCap = marketCap
-	bundle.Volume24h = volume
-
-	utils.RespondWithSuccess(c, bundle)
-}
-
-// ==================== Top 10 ====================
-
-// GetTop10 handles GET /api/top10
-func (h *StatsHandler) GetTop10(c *gin.Context) {
-	var req models.Top10Request
-	if err := c.ShouldBindQuery(&req); err != nil {
-		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
-		return
-	}
-
-	// Get top witnesses
-	witnesses, err := h.blockchainClient.ListWitnesses(context.Background(), &lindapb.EmptyMessage{})
-	if err != nil {
-		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get witnesses: "+err.Error())
-		return
-	}
-
-	// Get top accounts from database
-	accounts, err := h.statsRepo.GetTopAccounts(10)
-	if err != nil {
-		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get accounts: "+err.Error())
-		return
-	}
-
-	// Get top tokens from database
-	tokens, err := h.statsRepo.GetTopTokens(10)
-	if err != nil {
-		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get tokens: "+err.Error())
-		return
-	}
-
-	response := &models.Top10Response{
-		Witnesses: make([]models.WitnessResponse, 0),
-		Accounts:  accounts,
-		Tokens:    tokens,
-	}
-
-	// Convert witnesses
-	for i, w := range witnesses.Witnesses {
-		if i >= 10 {
-			break
-		}
-		response.Witnesses = append(response.Witnesses, models.WitnessResponse{
-			Address:   utils.MustHexToBase58(string(w.Address)),
-			VoteCount: w.VoteCount,
-			URL:       w.Url,
-		})
-	}
-
-	utils.RespondWithSuccess(c, response)
-}
-
-// ==================== Overview Statistics ====================
-
-// GetOverview handles GET /api/stats/overview
-func (h *StatsHandler) GetOverview(c *gin.Context) {
-	statType := c.DefaultQuery("type", "")
-
-	data, err := h.statsRepo.GetOverview(statType)
-	if err != nil {
-		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get overview: "+err.Error())
-		return
-	}
-
-	utils.RespondWithSuccess(c, data)
-}
-
-// ==================== Energy Statistics ====================
-
-// GetEnergyStatistic handles GET /api/energystatistic
-func (h *StatsHandler) GetEnergyStatistic(c *gin.Context) {
-	var req models.EnergyStatisticRequest
-	if err := c.ShouldBindQuery(&req); err != nil {
-		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
-		return
-	}
-
-	if req.Address == "" {
-		utils.RespondWithError(c, http.StatusBadRequest, "Address is required")
-		return
-	}
-
-	stats, err := h.statsRepo.GetEnergyStatistic(req.Address, req.From, req.To)
-	if err != nil {
-		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get energy statistic: "+err.Error())
-		return
-	}
-
-	utils.RespondWithSuccess(c, stats)
-}
-
-// GetEnergyDailyStatistic handles GET /api/energydailystatistic
-func (h *StatsHandler) GetEnergyDailyStatistic(c *gin.Context) {
-	var req models.EnergyDailyStatisticRequest
-	if err := c.ShouldBindQuery(&req); err != nil {
-		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
-		return
-	}
-
-	stats, err := h.statsRepo.GetEnergyDailyStatistic(req.From, req.To)
-	if err != nil {
-		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get energy daily statistic: "+err.Error())
-		return
-	}
-
-	utils.RespondWithSuccess(c, stats)
-}
-
-// ==================== Trigger Statistics ====================
-
-// GetTriggerStatistic handles GET /api/triggerstatistic
-func (h *StatsHandler) GetTriggerStatistic(c *gin.Context) {
-	var req models.TriggerStatisticRequest
-	if err := c.ShouldBindQuery(&req); err != nil {
-		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
-		return
-	}
-
-	if req.Contract == "" {
-		utils.RespondWithError(c, http.StatusBadRequest, "Contract address is required")
-		return
-	}
-
-	stats, err := h.statsRepo.GetTriggerStatistic(req.Contract, req.From, req.To)
-	if err != nil {
-		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get trigger statistic: "+err.Error())
-		return
-	}
-
-	utils.RespondWithSuccess(c, stats)
-}
-
-// GetTriggerAmountStatistic handles GET /api/triggeramountstatistic
-func (h *StatsHandler) GetTriggerAmountStatistic(c *gin.Context) {
-	var req models.TriggerAmountStatisticRequest
-	if err := c.ShouldBindQuery(&req); err != nil {
-		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
-		return
-	}
-
-	if req.Contract == "" {
-		utils.RespondWithError(c, http.StatusBadRequest, "Contract address is required")
-		return
-	}
-
-	// Get from database
-	// This would be implemented with the stats repository
-
-	utils.RespondWithSuccess(c, gin.H{
-		"contract": req.Contract,
-		"triggers": []interface{}{},
-	})
-}
-
-// ==================== Caller Statistics ====================
-
-// GetCallerAddressStatistic handles GET /api/calleraddressstatistic
-func (h *StatsHandler) GetCallerAddressStatistic(c *gin.Context) {
-	var req models.CallerAddressStatisticRequest
-	if err := c.ShouldBindQuery(&req); err != nil {
-		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
-		return
-	}
-
-	if req.Contract == "" {
-		utils.RespondWithError(c, http.StatusBadRequest, "Contract address is required")
-		return
-	}
-
-	stats, err := h.statsRepo.GetCallerAddressStatistic(req.Contract, req.From, req.To)
-	if err != nil {
-		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get caller statistic: "+err.Error())
-		return
-	}
-
-	utils.RespondWithSuccess(c, stats)
-}
-
-// ==================== One Contract Statistics ====================
-
-// GetOneContractEnergyStatistic handles GET /api/onecontractenergystatistic
-func (h *StatsHandler) GetOneContractEnergyStatistic(c *gin.Context) {
-	var req models.OneContractEnergyStatisticRequest
-	if err := c.ShouldBindQuery(&req); err != nil {
-		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
-		return
-	}
-
-	if req.Contract == "" {
-		utils.RespondWithError(c, http.StatusBadRequest, "Contract address is required")
-		return
-	}
-
-	// Get from database
-	utils.RespondWithSuccess(c, gin.H{
-		"contract": req.Contract,
-		"total":    0,
-		"daily":    []interface{}{},
-	})
-}
-
-// GetOneContractTriggerStatistic handles GET /api/onecontracttriggerstatistic
-func (h *StatsHandler) GetOneContractTriggerStatistic(c *gin.Context) {
-	var req models.OneContractTriggerStatisticRequest
-	if err := c.ShouldBindQuery(&req); err != nil {
-		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
-		return
-	}
-
-	if req.Contract == "" {
-		utils.RespondWithError(c, http.StatusBadRequest, "Contract address is required")
-		return
-	}
-
-	// Get from database
-	utils.RespondWithSuccess(c, gin.H{
-		"contract": req.Contract,
-		"count":    0,
-		"daily":    []interface{}{},
-	})
-}
-
-// GetOneContractCallerStatistic handles GET /api/onecontractcallerstatistic
-func (h *StatsHandler) GetOneContractCallerStatistic(c *gin.Context) {
-	var req models.OneContractCallerStatisticRequest
-	if err := c.ShouldBindQuery(&req); err != nil {
-		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
-		return
-	}
-
-	if req.Contract == "" {
-		utils.RespondWithError(c, http.StatusBadRequest, "Contract address is required")
-		return
-	}
-
-	// Get from database
-	utils.RespondWithSuccess(c, gin.H{
-		"contract": req.Contract,
-		"callers":  []interface{}{},
-	})
-}
-
-// GetOneContractCallers handles GET /api/onecontractcallers
-func (h *StatsHandler) GetOneContractCallers(c *gin.Context) {
-	var req models.OneContractCallersRequest
-	if err := c.ShouldBindQuery(&req); err != nil {
-		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
-		return
-	}
-
-	if req.Contract == "" {
-		utils.RespondWithError(c, http.StatusBadRequest, "Contract address is required")
-		return
-	}
-
-	if req.Limit <= 0 {
-		req.Limit = 20
-	}
-	if req.Limit > 200 {
-		req.Limit = 200
-	}
-
-	// Get from database
-	utils.RespondWithSuccess(c, gin.H{
-		"callers": []interface{}{},
-		"total":   0,
-	})
-}
-
-// ==================== Freeze Resource ====================
-
-// GetFreezeResource handles GET /api/freezeresource
-func (h *StatsHandler) GetFreezeResource(c *gin.Context) {
-	var req models.FreezeResourceRequest
-	if err := c.ShouldBindQuery(&req); err != nil {
-		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
-		return
-	}
-
-	if req.Address == "" {
-		utils.RespondWithError(c, http.StatusBadRequest, "Address is required")
-		return
-	}
-
-	data, err := h.statsRepo.GetFreezeResource(req.Address, req.Type)
-	if err != nil {
-		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get freeze resource: "+err.Error())
-		return
-	}
-
-	utils.RespondWithSuccess(c, data)
-}
-
-// ExportFreezeResourceToCSV handles CSV export for freeze resource
-func (h *StatsHandler) ExportFreezeResourceToCSV(c *gin.Context, req models.CSVExportRequest) {
-	// Get data
-	data, err := h.statsRepo.GetFreezeResource(req.Address, "")
-	if err != nil {
-		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get data: "+err.Error())
-		return
-	}
-
-	headers := []string{"Type", "Amount", "Expire At", "From", "To"}
-	var rows [][]string
-
-	for _, f := range data.Frozen {
-		rows = append(rows, []string{
-			f.Type,
-			strconv.FormatInt(f.Amount, 10),
-			strconv.FormatInt(f.ExpireAt, 10),
-			"",
-			"",
-		})
-	}
-
-	for _, d := range data.Delegated {
-		rows = append(rows, []string{
-			d.Type,
-			strconv.FormatInt(d.Amount, 10),
-			strconv.FormatInt(d.ExpireAt, 10),
-			d.From,
-			d.To,
-		})
-	}
-
-	utils.RespondWithCSV(c, "freezeresource.csv", headers, rows)
-}
-
-// ==================== Turnover ====================
-
-// GetTurnover handles GET /api/turnover
-func (h *StatsHandler) GetTurnover(c *gin.Context) {
-	var req models.TurnoverRequest
-	if err := c.ShouldBindQuery(&req); err != nil {
-		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
-		return
-	}
-
-	data, err := h.statsRepo.GetTurnover(req.From, req.To)
-	if err != nil {
-		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get turnover: "+err.Error())
-		return
-	}
-
-	utils.RespondWithSuccess(c, data)
-}
-
-// ExportTurnoverToCSV handles CSV export for turnover
-func (h *StatsHandler) ExportTurnoverToCSV(c *gin.Context, req models.CSVExportRequest) {
-	data, err := h.statsRepo.GetTurnover(req.From, req.To)
-	if err != nil {
-		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get data: "+err.Error())
-		return
-	}
-
-	headers := []string{"Date", "Turnover"}
-	var rows [][]string
-
-	for _, d := range data.Daily {
-		rows = append(rows, []string{
-			d.Date,
-			strconv.FormatInt(d.Turnover, 10),
-		})
-	}
-
-	utils.RespondWithCSV(c, "turnover.csv", headers, rows)
-}
-
-// ==================== Fund ====================
-
-// GetFund handles GET /api/fund
-func (h *StatsHandler) GetFund(c *gin.Context) {
-	var req models.FundRequest
-	if err := c.ShouldBindQuery(&req); err != nil {
-		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
-		return
-	}
-
-	if req.Limit <= 0 {
-		req.Limit = 20
-	}
-	if req.Limit > 200 {
-		req.Limit = 200
-	}
-
-	// Get from database
-	utils.RespondWithSuccess(c, gin.H{
-		"fund":  []interface{}{},
-		"total": 0,
-	})
-}
-
-// ==================== Ledger ====================
-
-// GetLedger handles GET /api/ledger
-func (h *StatsHandler) GetLedger(c *gin.Context) {
-	var req models.LedgerRequest
-	if err := c.ShouldBindQuery(&req); err != nil {
-		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
-		return
-	}
-
-	if req.Limit <= 0 {
-		req.Limit = 20
-	}
-	if req.Limit > 200 {
-		req.Limit = 200
-	}
-
-	// Get from database based on type
-	utils.RespondWithSuccess(c, gin.H{
-		"ledger": []interface{}{},
-		"total":  0,
-	})
-}
-
-// ==================== Helper Functions ====================
-
-func (h *StatsHandler) getMarketData() (price, marketCap, volume int64) {
-	// Get from external API or database
-	// This would be implemented with CoinMarketCap API
-	return 0, 0, 0
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/blockchain"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/storage/repository"
+	"github.com/lindaprotocol/grpc-api-gateway/pkg/lindapb"
+	"github.com/lindaprotocol/grpc-api-gateway/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// defaultContractStatRange is the contract statistics range returned when from is not given
+const defaultContractStatRange = 30 * 24 * time.Hour
+
+type StatsHandler struct {
+	blockchainClient *blockchain.Client
+	statsRepo        *repository.StatsRepository
+	tokenRepo        *repository.TokenRepository
+}
+
+func NewStatsHandler(client *blockchain.Client, statsRepo *repository.StatsRepository, tokenRepo *repository.TokenRepository) *StatsHandler {
+	return &StatsHandler{
+		blockchainClient: client,
+		statsRepo:        statsRepo,
+		tokenRepo:        tokenRepo,
+	}
+}
+
+// ==================== Homepage Bundle ====================
+
+// GetHomepageBundle handles GET /api/system/homepage-bundle
+func (h *StatsHandler) GetHomepageBundle(c *gin.Context) {
+	// Get from database or calculate
+	bundle, err := h.statsRepo.GetHomepageBundle()
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get homepage bundle: "+err.Error())
+		return
+	}
+
+	// Get market data
+	price, marketCap, volume := h.getMarketData()
+	bundle.PriceUSD = price
+	bundle.MarketCap = marketCap
+	bundle.Volume24h = volume
+
+	utils.RespondWithSuccess(c, bundle)
+}
+
+// ==================== Top 10 ====================
+
+// GetTop10 handles GET /api/top10
+func (h *StatsHandler) GetTop10(c *gin.Context) {
+	var req models.Top10Request
+	if err := c.ShouldBindQuery(&req); err != nil {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
+		return
+	}
+
+	// Get top witnesses
+	witnesses, err := h.blockchainClient.ListWitnesses(context.Background(), &lindapb.EmptyMessage{})
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get witnesses: "+err.Error())
+		return
+	}
+
+	// Get top accounts from database
+	accounts, err := h.statsRepo.GetTopAccounts(10)
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get accounts: "+err.Error())
+		return
+	}
+
+	// Get top tokens from database
+	tokens, err := h.statsRepo.GetTopTokens(10)
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get tokens: "+err.Error())
+		return
+	}
+
+	response := &models.Top10Response{
+		Witnesses: make([]models.WitnessResponse, 0),
+		Accounts:  accounts,
+		Tokens:    tokens,
+	}
+
+	// Convert witnesses
+	for i, w := range witnesses.Witnesses {
+		if i >= 10 {
+			break
+		}
+		response.Witnesses = append(response.Witnesses, models.WitnessResponse{
+			Address:   utils.MustHexToBase58(string(w.Address)),
+			VoteCount: w.VoteCount,
+			URL:       w.Url,
+		})
+	}
+
+	utils.RespondWithSuccess(c, response)
+}
+
+// ==================== Overview Statistics ====================
+
+// GetOverview handles GET /api/stats/overview
+// Returns the chain totals and 24h activity, or with type=hourly or type=daily the latest
+// buckets of the activity series, oldest first
+func (h *StatsHandler) GetOverview(c *gin.Context) {
+	var req models.OverviewRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
+		return
+	}
+
+	// Set defaults
+	switch req.Type {
+	case "", "summary", models.StatsIntervalHourly, models.StatsIntervalDaily:
+	default:
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid type, expected summary, hourly or daily")
+		return
+	}
+	if req.Limit <= 0 {
+		req.Limit = 30
+	}
+	if req.Limit > 720 {
+		req.Limit = 720
+	}
+
+	data, err := h.statsRepo.GetOverview(req.Type, req.Limit)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		utils.RespondWithError(c, http.StatusNotFound, "Overview not computed yet")
+		return
+	}
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get overview: "+err.Error())
+		return
+	}
+
+	utils.RespondWithSuccess(c, data)
+}
+
+// ==================== Energy Statistics ====================
+
+// GetEnergyStatistic handles GET /api/energystatistic
+// Returns the energy consumed by calls to a contract per day
+func (h *StatsHandler) GetEnergyStatistic(c *gin.Context) {
+	var req models.EnergyStatisticRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
+		return
+	}
+
+	if req.Address == "" {
+		utils.RespondWithError(c, http.StatusBadRequest, "Address is required")
+		return
+	}
+	req.From, req.To = contractStatRange(req.From, req.To)
+
+	stats, err := h.statsRepo.GetEnergyStatistic(req.Address, req.From, req.To)
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get energy statistic: "+err.Error())
+		return
+	}
+
+	utils.RespondWithSuccess(c, stats)
+}
+
+// GetEnergyDailyStatistic handles GET /api/energydailystatistic
+// Returns the energy consumed and burned by all contract calls per day
+func (h *StatsHandler) GetEnergyDailyStatistic(c *gin.Context) {
+	var req models.EnergyDailyStatisticRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
+		return
+	}
+	req.From, req.To = contractStatRange(req.From, req.To)
+
+	stats, err := h.statsRepo.GetEnergyDailyStatistic(req.From, req.To)
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get energy daily statistic: "+err.Error())
+		return
+	}
+
+	utils.RespondWithSuccess(c, stats)
+}
+
+// ==================== Trigger Statistics ====================
+
+// GetTriggerStatistic handles GET /api/triggerstatistic
+// Returns the calls to a contract per day
+func (h *StatsHandler) GetTriggerStatistic(c *gin.Context) {
+	req, ok := bindContractStatRequest(c)
+	if !ok {
+		return
+	}
+
+	stats, err := h.statsRepo.GetTriggerStatistic(req.Contract, req.From, req.To)
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get trigger statistic: "+err.Error())
+		return
+	}
+
+	utils.RespondWithSuccess(c, stats)
+}
+
+// GetTriggerAmountStatistic handles GET /api/triggeramountstatistic
+// Returns the LIND sent with calls to a contract per day
+func (h *StatsHandler) GetTriggerAmountStatistic(c *gin.Context) {
+	req, ok := bindContractStatRequest(c)
+	if !ok {
+		return
+	}
+
+	points, err := h.statsRepo.GetContractDailyStats(req.Contract, req.From, req.To)
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get trigger amount statistic: "+err.Error())
+		return
+	}
+
+	daily := make([]models.DailyStat, 0, len(points))
+	for _, point := range points {
+		daily = append(daily, models.DailyStat{Date: point.Date, Value: point.CallValue})
+	}
+
+	utils.RespondWithSuccess(c, gin.H{
+		"contract": req.Contract,
+		"total":    models.SumContractDailyPoints(points).CallValue,
+		"triggers": daily,
+	})
+}
+
+// ==================== Caller Statistics ====================
+
+// GetCallerAddressStatistic handles GET /api/calleraddressstatistic
+// Returns the 100 addresses that called a contract most
+func (h *StatsHandler) GetCallerAddressStatistic(c *gin.Context) {
+	req, ok := bindContractStatRequest(c)
+	if !ok {
+		return
+	}
+
+	stats, err := h.statsRepo.GetCallerAddressStatistic(req.Contract, req.From, req.To)
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get caller statistic: "+err.Error())
+		return
+	}
+
+	utils.RespondWithSuccess(c, stats)
+}
+
+// ==================== One Contract Statistics ====================
+
+// GetOneContractEnergyStatistic handles GET /api/onecontractenergystatistic
+// Returns the energy consumed by calls to a contract, split between callers and the contract owner
+func (h *StatsHandler) GetOneContractEnergyStatistic(c *gin.Context) {
+	req, ok := bindContractStatRequest(c)
+	if !ok {
+		return
+	}
+
+	points, err := h.statsRepo.GetContractDailyStats(req.Contract, req.From, req.To)
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get energy statistic: "+err.Error())
+		return
+	}
+
+	totals := models.SumContractDailyPoints(points)
+	utils.RespondWithSuccess(c, gin.H{
+		"contract":      req.Contract,
+		"total":         totals.EnergyUsed,
+		"caller_energy": totals.CallerEnergy,
+		"owner_energy":  totals.OwnerEnergy,
+		"energy_fee":    totals.EnergyFee,
+		"daily":         points,
+	})
+}
+
+// GetOneContractTriggerStatistic handles GET /api/onecontracttriggerstatistic
+// Returns the calls to a contract and their failure rate
+func (h *StatsHandler) GetOneContractTriggerStatistic(c *gin.Context) {
+	req, ok := bindContractStatRequest(c)
+	if !ok {
+		return
+	}
+
+	points, err := h.statsRepo.GetContractDailyStats(req.Contract, req.From, req.To)
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get trigger statistic: "+err.Error())
+		return
+	}
+
+	totals := models.SumContractDailyPoints(points)
+	utils.RespondWithSuccess(c, gin.H{
+		"contract":     req.Contract,
+		"count":        totals.Triggers,
+		"failed":       totals.Failed,
+		"failure_rate": totals.FailureRate,
+		"daily":        points,
+	})
+}
+
+// GetOneContractCallerStatistic handles GET /api/onecontractcallerstatistic
+// Returns the unique callers of a contract per day and its top callers
+func (h *StatsHandler) GetOneContractCallerStatistic(c *gin.Context) {
+	req, ok := bindContractStatRequest(c)
+	if !ok {
+		return
+	}
+
+	points, err := h.statsRepo.GetContractDailyStats(req.Contract, req.From, req.To)
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get caller statistic: "+err.Error())
+		return
+	}
+
+	callers, total, err := h.statsRepo.GetContractCallers(req.Contract, req.From, req.To, 0, 10)
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get callers: "+err.Error())
+		return
+	}
+
+	daily := make([]models.DailyStat, 0, len(points))
+	for _, point := range points {
+		daily = append(daily, models.DailyStat{Date: point.Date, Value: point.UniqueCallers})
+	}
+
+	utils.RespondWithSuccess(c, gin.H{
+		"contract":      req.Contract,
+		"total_callers": total,
+		"callers":       callers,
+		"daily":         daily,
+	})
+}
+
+// GetOneContractCallers handles GET /api/onecontractcallers
+// Returns the callers of a contract, most active first
+func (h *StatsHandler) GetOneContractCallers(c *gin.Context) {
+	req, ok := bindContractStatRequest(c)
+	if !ok {
+		return
+	}
+
+	// Set defaults
+	if req.Limit <= 0 {
+		req.Limit = 20
+	}
+	if req.Limit > 200 {
+		req.Limit = 200
+	}
+
+	callers, total, err := h.statsRepo.GetContractCallers(req.Contract, req.From, req.To, req.Start, req.Limit)
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get callers: "+err.Error())
+		return
+	}
+
+	utils.RespondWithSuccess(c, gin.H{
+		"callers": callers,
+		"total":   total,
+	})
+}
+
+// bindContractStatRequest binds contract statistic query parameters and defaults the range
+func bindContractStatRequest(c *gin.Context) (*models.ContractStatRequest, bool) {
+	var req models.ContractStatRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
+		return nil, false
+	}
+
+	if req.Contract == "" {
+		utils.RespondWithError(c, http.StatusBadRequest, "Contract address is required")
+		return nil, false
+	}
+	req.From, req.To = contractStatRange(req.From, req.To)
+
+	return &req, true
+}
+
+// contractStatRange defaults a statistics range to the defaultContractStatRange before now
+func contractStatRange(from, to int64) (int64, int64) {
+	if to <= 0 {
+		to = time.Now().UnixMilli()
+	}
+	if from <= 0 {
+		from = to - defaultContractStatRange.Milliseconds()
+	}
+	return from, to
+}
+
+// ==================== Freeze Resource ====================
+
+// GetFreezeResource handles GET /api/freezeresource
+func (h *StatsHandler) GetFreezeResource(c *gin.Context) {
+	var req models.FreezeResourceRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
+		return
+	}
+
+	if req.Address == "" {
+		utils.RespondWithError(c, http.StatusBadRequest, "Address is required")
+		return
+	}
+
+	data, err := h.statsRepo.GetFreezeResource(req.Address, req.Type)
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get freeze resource: "+err.Error())
+		return
+	}
+
+	utils.RespondWithSuccess(c, data)
+}
+
+// ExportFreezeResourceToCSV handles CSV export for freeze resource
+func (h *StatsHandler) ExportFreezeResourceToCSV(c *gin.Context, req models.CSVExportRequest) {
+	// Get data
+	data, err := h.statsRepo.GetFreezeResource(req.Address, "")
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get data: "+err.Error())
+		return
+	}
+
+	headers := []string{"Type", "Amount", "Expire At", "From", "To"}
+	var rows [][]string
+
+	for _, f := range data.Frozen {
+		rows = append(rows, []string{
+			f.Type,
+			strconv.FormatInt(f.Amount, 10),
+			strconv.FormatInt(f.ExpireAt, 10),
+			"",
+			"",
+		})
+	}
+
+	for _, d := range data.Delegated {
+		rows = append(rows, []string{
+			d.Type,
+			strconv.FormatInt(d.Amount, 10),
+			strconv.FormatInt(d.ExpireAt, 10),
+			d.From,
+			d.To,
+		})
+	}
+
+	utils.RespondWithCSV(c, "freezeresource.csv", headers, rows)
+}
+
+// ==================== Turnover ====================
+
+// GetTurnover handles GET /api/turnover
+func (h *StatsHandler) GetTurnover(c *gin.Context) {
+	var req models.TurnoverRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
+		return
+	}
+
+	data, err := h.statsRepo.GetTurnover(req.From, req.To)
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get turnover: "+err.Error())
+		return
+	}
+
+	utils.RespondWithSuccess(c, data)
+}
+
+// ExportTurnoverToCSV handles CSV export for turnover
+func (h *StatsHandler) ExportTurnoverToCSV(c *gin.Context, req models.CSVExportRequest) {
+	data, err := h.statsRepo.GetTurnover(req.From, req.To)
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get data: "+err.Error())
+		return
+	}
+
+	headers := []string{"Date", "Turnover"}
+	var rows [][]string
+
+	for _, d := range data.Daily {
+		rows = append(rows, []string{
+			d.Date,
+			strconv.FormatInt(d.Turnover, 10),
+		})
+	}
+
+	utils.RespondWithCSV(c, "turnover.csv", headers, rows)
+}
+
+// ==================== Fund ====================
+
+// GetFund handles GET /api/fund
+func (h *StatsHandler) GetFund(c *gin.Context) {
+	var req models.FundRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
+		return
+	}
+
+	if req.Limit <= 0 {
+		req.Limit = 20
+	}
+	if req.Limit > 200 {
+		req.Limit = 200
+	}
+
+	// Get from database
+	utils.RespondWithSuccess(c, gin.H{
+		"fund":  []interface{}{},
+		"total": 0,
+	})
+}
+
+// ==================== Ledger ====================
+
+// GetLedger handles GET /api/ledger
+func (h *StatsHandler) GetLedger(c *gin.Context) {
+	var req models.LedgerRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
+		return
+	}
+
+	if req.Limit <= 0 {
+		req.Limit = 20
+	}
+	if req.Limit > 200 {
+		req.Limit = 200
+	}
+
+	// Get from database based on type
+	utils.RespondWithSuccess(c, gin.H{
+		"ledger": []interface{}{},
+		"total":  0,
+	})
+}
+
+// ==================== Helper Functions ====================
+
+// getMarketData returns the latest LIND price feed quote, zeros when there is none
+func (h *StatsHandler) getMarketData() (price, marketCap, volume float64) {
+	quote, err := h.tokenRepo.GetTokenPrice("LIND", 0)
+	if err != nil {
+		return 0, 0, 0
+	}
+	return quote.Price, quote.MarketCap, quote.Volume24h
 }
\ No newline at end of file
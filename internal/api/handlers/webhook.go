@@ -0,0 +1,221 @@
+package handlers
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/storage/repository"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/webhook"
+	"github.com/lindaprotocol/grpc-api-gateway/pkg/utils"
+	"gorm.io/gorm"
+)
+
+type WebhookHandler struct {
+	webhookRepo      *repository.WebhookRepository
+	maxSubscriptions int
+}
+
+func NewWebhookHandler(webhookRepo *repository.WebhookRepository, maxSubscriptions int) *WebhookHandler {
+	return &WebhookHandler{
+		webhookRepo:      webhookRepo,
+		maxSubscriptions: maxSubscriptions,
+	}
+}
+
+// CreateSubscription handles POST /api/webhooks and POST /api/monitor
+// Registers a callback for an address, contract or event filter
+func (h *WebhookHandler) CreateSubscription(c *gin.Context) {
+	user, ok := authenticatedUser(c)
+	if !ok || user.APIKeyID == "" {
+		utils.RespondWithError(c, http.StatusUnauthorized, "An API key is required to manage webhooks")
+		return
+	}
+
+	var req models.MonitorRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
+		return
+	}
+	if err := webhook.ValidateCallbackURL(c.Request.Context(), req.CallbackURL); err != nil {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid callback_url: "+err.Error())
+		return
+	}
+
+	sub := &models.WebhookSubscription{
+		ID:                uuid.New().String(),
+		APIKeyID:          user.APIKeyID,
+		UserID:            user.ID,
+		CallbackURL:       req.CallbackURL,
+		Address:           req.Address,
+		ContractAddress:   req.ContractAddress,
+		EventName:         req.Event,
+		WaitForSolidified: req.WaitForSolidified,
+		Active:            true,
+		CreatedAt:         time.Now(),
+		UpdatedAt:         time.Now(),
+	}
+
+	switch {
+	case req.Event != "":
+		sub.FilterType = models.WebhookFilterEvent
+	case req.ContractAddress != "":
+		sub.FilterType = models.WebhookFilterContract
+	case req.Address != "":
+		sub.FilterType = models.WebhookFilterAddress
+	default:
+		utils.RespondWithError(c, http.StatusBadRequest, "One of address, contract_address or event is required")
+		return
+	}
+	if req.Address != "" && !utils.IsValidBase58Address(req.Address) {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid address format")
+		return
+	}
+	if req.ContractAddress != "" && !utils.IsValidBase58Address(req.ContractAddress) {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid contract address format")
+		return
+	}
+
+	if h.maxSubscriptions > 0 {
+		count, err := h.webhookRepo.CountSubscriptions(user.APIKeyID)
+		if err != nil {
+			utils.RespondWithError(c, http.StatusInternalServerError, "Failed to count subscriptions: "+err.Error())
+			return
+		}
+		if count >= int64(h.maxSubscriptions) {
+			utils.RespondWithError(c, http.StatusConflict, "Subscription limit reached for this API key")
+			return
+		}
+	}
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to generate secret: "+err.Error())
+		return
+	}
+	sub.Secret = hex.EncodeToString(secret)
+
+	if err := h.webhookRepo.CreateSubscription(sub); err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to create subscription: "+err.Error())
+		return
+	}
+
+	utils.RespondWithCreated(c, &models.WebhookSubscriptionResponse{
+		WebhookSubscription: sub,
+		Secret:              sub.Secret,
+	})
+}
+
+// ListSubscriptions handles GET /api/webhooks
+// Returns the subscriptions of the calling API key
+func (h *WebhookHandler) ListSubscriptions(c *gin.Context) {
+	user, ok := authenticatedUser(c)
+	if !ok || user.APIKeyID == "" {
+		utils.RespondWithError(c, http.StatusUnauthorized, "An API key is required to manage webhooks")
+		return
+	}
+
+	subs, err := h.webhookRepo.GetSubscriptionsByAPIKey(user.APIKeyID)
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get subscriptions: "+err.Error())
+		return
+	}
+
+	utils.RespondWithSuccess(c, subs)
+}
+
+// DeleteSubscription handles DELETE /api/webhooks/:id
+// Removes a subscription and drops its pending deliveries
+func (h *WebhookHandler) DeleteSubscription(c *gin.Context) {
+	user, ok := authenticatedUser(c)
+	if !ok || user.APIKeyID == "" {
+		utils.RespondWithError(c, http.StatusUnauthorized, "An API key is required to manage webhooks")
+		return
+	}
+
+	if err := h.webhookRepo.DeleteSubscription(c.Param("id"), user.APIKeyID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			utils.RespondWithError(c, http.StatusNotFound, "Subscription not found")
+			return
+		}
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to delete subscription: "+err.Error())
+		return
+	}
+
+	utils.RespondWithSuccess(c, gin.H{"id": c.Param("id")})
+}
+
+// GetDeliveries handles GET /api/webhooks/:id/deliveries
+// Returns the delivery log of a subscription, optionally filtered by status
+func (h *WebhookHandler) GetDeliveries(c *gin.Context) {
+	user, ok := authenticatedUser(c)
+	if !ok || user.APIKeyID == "" {
+		utils.RespondWithError(c, http.StatusUnauthorized, "An API key is required to manage webhooks")
+		return
+	}
+
+	var req models.WebhookDeliveryRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
+		return
+	}
+
+	// Set defaults
+	if req.Limit <= 0 {
+		req.Limit = 20
+	}
+	if req.Limit > 200 {
+		req.Limit = 200
+	}
+
+	sub, err := h.webhookRepo.GetSubscription(c.Param("id"), user.APIKeyID)
+	if err != nil {
+		utils.RespondWithError(c, http.StatusNotFound, "Subscription not found")
+		return
+	}
+
+	deliveries, total, err := h.webhookRepo.GetDeliveries(sub.ID, req.Status, req.Start, req.Limit)
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get deliveries: "+err.Error())
+		return
+	}
+
+	utils.RespondWithMeta(c, deliveries, gin.H{
+		"total": total,
+		"start": req.Start,
+		"limit": req.Limit,
+	})
+}
+
+// ReplayDelivery handles POST /api/webhooks/:id/deliveries/:delivery_id/replay
+// Queues a past delivery again, including dead-lettered ones
+func (h *WebhookHandler) ReplayDelivery(c *gin.Context) {
+	user, ok := authenticatedUser(c)
+	if !ok || user.APIKeyID == "" {
+		utils.RespondWithError(c, http.StatusUnauthorized, "An API key is required to manage webhooks")
+		return
+	}
+
+	sub, err := h.webhookRepo.GetSubscription(c.Param("id"), user.APIKeyID)
+	if err != nil {
+		utils.RespondWithError(c, http.StatusNotFound, "Subscription not found")
+		return
+	}
+
+	delivery, err := h.webhookRepo.ReplayDelivery(c.Param("delivery_id"), sub.ID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			utils.RespondWithError(c, http.StatusNotFound, "Delivery not found")
+			return
+		}
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to replay delivery: "+err.Error())
+		return
+	}
+
+	utils.RespondWithAccepted(c, delivery)
+}
@@ -0,0 +1,322 @@
+package handlers
+
+import (
+	"context"
+	"encoding/hex"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/blockchain"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/storage/repository"
+	"github.com/lindaprotocol/grpc-api-gateway/pkg/lindapb"
+	"github.com/lindaprotocol/grpc-api-gateway/pkg/utils"
+)
+
+const (
+	// defaultWitnessCycles is the number of maintenance cycles analysed when cycles is not given
+	defaultWitnessCycles = 28
+	// maxWitnessCycles is the maximum number of maintenance cycles analysed
+	maxWitnessCycles = 360
+	// defaultBrokerage is the brokerage of witnesses that never changed it
+	defaultBrokerage = 20
+	// rewardedWitnesses is the number of witnesses sharing the vote reward
+	rewardedWitnesses = 127
+	// witnessHistoryLimit is the number of brokerage changes returned
+	witnessHistoryLimit = 20
+)
+
+type WitnessHandler struct {
+	blockchainClient *blockchain.Client
+	witnessRepo      *repository.WitnessRepository
+}
+
+func NewWitnessHandler(client *blockchain.Client, witnessRepo *repository.WitnessRepository) *WitnessHandler {
+	return &WitnessHandler{
+		blockchainClient: client,
+		witnessRepo:      witnessRepo,
+	}
+}
+
+// witnessEconomics holds the chain state used to rank witnesses and estimate voter rewards
+type witnessEconomics struct {
+	witnesses       []*lindapb.Witness // ordered by votes
+	activeCount     int64
+	rewardedVotes   int64
+	interval        int64
+	nextMaintenance int64
+	payPerBlock     int64
+	votePayPerBlock int64
+}
+
+// loadWitnessEconomics reads the witness list, the maintenance schedule and the reward parameters
+func (h *WitnessHandler) loadWitnessEconomics(ctx context.Context) (*witnessEconomics, error) {
+	witnesses, err := h.blockchainClient.ListWitnesses(ctx, &lindapb.EmptyMessage{})
+	if err != nil {
+		return nil, err
+	}
+	next, err := h.blockchainClient.GetNextMaintenanceTime(ctx, &lindapb.EmptyMessage{})
+	if err != nil {
+		return nil, err
+	}
+	params, err := h.blockchainClient.GetChainParameters(ctx, &lindapb.EmptyMessage{})
+	if err != nil {
+		return nil, err
+	}
+
+	e := &witnessEconomics{
+		witnesses:       witnesses.Witnesses,
+		interval:        (6 * time.Hour).Milliseconds(),
+		nextMaintenance: next.Num,
+	}
+	for _, p := range params.ChainParameter {
+		switch p.Key {
+		case "getMaintenanceTimeInterval":
+			if p.Value > 0 {
+				e.interval = p.Value
+			}
+		case "getWitnessPayPerBlock":
+			e.payPerBlock = p.Value
+		case "getWitness127PayPerBlock":
+			e.votePayPerBlock = p.Value
+		}
+	}
+
+	sort.SliceStable(e.witnesses, func(a, b int) bool {
+		return e.witnesses[a].VoteCount > e.witnesses[b].VoteCount
+	})
+	for i, w := range e.witnesses {
+		if w.IsJobs {
+			e.activeCount++
+		}
+		if i < rewardedWitnesses {
+			e.rewardedVotes += w.VoteCount
+		}
+	}
+	return e, nil
+}
+
+// voterAPR estimates the yearly return in percent of votes for a witness from
+// the block and vote rewards it earns and the share kept as brokerage
+func (e *witnessEconomics) voterAPR(votes int64, rank int, active bool, productivity float64, brokerage int64) float64 {
+	if votes <= 0 {
+		return 0
+	}
+	blocksPerYear := float64(365 * 24 * time.Hour / models.BlockInterval)
+
+	var reward float64
+	if active && e.activeCount > 0 {
+		reward += blocksPerYear / float64(e.activeCount) * float64(e.payPerBlock) * productivity
+	}
+	if rank <= rewardedWitnesses && e.rewardedVotes > 0 {
+		reward += blocksPerYear * float64(e.votePayPerBlock) * float64(votes) / float64(e.rewardedVotes)
+	}
+
+	// One vote is one LIND staked
+	return reward * float64(100-brokerage) / 100 / (float64(votes) * 1e6) * 100
+}
+
+// productivity is the share of expected blocks produced, 1 when nothing was expected
+func productivity(produced, missed int64) float64 {
+	if produced+missed == 0 {
+		return 1
+	}
+	return float64(produced) / float64(produced+missed)
+}
+
+// GetWitnessStats handles GET /api/witness/:address/stats
+// Returns the block production, votes, brokerage and estimated voter APR of a witness
+func (h *WitnessHandler) GetWitnessStats(c *gin.Context) {
+	address := c.Param("address")
+	if !utils.IsValidBase58Address(address) {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid address format")
+		return
+	}
+	hexAddr, err := utils.Base58ToHex(address)
+	if err != nil {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid address format")
+		return
+	}
+	rawAddr, err := hex.DecodeString(hexAddr)
+	if err != nil {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid address format")
+		return
+	}
+
+	var req models.WitnessStatsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		utils.RespondWithError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Set defaults
+	if req.Cycles <= 0 {
+		req.Cycles = defaultWitnessCycles
+	}
+	if req.Cycles > maxWitnessCycles {
+		req.Cycles = maxWitnessCycles
+	}
+
+	ctx := context.Background()
+	economics, err := h.loadWitnessEconomics(ctx)
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get witnesses: "+err.Error())
+		return
+	}
+
+	stats := &models.WitnessStatsResponse{Address: address}
+	found := false
+	for i, w := range economics.witnesses {
+		if hex.EncodeToString(w.Address) == hexAddr {
+			stats.URL = w.Url
+			stats.VoteCount = w.VoteCount
+			stats.Rank = i + 1
+			stats.Active = w.IsJobs
+			found = true
+			break
+		}
+	}
+	if !found {
+		utils.RespondWithError(c, http.StatusNotFound, "Witness not found")
+		return
+	}
+
+	brokerage, err := h.blockchainClient.GetBrokerage(ctx, &lindapb.Account{Address: rawAddr})
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get brokerage: "+err.Error())
+		return
+	}
+	stats.Brokerage = brokerage.Num
+
+	reward, err := h.blockchainClient.GetReward(ctx, &lindapb.Account{Address: rawAddr})
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get reward: "+err.Error())
+		return
+	}
+	stats.PendingReward = reward.Num
+
+	// Production per maintenance cycle
+	fromTime := economics.nextMaintenance - int64(req.Cycles)*economics.interval
+	stats.Cycles, err = h.witnessRepo.GetCycleStats(address, fromTime, economics.nextMaintenance, economics.interval)
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get cycle stats: "+err.Error())
+		return
+	}
+	for _, cycle := range stats.Cycles {
+		stats.Produced += cycle.Produced
+		stats.Missed += cycle.Missed
+	}
+	stats.Productivity = productivity(stats.Produced, stats.Missed)
+
+	stats.AverageBlockGap, err = h.witnessRepo.GetAverageBlockGap(address, fromTime)
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get block gap: "+err.Error())
+		return
+	}
+
+	stats.VoteHistory, err = h.witnessRepo.GetVoteHistory(address, req.Cycles)
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get vote history: "+err.Error())
+		return
+	}
+
+	stats.BrokerageHistory, err = h.witnessRepo.GetBrokerageHistory(address, witnessHistoryLimit)
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get brokerage history: "+err.Error())
+		return
+	}
+
+	stats.EstimatedVoterAPR = economics.voterAPR(stats.VoteCount, stats.Rank, stats.Active, stats.Productivity, stats.Brokerage)
+
+	utils.RespondWithSuccess(c, stats)
+}
+
+// GetWitnessRanking handles GET /api/witness/ranking
+// Returns witnesses ranked by votes, productivity or estimated voter APR
+func (h *WitnessHandler) GetWitnessRanking(c *gin.Context) {
+	var req models.WitnessRankingRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		utils.RespondWithError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Set defaults
+	if req.Sort == "" {
+		req.Sort = "votes"
+	}
+	if req.Sort != "votes" && req.Sort != "productivity" && req.Sort != "apr" {
+		utils.RespondWithError(c, http.StatusBadRequest, "Invalid sort, expected votes, productivity or apr")
+		return
+	}
+	if req.Cycles <= 0 {
+		req.Cycles = defaultWitnessCycles
+	}
+	if req.Cycles > maxWitnessCycles {
+		req.Cycles = maxWitnessCycles
+	}
+	if req.Limit <= 0 {
+		req.Limit = 20
+	}
+	if req.Limit > 200 {
+		req.Limit = 200
+	}
+
+	economics, err := h.loadWitnessEconomics(context.Background())
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get witnesses: "+err.Error())
+		return
+	}
+
+	fromTime := economics.nextMaintenance - int64(req.Cycles)*economics.interval
+	production, err := h.witnessRepo.GetProduction(fromTime)
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get production: "+err.Error())
+		return
+	}
+
+	brokerages, err := h.witnessRepo.GetLatestBrokerages()
+	if err != nil {
+		utils.RespondWithError(c, http.StatusInternalServerError, "Failed to get brokerages: "+err.Error())
+		return
+	}
+
+	ranking := make([]*models.WitnessRankingEntry, 0, len(economics.witnesses))
+	for i, w := range economics.witnesses {
+		entry := &models.WitnessRankingEntry{
+			Address:   utils.MustHexToBase58(hex.EncodeToString(w.Address)),
+			URL:       w.Url,
+			VoteCount: w.VoteCount,
+			Rank:      i + 1,
+			Active:    w.IsJobs,
+			Brokerage: defaultBrokerage,
+		}
+		if p, ok := production[entry.Address]; ok {
+			entry.Produced = p.Produced
+			entry.Missed = p.Missed
+		}
+		if brokerage, ok := brokerages[entry.Address]; ok {
+			entry.Brokerage = int64(brokerage)
+		}
+		entry.Productivity = productivity(entry.Produced, entry.Missed)
+		entry.EstimatedVoterAPR = economics.voterAPR(entry.VoteCount, entry.Rank, entry.Active, entry.Productivity, entry.Brokerage)
+		ranking = append(ranking, entry)
+	}
+
+	switch req.Sort {
+	case "productivity":
+		sort.SliceStable(ranking, func(a, b int) bool {
+			return ranking[a].Productivity > ranking[b].Productivity
+		})
+	case "apr":
+		sort.SliceStable(ranking, func(a, b int) bool {
+			return ranking[a].EstimatedVoterAPR > ranking[b].EstimatedVoterAPR
+		})
+	}
+	if len(ranking) > req.Limit {
+		ranking = ranking[:req.Limit]
+	}
+
+	utils.RespondWithSuccess(c, ranking)
+}
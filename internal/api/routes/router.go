@@ -1,668 +1,866 @@
-package routes
-
-import (
-	"github.com/gin-gonic/gin"
-	"github.com/lindaprotocol/grpc-api-gateway/internal/api/handlers"
-	"github.com/lindaprotocol/grpc-api-gateway/internal/api/middleware"
-	"github.com/lindaprotocol/grpc-api-gateway/internal/config"
-	"github.com/lindaprotocol/grpc-api-gateway/internal/services/auth"
-	"github.com/lindaprotocol/grpc-api-gateway/internal/services/blockchain"
-	"github.com/lindaprotocol/grpc-api-gateway/internal/services/cache"
-	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
-	"github.com/lindaprotocol/grpc-api-gateway/internal/services/storage/repository"
-)
-
-type Router struct {
-	engine           *gin.Engine
-	config           *config.Config
-	blockchainClient *blockchain.Client
-	authService      *auth.Service
-	cacheClient      *cache.RedisClient
-	
-	// Handlers
-	accountHandler     *handlers.AccountHandler
-	blockHandler       *handlers.BlockHandler
-	transactionHandler *handlers.TransactionHandler
-	tokenHandler       *handlers.TokenHandler
-	contractHandler    *handlers.ContractHandler
-	nodeHandler        *handlers.NodeHandler
-	statsHandler       *handlers.StatsHandler
-	searchHandler      *handlers.SearchHandler
-	eventHandler       *handlers.EventHandler
-}
-
-func NewRouter(
-	cfg *config.Config,
-	client *blockchain.Client,
-	authSvc *auth.Service,
-	cacheClient *cache.RedisClient,
-	accountRepo *repository.AccountRepository,
-	blockRepo *repository.BlockRepository,
-	txRepo *repository.TransactionRepository,
-	tokenRepo *repository.TokenRepository,
-	eventRepo *repository.EventRepository,
-	tagRepo *repository.TagRepository,
-	statsRepo *repository.StatsRepository,
-) *Router {
-	router := &Router{
-		engine:           gin.New(),
-		config:           cfg,
-		blockchainClient: client,
-		authService:      authSvc,
-		cacheClient:      cacheClient,
-	}
-
-	// Initialize handlers
-	router.accountHandler = handlers.NewAccountHandler(client, accountRepo, tagRepo)
-	router.blockHandler = handlers.NewBlockHandler(client, blockRepo)
-	router.transactionHandler = handlers.NewTransactionHandler(client, txRepo)
-	router.tokenHandler = handlers.NewTokenHandler(client, tokenRepo)
-	router.contractHandler = handlers.NewContractHandler(client)
-	router.nodeHandler = handlers.NewNodeHandler(client)
-	router.statsHandler = handlers.NewStatsHandler(client, statsRepo)
-	router.searchHandler = handlers.NewSearchHandler(client, accountRepo, blockRepo, txRepo, tokenRepo)
-	router.eventHandler = handlers.NewEventHandler(client, eventRepo)
-
-	router.setupMiddleware()
-	router.setupRoutes()
-
-	return router
-}
-
-func (r *Router) setupMiddleware() {
-	// Recovery middleware
-	r.engine.Use(gin.Recovery())
-
-	// Logger middleware
-	r.engine.Use(middleware.LoggerMiddleware(r.config.Logging))
-
-	// CORS middleware
-	r.engine.Use(middleware.CorsMiddleware(r.config.CORS.AllowedOrigins))
-
-	// Rate limiting middleware
-	r.engine.Use(middleware.RateLimitMiddleware(r.cacheClient, r.config.RateLimit))
-
-	// Auth middleware
-	r.engine.Use(middleware.AuthMiddleware(r.authService, r.config.Auth))
-
-	// Allowlist middleware
-	r.engine.Use(middleware.AllowlistMiddleware(r.authService))
-
-	// Response interceptor
-	r.engine.Use(middleware.ResponseInterceptor())
-}
-
-func (r *Router) setupRoutes() {
-	// Health check
-	r.engine.GET("/health", r.handleHealth)
-
-	// Metrics
-	r.engine.GET("/metrics", r.handleMetrics)
-
-	// API v1 routes (Event Query Service style)
-	v1 := r.engine.Group("/v1")
-	{
-		// Transactions
-		v1.GET("/transactions", r.transactionHandler.GetTransactionsEvent)
-		v1.GET("/transactions/:hash", r.transactionHandler.GetTransactionByHashEvent)
-		
-		// Transfers
-		v1.GET("/transfers", r.tokenHandler.GetTransfersEvent)
-		v1.GET("/transfers/:hash", r.tokenHandler.GetTransferByHashEvent)
-		
-		// Events
-		v1.GET("/events", r.eventHandler.GetEvents)
-		v1.GET("/events/transaction/:transaction_id", r.eventHandler.GetEventsByTransactionId)
-		v1.GET("/events/:contract_address", r.eventHandler.GetEventsByContractAddress)
-		v1.GET("/events/contract/:contract_address/:event_name", r.eventHandler.GetEventsByContractAndEventName)
-		v1.GET("/events/contract/:contract_address/:event_name/:block_number", r.eventHandler.GetEventsByContractEventAndBlock)
-		v1.GET("/events/timestamp", r.eventHandler.GetEventsByTimestamp)
-		v1.GET("/events/confirmed", r.eventHandler.GetConfirmedEvents)
-		
-		// Blocks
-		v1.GET("/blocks/:hash", r.blockHandler.GetBlockByHashEvent)
-		v1.GET("/blocks", r.blockHandler.GetBlocksEvent)
-		v1.GET("/blocks/latestSolidifiedBlockNumber", r.blockHandler.GetLatestSolidifiedBlockNumber)
-		v1.GET("/blocks/:blockNum/stats", r.blockHandler.GetBlockStats)
-		
-		// Contract logs
-		v1.GET("/contractlogs", r.contractHandler.GetContractLogs)
-		v1.GET("/contractlogs/transaction/:transaction_id", r.contractHandler.GetContractLogsByTransactionId)
-		v1.GET("/contractlogs/contract/:contract_address", r.contractHandler.GetContractLogsByContractAddress)
-		v1.POST("/contract/transaction/:transaction_id", r.contractHandler.GetContractWithAbi)
-		v1.POST("/contract/contractAddress/:contract_address", r.contractHandler.GetContractByAddressWithAbi)
-		
-		// Accounts (v1 style)
-		v1.GET("/accounts/:address", r.accountHandler.GetAccountV1)
-		v1.GET("/accounts/:address/transactions", r.transactionHandler.GetAccountTransactionsV1)
-		v1.GET("/accounts/:address/transactions/lrc20", r.tokenHandler.GetAccountLRC20TransactionsV1)
-		v1.GET("/accounts/:address/internal-transactions", r.transactionHandler.GetAccountInternalTransactionsV1)
-		v1.GET("/accounts/:address/lrc20/balance", r.tokenHandler.GetAccountLRC20BalanceV1)
-		
-		// Assets (v1 style)
-		v1.GET("/assets", r.tokenHandler.GetAssetsV1)
-		v1.GET("/assets/:name/list", r.tokenHandler.GetAssetsByNameV1)
-		v1.GET("/assets/:identifier", r.tokenHandler.GetAssetsByIdentifierV1)
-		
-		// Contracts (v1 style)
-		v1.GET("/contracts/:contractAddress/transactions", r.transactionHandler.GetContractTransactionsV1)
-		v1.GET("/accounts/:contractAddress/internal-transactions", r.transactionHandler.GetContractInternalTransactionsV1)
-		v1.GET("/contracts/:contractAddress/tokens", r.tokenHandler.GetContractTokensV1)
-	}
-
-	// Lindascan custom API routes
-	api := r.engine.Group("/api")
-	{
-		// System
-		api.GET("/system/homepage-bundle", r.statsHandler.GetHomepageBundle)
-		api.POST("/system/proxy", r.handleProxy)
-		
-		// Node map
-		api.GET("/nodemap", r.nodeHandler.GetNodeMap)
-		
-		// Top 10
-		api.GET("/top10", r.statsHandler.GetTop10)
-		
-		// Token APIs
-		api.GET("/token", r.tokenHandler.GetTokens)
-		api.GET("/token/:id", r.tokenHandler.GetTokenById)
-		api.GET("/token/price", r.tokenHandler.GetTokenPrice)
-		api.GET("/tokens/overview", r.tokenHandler.GetTokensOverview)
-		api.GET("/token_lrc20", r.tokenHandler.GetLRC20Tokens)
-		api.GET("/token_lrc20/:contract", r.tokenHandler.GetLRC20TokenByContract)
-		api.GET("/token_lrc20/holders", r.tokenHandler.GetTokenHolders)
-		api.GET("/token_lrc20/transfers", r.tokenHandler.GetTokenTransfers)
-		api.GET("/tokens/position-distribution", r.tokenHandler.GetTokenPositionDistribution)
-		api.GET("/tokens/participateassetissue", r.tokenHandler.GetParticipateAssetIssue)
-		
-		// Special tokens
-		api.GET("/wink/fund", r.tokenHandler.GetWinkFund)
-		api.GET("/wink/graphic", r.tokenHandler.GetWinkGraphic)
-		api.GET("/jst/fund", r.tokenHandler.GetJSTFund)
-		api.GET("/jst/graphic", r.tokenHandler.GetJSTGraphic)
-		api.GET("/bittorrent/graphic", r.tokenHandler.GetBitTorrentGraphic)
-		
-		// Asset transfers
-		api.GET("/asset/transfer", r.tokenHandler.GetAssetTransfer)
-		
-		// Account APIs
-		api.GET("/account/list", r.accountHandler.GetAccountList)
-		api.GET("/account/resource", r.accountHandler.GetAccountResourceInfo)
-		api.GET("/account-proposal", r.accountHandler.GetAccountProposals)
-		
-		// Statistics
-		api.GET("/stats/overview", r.statsHandler.GetOverview)
-		api.GET("/energystatistic", r.statsHandler.GetEnergyStatistic)
-		api.GET("/triggerstatistic", r.statsHandler.GetTriggerStatistic)
-		api.GET("/calleraddressstatistic", r.statsHandler.GetCallerAddressStatistic)
-		api.GET("/energydailystatistic", r.statsHandler.GetEnergyDailyStatistic)
-		api.GET("/triggeramountstatistic", r.statsHandler.GetTriggerAmountStatistic)
-		api.GET("/freezeresource", r.statsHandler.GetFreezeResource)
-		api.GET("/turnover", r.statsHandler.GetTurnover)
-		api.GET("/onecontractenergystatistic", r.statsHandler.GetOneContractEnergyStatistic)
-		api.GET("/onecontracttriggerstatistic", r.statsHandler.GetOneContractTriggerStatistic)
-		api.GET("/onecontractcallerstatistic", r.statsHandler.GetOneContractCallerStatistic)
-		api.GET("/onecontractcallers", r.statsHandler.GetOneContractCallers)
-		
-		// Block and transaction
-		api.GET("/block", r.blockHandler.GetBlocksV2)
-		api.GET("/transaction", r.transactionHandler.GetTransactionsV2)
-		api.GET("/internal-transaction", r.transactionHandler.GetInternalTransactions)
-		api.GET("/contracts/transaction", r.transactionHandler.GetContractTransactions)
-		api.GET("/lrc10lrc20-transfer", r.tokenHandler.GetLRC10LRC20Transfers)
-		api.GET("/contract_account_history", r.contractHandler.GetContractAccountHistory)
-		api.GET("/contracts/smart-contract-triggers-batch", r.contractHandler.GetSmartContractTriggersBatch)
-		
-		// Chain parameters
-		api.GET("/chainparameters", r.nodeHandler.GetChainParametersV2)
-		
-		// Vote
-		api.GET("/vote", r.nodeHandler.GetVoteInfo)
-		
-		// Search
-		api.GET("/search", r.searchHandler.Search)
-		
-		// Fund
-		api.GET("/fund", r.statsHandler.GetFund)
-		
-		// Ledger
-		api.GET("/ledger", r.statsHandler.GetLedger)
-		
-		// Testnet
-		api.POST("/testnet/request-coins", r.handleTestnetRequest)
-		
-		// CSV Export
-		api.GET("/export", r.handleCSVExport)
-		
-		// Monitor
-		api.POST("/monitor", r.handleMonitor)
-		
-		// V2 node endpoints
-		api.POST("/v2/node/overview_upload", r.nodeHandler.UploadNodeOverview)
-		api.POST("/v2/node/info_upload", r.nodeHandler.UploadNodeInfo)
-	}
-
-	// External APIs (tag system, uploads)
-	external := r.engine.Group("/external")
-	{
-		// Tags
-		external.GET("/tag", r.accountHandler.GetTags)
-		external.POST("/tag/insert", r.accountHandler.InsertTag)
-		external.POST("/tag/update", r.accountHandler.UpdateTag)
-		external.POST("/tag/delete", r.accountHandler.DeleteTag)
-		external.GET("/tag/recommend", r.accountHandler.RecommendTag)
-		
-		// Upload
-		external.POST("/upload/logo", r.handleLogoUpload)
-	}
-
-	// FullNode HTTP API routes (gRPC gateway style)
-	fullnode := r.engine.Group("/wallet")
-	{
-		// Account
-		fullnode.POST("/getaccount", r.accountHandler.GetAccount)
-		fullnode.POST("/getaccountbalance", r.accountHandler.GetAccountBalance)
-		fullnode.POST("/getaccountresource", r.accountHandler.GetAccountResource)
-		fullnode.POST("/getaccountnet", r.accountHandler.GetAccountNet)
-		fullnode.POST("/createaccount", r.accountHandler.CreateAccount)
-		fullnode.POST("/updateaccount", r.accountHandler.UpdateAccount)
-		fullnode.POST("/setaccountid", r.accountHandler.SetAccountId)
-		fullnode.POST("/accountpermissionupdate", r.accountHandler.AccountPermissionUpdate)
-		
-		// Transaction
-		fullnode.POST("/createtransaction", r.transactionHandler.CreateTransaction)
-		fullnode.POST("/broadcasttransaction", r.transactionHandler.BroadcastTransaction)
-		fullnode.POST("/broadcasthex", r.transactionHandler.BroadcastHex)
-		fullnode.POST("/gettransactionbyid", r.transactionHandler.GetTransactionById)
-		fullnode.POST("/gettransactioninfobyid", r.transactionHandler.GetTransactionInfoById)
-		fullnode.POST("/gettransactionreceiptbyid", r.transactionHandler.GetTransactionReceiptById)
-		fullnode.POST("/gettransactioncountbyblocknum", r.transactionHandler.GetTransactionCountByBlockNum)
-		fullnode.POST("/gettransactioninfobyblocknum", r.transactionHandler.GetTransactionInfoByBlockNum)
-		fullnode.POST("/gettransactionsign", r.transactionHandler.GetTransactionSign)
-		fullnode.POST("/getsignweight", r.transactionHandler.GetTransactionSignWeight)
-		fullnode.POST("/getapprovedlist", r.transactionHandler.GetTransactionApprovedList)
-		
-		// Block
-		fullnode.POST("/getnowblock", r.blockHandler.GetNowBlock)
-		fullnode.POST("/getblockbynum", r.blockHandler.GetBlockByNum)
-		fullnode.POST("/getblockbyid", r.blockHandler.GetBlockById)
-		fullnode.POST("/getblockbylimitnext", r.blockHandler.GetBlockByLimitNext)
-		fullnode.POST("/getblockbylatestnum", r.blockHandler.GetBlockByLatestNum)
-		fullnode.POST("/getblock", r.blockHandler.GetBlock)
-		fullnode.POST("/getblockbalance", r.blockHandler.GetBlockBalance)
-		
-		// Node
-		fullnode.POST("/listnodes", r.nodeHandler.ListNodes)
-		fullnode.POST("/getnodeinfo", r.nodeHandler.GetNodeInfo)
-		
-		// Asset (LRC-10)
-		fullnode.POST("/getassetissuebyaccount", r.tokenHandler.GetAssetIssueByAccount)
-		fullnode.POST("/getassetissuebyid", r.tokenHandler.GetAssetIssueById)
-		fullnode.POST("/getassetissuebyname", r.tokenHandler.GetAssetIssueByName)
-		fullnode.GET("/getassetissuelist", r.tokenHandler.GetAssetIssueList)
-		fullnode.POST("/getassetissuelistbyname", r.tokenHandler.GetAssetIssueListByName)
-		fullnode.POST("/getpaginatedassetissuelist", r.tokenHandler.GetPaginatedAssetIssueList)
-		fullnode.POST("/createassetissue", r.tokenHandler.CreateAssetIssue)
-		fullnode.POST("/transferasset", r.tokenHandler.TransferAsset)
-		fullnode.POST("/participateassetissue", r.tokenHandler.ParticipateAssetIssue)
-		fullnode.POST("/unfreezeasset", r.tokenHandler.UnfreezeAsset)
-		fullnode.POST("/updateasset", r.tokenHandler.UpdateAsset)
-		
-		// Resource (Stake 1.0)
-		fullnode.POST("/freezebalance", r.contractHandler.FreezeBalance)
-		fullnode.POST("/unfreezebalance", r.contractHandler.UnfreezeBalance)
-		fullnode.POST("/withdrawbalance", r.contractHandler.WithdrawBalance)
-		
-		// Resource (Stake 2.0)
-		fullnode.POST("/freezebalancev2", r.contractHandler.FreezeBalanceV2)
-		fullnode.POST("/unfreezebalancev2", r.contractHandler.UnfreezeBalanceV2)
-		fullnode.POST("/withdrawexpireunfreeze", r.contractHandler.WithdrawExpireUnfreeze)
-		fullnode.POST("/delegateresource", r.contractHandler.DelegateResource)
-		fullnode.POST("/undelegateresource", r.contractHandler.UnDelegateResource)
-		fullnode.POST("/cancelallunfreezev2", r.contractHandler.CancelAllUnfreezeV2)
-		fullnode.POST("/getavailableunfreezecount", r.contractHandler.GetAvailableUnfreezeCount)
-		fullnode.POST("/getcanwithdrawunfreezeamount", r.contractHandler.GetCanWithdrawUnfreezeAmount)
-		fullnode.POST("/getdelegatedresource", r.contractHandler.GetDelegatedResource)
-		fullnode.POST("/getdelegatedresourcev2", r.contractHandler.GetDelegatedResourceV2)
-		fullnode.POST("/getdelegatedresourceaccountindex", r.contractHandler.GetDelegatedResourceAccountIndex)
-		fullnode.POST("/getdelegatedresourceaccountindexv2", r.contractHandler.GetDelegatedResourceAccountIndexV2)
-		fullnode.POST("/getcandelegatedmaxsize", r.contractHandler.GetCanDelegatedMaxSize)
-		
-		// Witness & Voting
-		fullnode.POST("/listwitnesses", r.nodeHandler.ListWitnesses)
-		fullnode.POST("/createwitness", r.nodeHandler.CreateWitness)
-		fullnode.POST("/updatewitness", r.nodeHandler.UpdateWitness)
-		fullnode.POST("/votewitnessaccount", r.nodeHandler.VoteWitnessAccount)
-		fullnode.POST("/getBrokerage", r.nodeHandler.GetBrokerage)
-		fullnode.POST("/updateBrokerage", r.nodeHandler.UpdateBrokerage)
-		fullnode.POST("/getReward", r.nodeHandler.GetReward)
-		fullnode.GET("/getnextmaintenancetime", r.nodeHandler.GetNextMaintenanceTime)
-		fullnode.POST("/getpaginatednowwitnesslist", r.nodeHandler.GetPaginatedNowWitnessList)
-		
-		// Proposal
-		fullnode.GET("/listproposals", r.nodeHandler.ListProposals)
-		fullnode.POST("/getproposalbyid", r.nodeHandler.GetProposalById)
-		fullnode.POST("/proposalcreate", r.nodeHandler.ProposalCreate)
-		fullnode.POST("/proposalapprove", r.nodeHandler.ProposalApprove)
-		fullnode.POST("/proposaldelete", r.nodeHandler.ProposalDelete)
-		fullnode.POST("/getpaginatedproposallist", r.nodeHandler.GetPaginatedProposalList)
-		
-		// Exchange
-		fullnode.POST("/exchangecreate", r.contractHandler.ExchangeCreate)
-		fullnode.POST("/exchangeinject", r.contractHandler.ExchangeInject)
-		fullnode.POST("/exchangewithdraw", r.contractHandler.ExchangeWithdraw)
-		fullnode.POST("/exchangetransaction", r.contractHandler.ExchangeTransaction)
-		fullnode.POST("/getexchangebyid", r.contractHandler.GetExchangeById)
-		fullnode.GET("/listexchanges", r.contractHandler.ListExchanges)
-		fullnode.POST("/getpaginatedexchangelist", r.contractHandler.GetPaginatedExchangeList)
-		
-		// Market (DEX)
-		fullnode.POST("/marketsellasset", r.contractHandler.MarketSellAsset)
-		fullnode.POST("/marketcancelorder", r.contractHandler.MarketCancelOrder)
-		fullnode.POST("/getmarketorderbyaccount", r.contractHandler.GetMarketOrderByAccount)
-		fullnode.POST("/getmarketorderbyid", r.contractHandler.GetMarketOrderById)
-		fullnode.POST("/getmarketpricebypair", r.contractHandler.GetMarketPriceByPair)
-		fullnode.POST("/getmarketorderlistbypair", r.contractHandler.GetMarketOrderListByPair)
-		fullnode.GET("/getmarketpairlist", r.contractHandler.GetMarketPairList)
-		
-		// Smart Contract
-		fullnode.POST("/deploycontract", r.contractHandler.DeployContract)
-		fullnode.POST("/triggersmartcontract", r.contractHandler.TriggerSmartContract)
-		fullnode.POST("/triggerconstantcontract", r.contractHandler.TriggerConstantContract)
-		fullnode.POST("/estimateenergy", r.contractHandler.EstimateEnergy)
-		fullnode.POST("/getcontract", r.contractHandler.GetContract)
-		fullnode.POST("/getcontractinfo", r.contractHandler.GetContractInfo)
-		fullnode.POST("/updatesetting", r.contractHandler.UpdateSetting)
-		fullnode.POST("/updateenergylimit", r.contractHandler.UpdateEnergyLimit)
-		fullnode.POST("/clearabi", r.contractHandler.ClearAbi)
-		
-		// Shielded Transaction
-		fullnode.POST("/getnewshieldedaddress", r.contractHandler.GetNewShieldedAddress)
-		fullnode.POST("/getspendingkey", r.contractHandler.GetSpendingKey)
-		fullnode.POST("/getexpandedspendingkey", r.contractHandler.GetExpandedSpendingKey)
-		fullnode.POST("/getakfromask", r.contractHandler.GetAkFromAsk)
-		fullnode.POST("/getnkfromnsk", r.contractHandler.GetNkFromNsk)
-		fullnode.POST("/getincomingviewingkey", r.contractHandler.GetIncomingViewingKey)
-		fullnode.POST("/getdiversifier", r.contractHandler.GetDiversifier)
-		fullnode.POST("/getzenpaymentaddress", r.contractHandler.GetZenPaymentAddress)
-		fullnode.POST("/createshieldedtransaction", r.contractHandler.CreateShieldedTransaction)
-		fullnode.POST("/createshieldedtransactionwithoutspendauthsig", r.contractHandler.CreateShieldedTransactionWithoutSpendAuthSig)
-		fullnode.POST("/getmerkletreevoucherinfo", r.contractHandler.GetMerkleTreeVoucherInfo)
-		fullnode.POST("/scannotebyivk", r.contractHandler.ScanNoteByIvk)
-		fullnode.POST("/scanandmarknotebyivk", r.contractHandler.ScanAndMarkNoteByIvk)
-		fullnode.POST("/scannotebyovk", r.contractHandler.ScanNoteByOvk)
-		fullnode.POST("/isspend", r.contractHandler.IsSpend)
-		fullnode.POST("/getrcm", r.contractHandler.GetRcm)
-		fullnode.POST("/createspendauthsig", r.contractHandler.CreateSpendAuthSig)
-		fullnode.POST("/createshieldnullifier", r.contractHandler.CreateShieldNullifier)
-		fullnode.POST("/getshieldtransactionhash", r.contractHandler.GetShieldTransactionHash)
-		fullnode.POST("/scanshieldedlrc20notesbyivk", r.contractHandler.GetShieldedLRC20NotesByIvk)
-		fullnode.POST("/scanshieldedlrc20notesbyovk", r.contractHandler.GetShieldedLRC20NotesByOvk)
-		fullnode.POST("/isshieldedlrc20contractnotespent", r.contractHandler.IsShieldedLRC20ContractNoteSpent)
-		fullnode.POST("/createshieldedcontractparameters", r.contractHandler.CreateShieldedContractParameters)
-		fullnode.POST("/createshieldedcontractparameterswithoutask", r.contractHandler.CreateShieldedContractParametersWithoutAsk)
-		fullnode.POST("/gettriggerinputforshieldedlrc20contract", r.contractHandler.GetTriggerInputForShieldedLRC20Contract)
-		
-		// Utility
-		fullnode.POST("/validateaddress", r.accountHandler.ValidateAddress)
-		fullnode.POST("/generateaddress", r.accountHandler.GenerateAddress)
-		fullnode.POST("/createaddress", r.accountHandler.CreateAddress)
-		fullnode.POST("/easytransfer", r.accountHandler.EasyTransfer)
-		fullnode.POST("/easytransferbyprivate", r.accountHandler.EasyTransferByPrivate)
-		fullnode.POST("/easytransferasset", r.accountHandler.EasyTransferAsset)
-		fullnode.POST("/easytransferassetbyprivate", r.accountHandler.EasyTransferAssetByPrivate)
-		
-		// Pending Pool
-		fullnode.POST("/gettransactionfrompending", r.transactionHandler.GetTransactionFromPending)
-		fullnode.GET("/gettransactionlistfrompending", r.transactionHandler.GetTransactionListFromPending)
-		fullnode.GET("/getpendingsize", r.transactionHandler.GetPendingSize)
-		
-		// Chain Info
-		fullnode.GET("/getchainparameters", r.nodeHandler.GetChainParameters)
-		fullnode.GET("/totaltransaction", r.nodeHandler.TotalTransaction)
-		fullnode.GET("/getburnlind", r.nodeHandler.GetBurnLind)
-		fullnode.GET("/getenergyprices", r.nodeHandler.GetEnergyPrices)
-		fullnode.GET("/getbandwidthprices", r.nodeHandler.GetBandwidthPrices)
-		fullnode.GET("/getmemofee", r.nodeHandler.GetMemoFeePrices)
-	}
-
-	// Solidity Node API routes
-	solidity := r.engine.Group("/walletsolidity")
-	{
-		// Account
-		solidity.POST("/getaccount", r.accountHandler.GetAccountSolidity)
-		solidity.POST("/getaccountbyid", r.accountHandler.GetAccountByIdSolidity)
-		solidity.POST("/getaccountresource", r.accountHandler.GetAccountResource)
-		solidity.POST("/getaccountnet", r.accountHandler.GetAccountNet)
-		
-		// Transaction
-		solidity.POST("/gettransactionbyid", r.transactionHandler.GetTransactionByIdSolidity)
-		solidity.POST("/gettransactioninfobyid", r.transactionHandler.GetTransactionInfoByIdSolidity)
-		solidity.POST("/gettransactioncountbyblocknum", r.transactionHandler.GetTransactionCountByBlockNum)
-		solidity.POST("/gettransactioninfobyblocknum", r.transactionHandler.GetTransactionInfoByBlockNumSolidity)
-		
-		// Block
-		solidity.POST("/getnowblock", r.blockHandler.GetNowBlockSolidity)
-		solidity.POST("/getblockbynum", r.blockHandler.GetBlockByNumSolidity)
-		solidity.POST("/getblockbyid", r.blockHandler.GetBlockById)
-		solidity.POST("/getblockbylimitnext", r.blockHandler.GetBlockByLimitNext)
-		solidity.POST("/getblockbylatestnum", r.blockHandler.GetBlockByLatestNum)
-		solidity.POST("/getblock", r.blockHandler.GetBlock)
-		
-		// Node Info
-		solidity.POST("/getnodeinfo", r.nodeHandler.GetNodeInfoSolidity)
-		
-		// Asset (LRC-10)
-		solidity.POST("/getassetissuebyid", r.tokenHandler.GetAssetIssueByIdSolidity)
-		solidity.POST("/getassetissuebyname", r.tokenHandler.GetAssetIssueByNameSolidity)
-		solidity.GET("/getassetissuelist", r.tokenHandler.GetAssetIssueListSolidity)
-		solidity.POST("/getassetissuelistbyname", r.tokenHandler.GetAssetIssueListByName)
-		solidity.POST("/getpaginatedassetissuelist", r.tokenHandler.GetPaginatedAssetIssueList)
-		
-		// Exchange
-		solidity.POST("/getexchangebyid", r.contractHandler.GetExchangeById)
-		solidity.GET("/listexchanges", r.contractHandler.ListExchanges)
-		
-		// Market
-		solidity.POST("/getmarketorderbyaccount", r.contractHandler.GetMarketOrderByAccount)
-		solidity.POST("/getmarketorderbyid", r.contractHandler.GetMarketOrderById)
-		solidity.POST("/getmarketpricebypair", r.contractHandler.GetMarketPriceByPair)
-		solidity.POST("/getmarketorderlistbypair", r.contractHandler.GetMarketOrderListByPair)
-		solidity.GET("/getmarketpairlist", r.contractHandler.GetMarketPairList)
-		
-		// Smart Contract
-		solidity.POST("/triggerconstantcontract", r.contractHandler.TriggerConstantContractSolidity)
-		solidity.POST("/estimateenergy", r.contractHandler.EstimateEnergySolidity)
-		solidity.POST("/getcontract", r.contractHandler.GetContract)
-		solidity.POST("/getcontractinfo", r.contractHandler.GetContractInfo)
-		
-		// Witness
-		solidity.GET("/listwitnesses", r.nodeHandler.ListWitnessesSolidity)
-		solidity.POST("/getBrokerage", r.nodeHandler.GetBrokerageSolidity)
-		solidity.POST("/getReward", r.nodeHandler.GetRewardSolidity)
-		solidity.GET("/getpaginatednowwitnesslist", r.nodeHandler.GetPaginatedNowWitnessListSolidity)
-		
-		// Resource Delegation
-		solidity.POST("/getdelegatedresource", r.contractHandler.GetDelegatedResource)
-		solidity.POST("/getdelegatedresourcev2", r.contractHandler.GetDelegatedResourceV2)
-		solidity.POST("/getdelegatedresourceaccountindex", r.contractHandler.GetDelegatedResourceAccountIndex)
-		solidity.POST("/getdelegatedresourceaccountindexv2", r.contractHandler.GetDelegatedResourceAccountIndexV2)
-		solidity.POST("/getcandelegatedmaxsize", r.contractHandler.GetCanDelegatedMaxSize)
-		solidity.POST("/getavailableunfreezecount", r.contractHandler.GetAvailableUnfreezeCount)
-		solidity.POST("/getcanwithdrawunfreezeamount", r.contractHandler.GetCanWithdrawUnfreezeAmount)
-		
-		// Shielded Transaction
-		solidity.POST("/scannotebyivk", r.contractHandler.ScanNoteByIvkSolidity)
-		solidity.POST("/scanandmarknotebyivk", r.contractHandler.ScanAndMarkNoteByIvkSolidity)
-		solidity.POST("/scannotebyovk", r.contractHandler.ScanNoteByOvkSolidity)
-		solidity.POST("/getmerkletreevoucherinfo", r.contractHandler.GetMerkleTreeVoucherInfo)
-		solidity.POST("/isspend", r.contractHandler.IsSpend)
-		solidity.POST("/scanshieldedlrc20notesbyivk", r.contractHandler.GetShieldedLRC20NotesByIvk)
-		solidity.POST("/scanshieldedlrc20notesbyovk", r.contractHandler.GetShieldedLRC20NotesByOvk)
-		solidity.POST("/isshieldedlrc20contractnotespent", r.contractHandler.IsShieldedLRC20ContractNoteSpent)
-		
-		// Burn LIND
-		solidity.GET("/getburnlind", r.nodeHandler.GetBurnLindSolidity)
-	}
-
-	// JSON-RPC API
-	jsonrpc := r.engine.Group("/jsonrpc")
-	{
-		jsonrpc.POST("", r.handleJsonRPC)
-	}
-
-	// Monitor endpoints
-	monitor := r.engine.Group("/monitor")
-	{
-		monitor.GET("/getstatsinfo", r.nodeHandler.GetStatsInfo)
-		monitor.GET("/getnodeinfo", r.nodeHandler.GetNodeInfo)
-	}
-
-	// Network endpoints
-	net := r.engine.Group("/net")
-	{
-		net.GET("/listnodes", r.nodeHandler.ListNodes)
-	}
-}
-
-func (r *Router) Run(addr string) error {
-	return r.engine.Run(addr)
-}
-
-func (r *Router) Engine() *gin.Engine {
-	return r.engine
-}
-
-// Health check handler
-func (r *Router) handleHealth(c *gin.Context) {
-	c.JSON(200, gin.H{
-		"status": "ok",
-		"time":   time.Now().Unix(),
-	})
-}
-
-// Metrics handler
-func (r *Router) handleMetrics(c *gin.Context) {
-	// Prometheus metrics would be exposed here
-	c.String(200, "metrics endpoint")
-}
-
-// Proxy handler for external APIs
-func (r *Router) handleProxy(c *gin.Context) {
-	var req models.ProxyRequestMessage
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(400, gin.H{"error": err.Error()})
-		return
-	}
-
-	// Forward request through blockchain client
-	resp, err := r.blockchainClient.ProxyRequest(c.Request.Context(), &req)
-	if err != nil {
-		c.JSON(500, gin.H{"error": err.Error()})
-		return
-	}
-
-	c.JSON(int(resp.StatusCode), resp.Body)
-}
-
-// Testnet request handler
-func (r *Router) handleTestnetRequest(c *gin.Context) {
-	var req models.TestnetRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(400, gin.H{"error": err.Error()})
-		return
-	}
-
-	// Process testnet request
-	// This would typically interact with a faucet service
-	c.JSON(200, gin.H{
-		"success": true,
-		"txid":    "test_txid",
-	})
-}
-
-// CSV export handler
-func (r *Router) handleCSVExport(c *gin.Context) {
-	var req models.CSVExportRequest
-	if err := c.ShouldBindQuery(&req); err != nil {
-		c.JSON(400, gin.H{"error": err.Error()})
-		return
-	}
-
-	// Set CSV headers
-	c.Header("Content-Type", "text/csv")
-	c.Header("Content-Disposition", "attachment;filename=export.csv")
-
-	// Generate CSV based on type
-	switch req.Type {
-	case "block":
-		r.blockHandler.ExportBlocksToCSV(c, req)
-	case "freezeresource":
-		r.statsHandler.ExportFreezeResourceToCSV(c, req)
-	case "turnover":
-		r.statsHandler.ExportTurnoverToCSV(c, req)
-	case "tokenholders":
-		r.tokenHandler.ExportTokenHoldersToCSV(c, req)
-	default:
-		c.JSON(400, gin.H{"error": "invalid export type"})
-	}
-}
-
-// Monitor handler
-func (r *Router) handleMonitor(c *gin.Context) {
-	var req models.MonitorRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(400, gin.H{"error": err.Error()})
-		return
-	}
-
-	// Process monitor event
-	c.JSON(200, gin.H{"success": true})
-}
-
-// Logo upload handler
-func (r *Router) handleLogoUpload(c *gin.Context) {
-	var req models.UploadLogoRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(400, gin.H{"error": err.Error()})
-		return
-	}
-
-	// Process logo upload
-	c.JSON(200, gin.H{
-		"success": true,
-		"url":     "https://example.com/logo.png",
-	})
-}
-
-// JSON-RPC handler
-func (r *Router) handleJsonRPC(c *gin.Context) {
-	var req map[string]interface{}
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(400, gin.H{
-			"jsonrpc": "2.0",
-			"id":      nil,
-			"error": gin.H{
-				"code":    -32700,
-				"message": "Parse error",
-			},
-		})
-		return
-	}
-
-	// Forward to blockchain client
-	resp, err := r.blockchainClient.JsonRpcForward(c.Request.Context(), req)
-	if err != nil {
-		c.JSON(500, gin.H{
-			"jsonrpc": "2.0",
-			"id":      req["id"],
-			"error": gin.H{
-				"code":    -32000,
-				"message": err.Error(),
-			},
-		})
-		return
-	}
-
-	c.JSON(200, resp)
+package routes
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/api/handlers"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/api/middleware"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/config"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/auth"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/blockchain"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/cache"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/event"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/geoip"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/search"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/storage/repository"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/tagging"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/telemetry"
+)
+
+type Router struct {
+	engine           *gin.Engine
+	config           *config.Config
+	blockchainClient *blockchain.Client
+	authService      *auth.Service
+	cacheClient      *cache.RedisClient
+	logService       *event.LogService
+	
+	// Handlers
+	accountHandler     *handlers.AccountHandler
+	blockHandler       *handlers.BlockHandler
+	transactionHandler *handlers.TransactionHandler
+	tokenHandler       *handlers.TokenHandler
+	contractHandler    *handlers.ContractHandler
+	nodeHandler        *handlers.NodeHandler
+	statsHandler       *handlers.StatsHandler
+	searchHandler      *handlers.SearchHandler
+	eventHandler       *handlers.EventHandler
+	webhookHandler     *handlers.WebhookHandler
+	alertHandler       *handlers.AlertHandler
+	nftHandler         *handlers.NFTHandler
+	approvalHandler    *handlers.ApprovalHandler
+	balanceHandler     *handlers.BalanceHandler
+	portfolioHandler   *handlers.PortfolioHandler
+	stakeHandler       *handlers.StakeHandler
+	witnessHandler     *handlers.WitnessHandler
+	governanceHandler  *handlers.GovernanceHandler
+	exchangeHandler    *handlers.ExchangeHandler
+}
+
+func NewRouter(
+	cfg *config.Config,
+	client *blockchain.Client,
+	authSvc *auth.Service,
+	cacheClient *cache.RedisClient,
+	accountRepo *repository.AccountRepository,
+	blockRepo *repository.BlockRepository,
+	txRepo *repository.TransactionRepository,
+	tokenRepo *repository.TokenRepository,
+	eventRepo *repository.EventRepository,
+	tagRepo *repository.TagRepository,
+	statsRepo *repository.StatsRepository,
+	webhookRepo *repository.WebhookRepository,
+	alertRepo *repository.AlertRepository,
+	nftRepo *repository.NFTRepository,
+	balanceRepo *repository.BalanceRepository,
+	stakingRepo *repository.StakingRepository,
+	witnessRepo *repository.WitnessRepository,
+	governanceRepo *repository.GovernanceRepository,
+	exchangeRepo *repository.ExchangeRepository,
+	nodeRepo *repository.NodeRepository,
+	searchRepo *repository.SearchRepository,
+) *Router {
+	router := &Router{
+		engine:           gin.New(),
+		config:           cfg,
+		blockchainClient: client,
+		authService:      authSvc,
+		cacheClient:      cacheClient,
+	}
+
+	// Initialize handlers
+	router.accountHandler = handlers.NewAccountHandler(client, accountRepo, tagRepo, tagging.NewService(cfg.Tags, tagRepo))
+	router.blockHandler = handlers.NewBlockHandler(client, blockRepo)
+	router.transactionHandler = handlers.NewTransactionHandler(client, txRepo)
+	router.tokenHandler = handlers.NewTokenHandler(client, tokenRepo, cfg.PriceFeed.StaleAfter)
+	router.contractHandler = handlers.NewContractHandler(client, statsRepo)
+	router.nodeHandler = handlers.NewNodeHandler(client, nodeRepo, geoip.NewResolver(cfg.External.IPGeo, nodeRepo), telemetry.NewAuthenticator(cfg.Telemetry, client))
+	router.statsHandler = handlers.NewStatsHandler(client, statsRepo, tokenRepo)
+	router.searchHandler = handlers.NewSearchHandler(search.NewService(cfg.Search, client, searchRepo, blockRepo, txRepo))
+	router.eventHandler = handlers.NewEventHandler(client, eventRepo)
+	router.webhookHandler = handlers.NewWebhookHandler(webhookRepo, cfg.Webhook.MaxSubscriptions)
+	router.alertHandler = handlers.NewAlertHandler(alertRepo)
+	router.nftHandler = handlers.NewNFTHandler(nftRepo)
+	router.approvalHandler = handlers.NewApprovalHandler(client, tokenRepo)
+	router.balanceHandler = handlers.NewBalanceHandler(balanceRepo)
+	router.portfolioHandler = handlers.NewPortfolioHandler(client, blockRepo, tokenRepo, nftRepo, cacheClient, cfg.PriceFeed.StaleAfter)
+	router.stakeHandler = handlers.NewStakeHandler(client, stakingRepo)
+	router.witnessHandler = handlers.NewWitnessHandler(client, witnessRepo)
+	router.governanceHandler = handlers.NewGovernanceHandler(client, governanceRepo)
+	router.exchangeHandler = handlers.NewExchangeHandler(exchangeRepo)
+
+	if cfg.JSONRPC.LogsFromIndex {
+		router.logService = event.NewLogService(eventRepo, blockRepo, client, cfg.JSONRPC.MaxLogBlockRange, cfg.JSONRPC.MaxLogResults)
+	}
+
+	router.setupMiddleware()
+	router.setupRoutes()
+
+	return router
+}
+
+func (r *Router) setupMiddleware() {
+	// Recovery middleware
+	r.engine.Use(gin.Recovery())
+
+	// Logger middleware
+	r.engine.Use(middleware.LoggerMiddleware(r.config.Logging))
+
+	// CORS middleware
+	r.engine.Use(middleware.CorsMiddleware(r.config.CORS.AllowedOrigins))
+
+	// Rate limiting middleware
+	r.engine.Use(middleware.RateLimitMiddleware(r.cacheClient, r.config.RateLimit))
+
+	// Auth middleware
+	r.engine.Use(middleware.AuthMiddleware(r.authService, r.config.Auth))
+
+	// Allowlist middleware
+	r.engine.Use(middleware.AllowlistMiddleware(r.authService))
+
+	// Response interceptor
+	r.engine.Use(middleware.ResponseInterceptor())
+}
+
+func (r *Router) setupRoutes() {
+	// Health check
+	r.engine.GET("/health", r.handleHealth)
+
+	// Metrics
+	r.engine.GET("/metrics", r.handleMetrics)
+
+	// API v1 routes (Event Query Service style)
+	v1 := r.engine.Group("/v1")
+	{
+		// Transactions
+		v1.GET("/transactions", r.transactionHandler.GetTransactionsEvent)
+		v1.GET("/transactions/:hash", r.transactionHandler.GetTransactionByHashEvent)
+		
+		// Transfers
+		v1.GET("/transfers", r.tokenHandler.GetTransfersEvent)
+		v1.GET("/transfers/:hash", r.tokenHandler.GetTransferByHashEvent)
+		
+		// Events
+		v1.GET("/events", r.eventHandler.GetEvents)
+		v1.GET("/events/transaction/:transaction_id", r.eventHandler.GetEventsByTransactionId)
+		v1.GET("/events/:contract_address", r.eventHandler.GetEventsByContractAddress)
+		v1.GET("/events/contract/:contract_address/:event_name", r.eventHandler.GetEventsByContractAndEventName)
+		v1.GET("/events/contract/:contract_address/:event_name/:block_number", r.eventHandler.GetEventsByContractEventAndBlock)
+		v1.GET("/events/timestamp", r.eventHandler.GetEventsByTimestamp)
+		v1.GET("/events/confirmed", r.eventHandler.GetConfirmedEvents)
+		
+		// Blocks
+		v1.GET("/blocks/:hash", r.blockHandler.GetBlockByHashEvent)
+		v1.GET("/blocks", r.blockHandler.GetBlocksEvent)
+		v1.GET("/blocks/latestSolidifiedBlockNumber", r.blockHandler.GetLatestSolidifiedBlockNumber)
+		v1.GET("/blocks/:blockNum/stats", r.blockHandler.GetBlockStats)
+		
+		// Contract logs
+		v1.GET("/contractlogs", r.contractHandler.GetContractLogs)
+		v1.GET("/contractlogs/transaction/:transaction_id", r.contractHandler.GetContractLogsByTransactionId)
+		v1.GET("/contractlogs/contract/:contract_address", r.contractHandler.GetContractLogsByContractAddress)
+		v1.POST("/contract/transaction/:transaction_id", r.contractHandler.GetContractWithAbi)
+		v1.POST("/contract/contractAddress/:contract_address", r.contractHandler.GetContractByAddressWithAbi)
+		
+		// Accounts (v1 style)
+		v1.GET("/accounts/:address", r.accountHandler.GetAccountV1)
+		v1.GET("/accounts/:address/transactions", r.transactionHandler.GetAccountTransactionsV1)
+		v1.GET("/accounts/:address/transactions/lrc20", r.tokenHandler.GetAccountLRC20TransactionsV1)
+		v1.GET("/accounts/:address/internal-transactions", r.transactionHandler.GetAccountInternalTransactionsV1)
+		v1.GET("/accounts/:address/lrc20/balance", r.tokenHandler.GetAccountLRC20BalanceV1)
+		
+		// Assets (v1 style)
+		v1.GET("/assets", r.tokenHandler.GetAssetsV1)
+		v1.GET("/assets/:name/list", r.tokenHandler.GetAssetsByNameV1)
+		v1.GET("/assets/:identifier", r.tokenHandler.GetAssetsByIdentifierV1)
+		
+		// Contracts (v1 style)
+		v1.GET("/contracts/:contractAddress/transactions", r.transactionHandler.GetContractTransactionsV1)
+		v1.GET("/accounts/:contractAddress/internal-transactions", r.transactionHandler.GetContractInternalTransactionsV1)
+		v1.GET("/contracts/:contractAddress/tokens", r.tokenHandler.GetContractTokensV1)
+	}
+
+	// Lindascan custom API routes
+	api := r.engine.Group("/api")
+	{
+		// System
+		api.GET("/system/homepage-bundle", r.statsHandler.GetHomepageBundle)
+		api.POST("/system/proxy", r.handleProxy)
+		
+		// Node map
+		api.GET("/nodemap", r.nodeHandler.GetNodeMap)
+		
+		// Top 10
+		api.GET("/top10", r.statsHandler.GetTop10)
+		
+		// Token APIs
+		api.GET("/token", r.tokenHandler.GetTokens)
+		api.GET("/token/:id", r.tokenHandler.GetTokenById)
+		api.GET("/token/price", r.tokenHandler.GetTokenPrice)
+		api.GET("/tokens/overview", r.tokenHandler.GetTokensOverview)
+		api.GET("/token_lrc20", r.tokenHandler.GetLRC20Tokens)
+		api.GET("/token_lrc20/:contract", r.tokenHandler.GetLRC20TokenByContract)
+		api.GET("/token_lrc20/holders", r.tokenHandler.GetTokenHolders)
+		api.GET("/token_lrc20/transfers", r.tokenHandler.GetTokenTransfers)
+		api.GET("/tokens/position-distribution", r.tokenHandler.GetTokenPositionDistribution)
+		api.GET("/tokens/participateassetissue", r.tokenHandler.GetParticipateAssetIssue)
+		
+		// Special tokens
+		api.GET("/wink/fund", r.tokenHandler.GetWinkFund)
+		api.GET("/wink/graphic", r.tokenHandler.GetWinkGraphic)
+		api.GET("/jst/fund", r.tokenHandler.GetJSTFund)
+		api.GET("/jst/graphic", r.tokenHandler.GetJSTGraphic)
+		api.GET("/bittorrent/graphic", r.tokenHandler.GetBitTorrentGraphic)
+		
+		// Asset transfers
+		api.GET("/asset/transfer", r.tokenHandler.GetAssetTransfer)
+		
+		// Account APIs
+		api.GET("/account/list", r.accountHandler.GetAccountList)
+		api.GET("/account/resource", r.accountHandler.GetAccountResourceInfo)
+		api.GET("/account-proposal", r.governanceHandler.GetAccountProposals)
+		api.GET("/account/approvals", r.approvalHandler.GetApprovals)
+		api.POST("/account/approvals/revoke", r.approvalHandler.RevokeApproval)
+		api.GET("/account/balance", r.balanceHandler.GetBalanceAtBlock)
+		api.GET("/account/balance-history", r.balanceHandler.GetBalanceHistory)
+		api.GET("/account/portfolio", r.portfolioHandler.GetPortfolio)
+		api.GET("/account/stake", r.stakeHandler.GetStake)
+		api.GET("/account/stake/history", r.stakeHandler.GetStakingTimeline)
+		api.GET("/witness/voters", r.stakeHandler.GetWitnessVoters)
+		api.GET("/witness/ranking", r.witnessHandler.GetWitnessRanking)
+		api.GET("/witness/:address/stats", r.witnessHandler.GetWitnessStats)
+		
+		// Statistics
+		api.GET("/stats/overview", r.statsHandler.GetOverview)
+		api.GET("/energystatistic", r.statsHandler.GetEnergyStatistic)
+		api.GET("/triggerstatistic", r.statsHandler.GetTriggerStatistic)
+		api.GET("/calleraddressstatistic", r.statsHandler.GetCallerAddressStatistic)
+		api.GET("/energydailystatistic", r.statsHandler.GetEnergyDailyStatistic)
+		api.GET("/triggeramountstatistic", r.statsHandler.GetTriggerAmountStatistic)
+		api.GET("/freezeresource", r.statsHandler.GetFreezeResource)
+		api.GET("/turnover", r.statsHandler.GetTurnover)
+		api.GET("/stats/delegated-resource", r.stakeHandler.GetDelegatedResourceHistory)
+		api.GET("/onecontractenergystatistic", r.statsHandler.GetOneContractEnergyStatistic)
+		api.GET("/onecontracttriggerstatistic", r.statsHandler.GetOneContractTriggerStatistic)
+		api.GET("/onecontractcallerstatistic", r.statsHandler.GetOneContractCallerStatistic)
+		api.GET("/onecontractcallers", r.statsHandler.GetOneContractCallers)
+		
+		// Block and transaction
+		api.GET("/block", r.blockHandler.GetBlocksV2)
+		api.GET("/transaction", r.transactionHandler.GetTransactionsV2)
+		api.GET("/internal-transaction", r.transactionHandler.GetInternalTransactions)
+		api.GET("/contracts/transaction", r.transactionHandler.GetContractTransactions)
+		api.GET("/lrc10lrc20-transfer", r.tokenHandler.GetLRC10LRC20Transfers)
+		api.GET("/contract_account_history", r.contractHandler.GetContractAccountHistory)
+		api.GET("/contracts/smart-contract-triggers-batch", r.contractHandler.GetSmartContractTriggersBatch)
+		
+		// Chain parameters
+		api.GET("/chainparameters", r.nodeHandler.GetChainParametersV2)
+		api.GET("/chainparameters/history", r.governanceHandler.GetChainParameterHistory)
+		
+		// Exchanges and market
+		api.GET("/exchange/:id/candles", r.exchangeHandler.GetExchangeCandles)
+		api.GET("/exchange/:id/liquidity", r.exchangeHandler.GetExchangeLiquidity)
+		api.GET("/market/:pair/trades", r.exchangeHandler.GetMarketTrades)
+		api.GET("/market/:pair/candles", r.exchangeHandler.GetMarketCandles)
+		
+		// Vote
+		api.GET("/vote", r.nodeHandler.GetVoteInfo)
+		
+		// Search
+		api.GET("/search", r.searchHandler.Search)
+		api.GET("/search/autocomplete", r.searchHandler.Autocomplete)
+		
+		// Fund
+		api.GET("/fund", r.statsHandler.GetFund)
+		
+		// Ledger
+		api.GET("/ledger", r.statsHandler.GetLedger)
+		
+		// Testnet
+		api.POST("/testnet/request-coins", r.handleTestnetRequest)
+		
+		// CSV Export
+		api.GET("/export", r.handleCSVExport)
+		
+		// Monitor
+		api.POST("/monitor", r.webhookHandler.CreateSubscription)
+		
+		// Webhooks
+		api.POST("/webhooks", r.webhookHandler.CreateSubscription)
+		api.GET("/webhooks", r.webhookHandler.ListSubscriptions)
+		api.DELETE("/webhooks/:id", r.webhookHandler.DeleteSubscription)
+		api.GET("/webhooks/:id/deliveries", r.webhookHandler.GetDeliveries)
+		api.POST("/webhooks/:id/deliveries/:delivery_id/replay", r.webhookHandler.ReplayDelivery)
+		
+		// Watchlists and alerts
+		api.POST("/watchlists", r.alertHandler.CreateWatchlist)
+		api.GET("/watchlists", r.alertHandler.ListWatchlists)
+		api.DELETE("/watchlists/:id", r.alertHandler.DeleteWatchlist)
+		api.POST("/watchlists/:id/addresses", r.alertHandler.AddWatchlistAddress)
+		api.DELETE("/watchlists/:id/addresses/:address", r.alertHandler.RemoveWatchlistAddress)
+		api.POST("/alert-rules", r.alertHandler.CreateRule)
+		api.GET("/alert-rules", r.alertHandler.ListRules)
+		api.DELETE("/alert-rules/:id", r.alertHandler.DeleteRule)
+		api.GET("/alerts", r.alertHandler.GetAlerts)
+		
+		// NFT collections, ownership and transfers
+		api.GET("/nft/collections", r.nftHandler.GetCollections)
+		api.GET("/nft/collections/:contract", r.nftHandler.GetCollection)
+		api.GET("/nft/collections/:contract/tokens/:token_id", r.nftHandler.GetToken)
+		api.GET("/nft/collections/:contract/tokens/:token_id/owners", r.nftHandler.GetTokenOwners)
+		api.GET("/nft/owners/:address/tokens", r.nftHandler.GetOwnerTokens)
+		api.GET("/nft/transfers", r.nftHandler.GetTransfers)
+		
+		// V2 node endpoints
+		api.POST("/v2/node/overview_upload", r.nodeHandler.UploadNodeOverview)
+		api.POST("/v2/node/info_upload", r.nodeHandler.UploadNodeInfo)
+		api.GET("/v2/node/versions", r.nodeHandler.GetNodeVersions)
+		api.GET("/v2/node/lagging", r.nodeHandler.GetLaggingNodes)
+		api.GET("/v2/node/:host/history", r.nodeHandler.GetNodeHistory)
+	}
+
+	// External APIs (tag system, uploads)
+	external := r.engine.Group("/external")
+	{
+		// Tags
+		external.GET("/tag", r.accountHandler.GetTags)
+		external.POST("/tag/insert", r.accountHandler.InsertTag)
+		external.POST("/tag/update", r.accountHandler.UpdateTag)
+		external.POST("/tag/delete", r.accountHandler.DeleteTag)
+		external.GET("/tag/recommend", r.accountHandler.RecommendTag)
+		external.POST("/tag/vote", r.accountHandler.VoteTag)
+		external.GET("/tag/pending", r.accountHandler.GetPendingTags)
+		external.POST("/tag/moderate", r.accountHandler.ModerateTag)
+		
+		// Upload
+		external.POST("/upload/logo", r.handleLogoUpload)
+	}
+
+	// FullNode HTTP API routes (gRPC gateway style)
+	fullnode := r.engine.Group("/wallet")
+	{
+		// Account
+		fullnode.POST("/getaccount", r.accountHandler.GetAccount)
+		fullnode.POST("/getaccountbalance", r.accountHandler.GetAccountBalance)
+		fullnode.POST("/getaccountresource", r.accountHandler.GetAccountResource)
+		fullnode.POST("/getaccountnet", r.accountHandler.GetAccountNet)
+		fullnode.POST("/createaccount", r.accountHandler.CreateAccount)
+		fullnode.POST("/updateaccount", r.accountHandler.UpdateAccount)
+		fullnode.POST("/setaccountid", r.accountHandler.SetAccountId)
+		fullnode.POST("/accountpermissionupdate", r.accountHandler.AccountPermissionUpdate)
+		
+		// Transaction
+		fullnode.POST("/createtransaction", r.transactionHandler.CreateTransaction)
+		fullnode.POST("/broadcasttransaction", r.transactionHandler.BroadcastTransaction)
+		fullnode.POST("/broadcasthex", r.transactionHandler.BroadcastHex)
+		fullnode.POST("/gettransactionbyid", r.transactionHandler.GetTransactionById)
+		fullnode.POST("/gettransactioninfobyid", r.transactionHandler.GetTransactionInfoById)
+		fullnode.POST("/gettransactionreceiptbyid", r.transactionHandler.GetTransactionReceiptById)
+		fullnode.POST("/gettransactioncountbyblocknum", r.transactionHandler.GetTransactionCountByBlockNum)
+		fullnode.POST("/gettransactioninfobyblocknum", r.transactionHandler.GetTransactionInfoByBlockNum)
+		fullnode.POST("/gettransactionsign", r.transactionHandler.GetTransactionSign)
+		fullnode.POST("/getsignweight", r.transactionHandler.GetTransactionSignWeight)
+		fullnode.POST("/getapprovedlist", r.transactionHandler.GetTransactionApprovedList)
+		
+		// Block
+		fullnode.POST("/getnowblock", r.blockHandler.GetNowBlock)
+		fullnode.POST("/getblockbynum", r.blockHandler.GetBlockByNum)
+		fullnode.POST("/getblockbyid", r.blockHandler.GetBlockById)
+		fullnode.POST("/getblockbylimitnext", r.blockHandler.GetBlockByLimitNext)
+		fullnode.POST("/getblockbylatestnum", r.blockHandler.GetBlockByLatestNum)
+		fullnode.POST("/getblock", r.blockHandler.GetBlock)
+		fullnode.POST("/getblockbalance", r.blockHandler.GetBlockBalance)
+		
+		// Node
+		fullnode.POST("/listnodes", r.nodeHandler.ListNodes)
+		fullnode.POST("/getnodeinfo", r.nodeHandler.GetNodeInfo)
+		
+		// Asset (LRC-10)
+		fullnode.POST("/getassetissuebyaccount", r.tokenHandler.GetAssetIssueByAccount)
+		fullnode.POST("/getassetissuebyid", r.tokenHandler.GetAssetIssueById)
+		fullnode.POST("/getassetissuebyname", r.tokenHandler.GetAssetIssueByName)
+		fullnode.GET("/getassetissuelist", r.tokenHandler.GetAssetIssueList)
+		fullnode.POST("/getassetissuelistbyname", r.tokenHandler.GetAssetIssueListByName)
+		fullnode.POST("/getpaginatedassetissuelist", r.tokenHandler.GetPaginatedAssetIssueList)
+		fullnode.POST("/createassetissue", r.tokenHandler.CreateAssetIssue)
+		fullnode.POST("/transferasset", r.tokenHandler.TransferAsset)
+		fullnode.POST("/participateassetissue", r.tokenHandler.ParticipateAssetIssue)
+		fullnode.POST("/unfreezeasset", r.tokenHandler.UnfreezeAsset)
+		fullnode.POST("/updateasset", r.tokenHandler.UpdateAsset)
+		
+		// Resource (Stake 1.0)
+		fullnode.POST("/freezebalance", r.contractHandler.FreezeBalance)
+		fullnode.POST("/unfreezebalance", r.contractHandler.UnfreezeBalance)
+		fullnode.POST("/withdrawbalance", r.contractHandler.WithdrawBalance)
+		
+		// Resource (Stake 2.0)
+		fullnode.POST("/freezebalancev2", r.contractHandler.FreezeBalanceV2)
+		fullnode.POST("/unfreezebalancev2", r.contractHandler.UnfreezeBalanceV2)
+		fullnode.POST("/withdrawexpireunfreeze", r.contractHandler.WithdrawExpireUnfreeze)
+		fullnode.POST("/delegateresource", r.contractHandler.DelegateResource)
+		fullnode.POST("/undelegateresource", r.contractHandler.UnDelegateResource)
+		fullnode.POST("/cancelallunfreezev2", r.contractHandler.CancelAllUnfreezeV2)
+		fullnode.POST("/getavailableunfreezecount", r.contractHandler.GetAvailableUnfreezeCount)
+		fullnode.POST("/getcanwithdrawunfreezeamount", r.contractHandler.GetCanWithdrawUnfreezeAmount)
+		fullnode.POST("/getdelegatedresource", r.contractHandler.GetDelegatedResource)
+		fullnode.POST("/getdelegatedresourcev2", r.contractHandler.GetDelegatedResourceV2)
+		fullnode.POST("/getdelegatedresourceaccountindex", r.contractHandler.GetDelegatedResourceAccountIndex)
+		fullnode.POST("/getdelegatedresourceaccountindexv2", r.contractHandler.GetDelegatedResourceAccountIndexV2)
+		fullnode.POST("/getcandelegatedmaxsize", r.contractHandler.GetCanDelegatedMaxSize)
+		
+		// Witness & Voting
+		fullnode.POST("/listwitnesses", r.nodeHandler.ListWitnesses)
+		fullnode.POST("/createwitness", r.nodeHandler.CreateWitness)
+		fullnode.POST("/updatewitness", r.nodeHandler.UpdateWitness)
+		fullnode.POST("/votewitnessaccount", r.nodeHandler.VoteWitnessAccount)
+		fullnode.POST("/getBrokerage", r.nodeHandler.GetBrokerage)
+		fullnode.POST("/updateBrokerage", r.nodeHandler.UpdateBrokerage)
+		fullnode.POST("/getReward", r.nodeHandler.GetReward)
+		fullnode.GET("/getnextmaintenancetime", r.nodeHandler.GetNextMaintenanceTime)
+		fullnode.POST("/getpaginatednowwitnesslist", r.nodeHandler.GetPaginatedNowWitnessList)
+		
+		// Proposal
+		fullnode.GET("/listproposals", r.nodeHandler.ListProposals)
+		fullnode.POST("/getproposalbyid", r.nodeHandler.GetProposalById)
+		fullnode.POST("/proposalcreate", r.nodeHandler.ProposalCreate)
+		fullnode.POST("/proposalapprove", r.nodeHandler.ProposalApprove)
+		fullnode.POST("/proposaldelete", r.nodeHandler.ProposalDelete)
+		fullnode.POST("/getpaginatedproposallist", r.nodeHandler.GetPaginatedProposalList)
+		
+		// Exchange
+		fullnode.POST("/exchangecreate", r.contractHandler.ExchangeCreate)
+		fullnode.POST("/exchangeinject", r.contractHandler.ExchangeInject)
+		fullnode.POST("/exchangewithdraw", r.contractHandler.ExchangeWithdraw)
+		fullnode.POST("/exchangetransaction", r.contractHandler.ExchangeTransaction)
+		fullnode.POST("/getexchangebyid", r.contractHandler.GetExchangeById)
+		fullnode.GET("/listexchanges", r.contractHandler.ListExchanges)
+		fullnode.POST("/getpaginatedexchangelist", r.contractHandler.GetPaginatedExchangeList)
+		
+		// Market (DEX)
+		fullnode.POST("/marketsellasset", r.contractHandler.MarketSellAsset)
+		fullnode.POST("/marketcancelorder", r.contractHandler.MarketCancelOrder)
+		fullnode.POST("/getmarketorderbyaccount", r.contractHandler.GetMarketOrderByAccount)
+		fullnode.POST("/getmarketorderbyid", r.contractHandler.GetMarketOrderById)
+		fullnode.POST("/getmarketpricebypair", r.contractHandler.GetMarketPriceByPair)
+		fullnode.POST("/getmarketorderlistbypair", r.contractHandler.GetMarketOrderListByPair)
+		fullnode.GET("/getmarketpairlist", r.contractHandler.GetMarketPairList)
+		
+		// Smart Contract
+		fullnode.POST("/deploycontract", r.contractHandler.DeployContract)
+		fullnode.POST("/triggersmartcontract", r.contractHandler.TriggerSmartContract)
+		fullnode.POST("/triggerconstantcontract", r.contractHandler.TriggerConstantContract)
+		fullnode.POST("/estimateenergy", r.contractHandler.EstimateEnergy)
+		fullnode.POST("/getcontract", r.contractHandler.GetContract)
+		fullnode.POST("/getcontractinfo", r.contractHandler.GetContractInfo)
+		fullnode.POST("/updatesetting", r.contractHandler.UpdateSetting)
+		fullnode.POST("/updateenergylimit", r.contractHandler.UpdateEnergyLimit)
+		fullnode.POST("/clearabi", r.contractHandler.ClearAbi)
+		
+		// Shielded Transaction
+		fullnode.POST("/getnewshieldedaddress", r.contractHandler.GetNewShieldedAddress)
+		fullnode.POST("/getspendingkey", r.contractHandler.GetSpendingKey)
+		fullnode.POST("/getexpandedspendingkey", r.contractHandler.GetExpandedSpendingKey)
+		fullnode.POST("/getakfromask", r.contractHandler.GetAkFromAsk)
+		fullnode.POST("/getnkfromnsk", r.contractHandler.GetNkFromNsk)
+		fullnode.POST("/getincomingviewingkey", r.contractHandler.GetIncomingViewingKey)
+		fullnode.POST("/getdiversifier", r.contractHandler.GetDiversifier)
+		fullnode.POST("/getzenpaymentaddress", r.contractHandler.GetZenPaymentAddress)
+		fullnode.POST("/createshieldedtransaction", r.contractHandler.CreateShieldedTransaction)
+		fullnode.POST("/createshieldedtransactionwithoutspendauthsig", r.contractHandler.CreateShieldedTransactionWithoutSpendAuthSig)
+		fullnode.POST("/getmerkletreevoucherinfo", r.contractHandler.GetMerkleTreeVoucherInfo)
+		fullnode.POST("/scannotebyivk", r.contractHandler.ScanNoteByIvk)
+		fullnode.POST("/scanandmarknotebyivk", r.contractHandler.ScanAndMarkNoteByIvk)
+		fullnode.POST("/scannotebyovk", r.contractHandler.ScanNoteByOvk)
+		fullnode.POST("/isspend", r.contractHandler.IsSpend)
+		fullnode.POST("/getrcm", r.contractHandler.GetRcm)
+		fullnode.POST("/createspendauthsig", r.contractHandler.CreateSpendAuthSig)
+		fullnode.POST("/createshieldnullifier", r.contractHandler.CreateShieldNullifier)
+		fullnode.POST("/getshieldtransactionhash", r.contractHandler.GetShieldTransactionHash)
+		fullnode.POST("/scanshieldedlrc20notesbyivk", r.contractHandler.GetShieldedLRC20NotesByIvk)
+		fullnode.POST("/scanshieldedlrc20notesbyovk", r.contractHandler.GetShieldedLRC20NotesByOvk)
+		fullnode.POST("/isshieldedlrc20contractnotespent", r.contractHandler.IsShieldedLRC20ContractNoteSpent)
+		fullnode.POST("/createshieldedcontractparameters", r.contractHandler.CreateShieldedContractParameters)
+		fullnode.POST("/createshieldedcontractparameterswithoutask", r.contractHandler.CreateShieldedContractParametersWithoutAsk)
+		fullnode.POST("/gettriggerinputforshieldedlrc20contract", r.contractHandler.GetTriggerInputForShieldedLRC20Contract)
+		
+		// Utility
+		fullnode.POST("/validateaddress", r.accountHandler.ValidateAddress)
+		fullnode.POST("/generateaddress", r.accountHandler.GenerateAddress)
+		fullnode.POST("/createaddress", r.accountHandler.CreateAddress)
+		fullnode.POST("/easytransfer", r.accountHandler.EasyTransfer)
+		fullnode.POST("/easytransferbyprivate", r.accountHandler.EasyTransferByPrivate)
+		fullnode.POST("/easytransferasset", r.accountHandler.EasyTransferAsset)
+		fullnode.POST("/easytransferassetbyprivate", r.accountHandler.EasyTransferAssetByPrivate)
+		
+		// Pending Pool
+		fullnode.POST("/gettransactionfrompending", r.transactionHandler.GetTransactionFromPending)
+		fullnode.GET("/gettransactionlistfrompending", r.transactionHandler.GetTransactionListFromPending)
+		fullnode.GET("/getpendingsize", r.transactionHandler.GetPendingSize)
+		
+		// Chain Info
+		fullnode.GET("/getchainparameters", r.nodeHandler.GetChainParameters)
+		fullnode.GET("/totaltransaction", r.nodeHandler.TotalTransaction)
+		fullnode.GET("/getburnlind", r.nodeHandler.GetBurnLind)
+		fullnode.GET("/getenergyprices", r.nodeHandler.GetEnergyPrices)
+		fullnode.GET("/getbandwidthprices", r.nodeHandler.GetBandwidthPrices)
+		fullnode.GET("/getmemofee", r.nodeHandler.GetMemoFeePrices)
+	}
+
+	// Solidity Node API routes
+	solidity := r.engine.Group("/walletsolidity")
+	{
+		// Account
+		solidity.POST("/getaccount", r.accountHandler.GetAccountSolidity)
+		solidity.POST("/getaccountbyid", r.accountHandler.GetAccountByIdSolidity)
+		solidity.POST("/getaccountresource", r.accountHandler.GetAccountResource)
+		solidity.POST("/getaccountnet", r.accountHandler.GetAccountNet)
+		
+		// Transaction
+		solidity.POST("/gettransactionbyid", r.transactionHandler.GetTransactionByIdSolidity)
+		solidity.POST("/gettransactioninfobyid", r.transactionHandler.GetTransactionInfoByIdSolidity)
+		solidity.POST("/gettransactioncountbyblocknum", r.transactionHandler.GetTransactionCountByBlockNum)
+		solidity.POST("/gettransactioninfobyblocknum", r.transactionHandler.GetTransactionInfoByBlockNumSolidity)
+		
+		// Block
+		solidity.POST("/getnowblock", r.blockHandler.GetNowBlockSolidity)
+		solidity.POST("/getblockbynum", r.blockHandler.GetBlockByNumSolidity)
+		solidity.POST("/getblockbyid", r.blockHandler.GetBlockById)
+		solidity.POST("/getblockbylimitnext", r.blockHandler.GetBlockByLimitNext)
+		solidity.POST("/getblockbylatestnum", r.blockHandler.GetBlockByLatestNum)
+		solidity.POST("/getblock", r.blockHandler.GetBlock)
+		
+		// Node Info
+		solidity.POST("/getnodeinfo", r.nodeHandler.GetNodeInfoSolidity)
+		
+		// Asset (LRC-10)
+		solidity.POST("/getassetissuebyid", r.tokenHandler.GetAssetIssueByIdSolidity)
+		solidity.POST("/getassetissuebyname", r.tokenHandler.GetAssetIssueByNameSolidity)
+		solidity.GET("/getassetissuelist", r.tokenHandler.GetAssetIssueListSolidity)
+		solidity.POST("/getassetissuelistbyname", r.tokenHandler.GetAssetIssueListByName)
+		solidity.POST("/getpaginatedassetissuelist", r.tokenHandler.GetPaginatedAssetIssueList)
+		
+		// Exchange
+		solidity.POST("/getexchangebyid", r.contractHandler.GetExchangeById)
+		solidity.GET("/listexchanges", r.contractHandler.ListExchanges)
+		
+		// Market
+		solidity.POST("/getmarketorderbyaccount", r.contractHandler.GetMarketOrderByAccount)
+		solidity.POST("/getmarketorderbyid", r.contractHandler.GetMarketOrderById)
+		solidity.POST("/getmarketpricebypair", r.contractHandler.GetMarketPriceByPair)
+		solidity.POST("/getmarketorderlistbypair", r.contractHandler.GetMarketOrderListByPair)
+		solidity.GET("/getmarketpairlist", r.contractHandler.GetMarketPairList)
+		
+		// Smart Contract
+		solidity.POST("/triggerconstantcontract", r.contractHandler.TriggerConstantContractSolidity)
+		solidity.POST("/estimateenergy", r.contractHandler.EstimateEnergySolidity)
+		solidity.POST("/getcontract", r.contractHandler.GetContract)
+		solidity.POST("/getcontractinfo", r.contractHandler.GetContractInfo)
+		
+		// Witness
+		solidity.GET("/listwitnesses", r.nodeHandler.ListWitnessesSolidity)
+		solidity.POST("/getBrokerage", r.nodeHandler.GetBrokerageSolidity)
+		solidity.POST("/getReward", r.nodeHandler.GetRewardSolidity)
+		solidity.GET("/getpaginatednowwitnesslist", r.nodeHandler.GetPaginatedNowWitnessListSolidity)
+		
+		// Resource Delegation
+		solidity.POST("/getdelegatedresource", r.contractHandler.GetDelegatedResource)
+		solidity.POST("/getdelegatedresourcev2", r.contractHandler.GetDelegatedResourceV2)
+		solidity.POST("/getdelegatedresourceaccountindex", r.contractHandler.GetDelegatedResourceAccountIndex)
+		solidity.POST("/getdelegatedresourceaccountindexv2", r.contractHandler.GetDelegatedResourceAccountIndexV2)
+		solidity.POST("/getcandelegatedmaxsize", r.contractHandler.GetCanDelegatedMaxSize)
+		solidity.POST("/getavailableunfreezecount", r.contractHandler.GetAvailableUnfreezeCount)
+		solidity.POST("/getcanwithdrawunfreezeamount", r.contractHandler.GetCanWithdrawUnfreezeAmount)
+		
+		// Shielded Transaction
+		solidity.POST("/scannotebyivk", r.contractHandler.ScanNoteByIvkSolidity)
+		solidity.POST("/scanandmarknotebyivk", r.contractHandler.ScanAndMarkNoteByIvkSolidity)
+		solidity.POST("/scannotebyovk", r.contractHandler.ScanNoteByOvkSolidity)
+		solidity.POST("/getmerkletreevoucherinfo", r.contractHandler.GetMerkleTreeVoucherInfo)
+		solidity.POST("/isspend", r.contractHandler.IsSpend)
+		solidity.POST("/scanshieldedlrc20notesbyivk", r.contractHandler.GetShieldedLRC20NotesByIvk)
+		solidity.POST("/scanshieldedlrc20notesbyovk", r.contractHandler.GetShieldedLRC20NotesByOvk)
+		solidity.POST("/isshieldedlrc20contractnotespent", r.contractHandler.IsShieldedLRC20ContractNoteSpent)
+		
+		// Burn LIND
+		solidity.GET("/getburnlind", r.nodeHandler.GetBurnLindSolidity)
+	}
+
+	// JSON-RPC API
+	jsonrpc := r.engine.Group("/jsonrpc")
+	{
+		jsonrpc.POST("", r.handleJsonRPC)
+	}
+
+	// Monitor endpoints
+	monitor := r.engine.Group("/monitor")
+	{
+		monitor.GET("/getstatsinfo", r.nodeHandler.GetStatsInfo)
+		monitor.GET("/getnodeinfo", r.nodeHandler.GetNodeInfo)
+	}
+
+	// Network endpoints
+	net := r.engine.Group("/net")
+	{
+		net.GET("/listnodes", r.nodeHandler.ListNodes)
+	}
+}
+
+func (r *Router) Run(addr string) error {
+	return r.engine.Run(addr)
+}
+
+func (r *Router) Engine() *gin.Engine {
+	return r.engine
+}
+
+// Health check handler
+func (r *Router) handleHealth(c *gin.Context) {
+	c.JSON(200, gin.H{
+		"status": "ok",
+		"time":   time.Now().Unix(),
+	})
+}
+
+// Metrics handler
+func (r *Router) handleMetrics(c *gin.Context) {
+	// Prometheus metrics would be exposed here
+	c.String(200, "metrics endpoint")
+}
+
+// Proxy handler for external APIs
+func (r *Router) handleProxy(c *gin.Context) {
+	var req models.ProxyRequestMessage
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Forward request through blockchain client
+	resp, err := r.blockchainClient.ProxyRequest(c.Request.Context(), &req)
+	if err != nil {
+		c.JSON(500, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(int(resp.StatusCode), resp.Body)
+}
+
+// Testnet request handler
+func (r *Router) handleTestnetRequest(c *gin.Context) {
+	var req models.TestnetRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Process testnet request
+	// This would typically interact with a faucet service
+	c.JSON(200, gin.H{
+		"success": true,
+		"txid":    "test_txid",
+	})
+}
+
+// CSV export handler
+func (r *Router) handleCSVExport(c *gin.Context) {
+	var req models.CSVExportRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Set CSV headers
+	c.Header("Content-Type", "text/csv")
+	c.Header("Content-Disposition", "attachment;filename=export.csv")
+
+	// Generate CSV based on type
+	switch req.Type {
+	case "block":
+		r.blockHandler.ExportBlocksToCSV(c, req)
+	case "freezeresource":
+		r.statsHandler.ExportFreezeResourceToCSV(c, req)
+	case "turnover":
+		r.statsHandler.ExportTurnoverToCSV(c, req)
+	case "tokenholders":
+		r.tokenHandler.ExportTokenHoldersToCSV(c, req)
+	default:
+		c.JSON(400, gin.H{"error": "invalid export type"})
+	}
+}
+
+// Logo upload handler
+func (r *Router) handleLogoUpload(c *gin.Context) {
+	var req models.UploadLogoRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Process logo upload
+	c.JSON(200, gin.H{
+		"success": true,
+		"url":     "https://example.com/logo.png",
+	})
+}
+
+// JSON-RPC error codes
+const (
+	jsonRPCParseError     = -32700
+	jsonRPCInvalidRequest = -32600
+	jsonRPCMethodNotFound = -32601
+	jsonRPCServerError    = -32000
+)
+
+// JSON-RPC handler, accepts a single request or a batch array. Errors are
+// returned in the error member of a 200 response as JSON-RPC 2.0 clients expect.
+func (r *Router) handleJsonRPC(c *gin.Context) {
+	body, err := c.GetRawData()
+	if err != nil {
+		c.JSON(200, jsonRPCError(nil, jsonRPCParseError, "Parse error"))
+		return
+	}
+
+	var user *auth.User
+	if u, exists := c.Get("auth_user"); exists {
+		user, _ = u.(*auth.User)
+	}
+
+	body = bytes.TrimSpace(body)
+	if len(body) == 0 || body[0] != '[' {
+		var req map[string]interface{}
+		if err := json.Unmarshal(body, &req); err != nil {
+			c.JSON(200, jsonRPCError(nil, jsonRPCParseError, "Parse error"))
+			return
+		}
+
+		c.JSON(200, r.callJsonRPC(c.Request.Context(), user, req))
+		return
+	}
+
+	var batch []json.RawMessage
+	if err := json.Unmarshal(body, &batch); err != nil {
+		c.JSON(200, jsonRPCError(nil, jsonRPCParseError, "Parse error"))
+		return
+	}
+	if len(batch) == 0 {
+		c.JSON(200, jsonRPCError(nil, jsonRPCInvalidRequest, "Invalid Request"))
+		return
+	}
+	if max := r.config.JSONRPC.MaxBatchSize; max > 0 && len(batch) > max {
+		c.JSON(200, jsonRPCError(nil, jsonRPCInvalidRequest,
+			fmt.Sprintf("batch of %d requests exceeds the limit of %d", len(batch), max)))
+		return
+	}
+
+	concurrency := r.config.JSONRPC.BatchConcurrency
+	if concurrency <= 0 || concurrency > len(batch) {
+		concurrency = len(batch)
+	}
+
+	// Execute concurrently, each result keeps the position of its request
+	responses := make([]gin.H, len(batch))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, raw := range batch {
+		var req map[string]interface{}
+		if err := json.Unmarshal(raw, &req); err != nil {
+			responses[i] = jsonRPCError(nil, jsonRPCInvalidRequest, "Invalid Request")
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, req map[string]interface{}) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			resp := r.callJsonRPC(c.Request.Context(), user, req)
+			// Notifications carry no id and get no response
+			if _, hasID := req["id"]; hasID {
+				responses[i] = resp
+			}
+		}(i, req)
+	}
+	wg.Wait()
+
+	results := make([]gin.H, 0, len(responses))
+	for _, resp := range responses {
+		if resp != nil {
+			results = append(results, resp)
+		}
+	}
+	if len(results) == 0 {
+		c.Status(http.StatusNoContent)
+		return
+	}
+
+	c.JSON(200, results)
+}
+
+// callJsonRPC validates a single JSON-RPC request against the caller's
+// method allowlist and forwards it to the node
+func (r *Router) callJsonRPC(ctx context.Context, user *auth.User, req map[string]interface{}) gin.H {
+	method, ok := req["method"].(string)
+	if !ok || method == "" {
+		return jsonRPCError(req["id"], jsonRPCInvalidRequest, "Invalid Request")
+	}
+
+	if user != nil && !user.IsAnonymous && !user.Allowlist.AllowsJSONRPCMethod(method) {
+		return jsonRPCError(req["id"], jsonRPCMethodNotFound, "method "+method+" is not allowed for this API key")
+	}
+
+	// Serve eth_getLogs from the event index when the range is covered
+	if method == "eth_getLogs" && r.logService != nil {
+		params, _ := req["params"].([]interface{})
+		logs, err := r.logService.GetLogs(ctx, params)
+		if err == nil {
+			return gin.H{"jsonrpc": "2.0", "id": req["id"], "result": logs}
+		}
+		if !errors.Is(err, event.ErrLogsNotIndexed) {
+			var queryErr *event.LogQueryError
+			if errors.As(err, &queryErr) {
+				return jsonRPCError(req["id"], queryErr.Code, queryErr.Message)
+			}
+			return jsonRPCError(req["id"], jsonRPCServerError, err.Error())
+		}
+	}
+
+	// Forward to blockchain client
+	resp, err := r.blockchainClient.JsonRpcForward(ctx, req)
+	if err != nil {
+		return jsonRPCError(req["id"], jsonRPCServerError, err.Error())
+	}
+
+	return gin.H(resp)
+}
+
+func jsonRPCError(id interface{}, code int, message string) gin.H {
+	return gin.H{
+		"jsonrpc": "2.0",
+		"id":      id,
+		"error": gin.H{
+			"code":    code,
+			"message": message,
+		},
+	}
 }
\ No newline at end of file
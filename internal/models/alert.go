@@ -0,0 +1,116 @@
+// internal/models/alert.go
+package models
+
+import (
+	"time"
+)
+
+// Alert rule types
+const (
+	AlertRuleLargeTransfer    = "large_transfer"
+	AlertRuleLowBalance       = "low_balance"
+	AlertRuleDelegation       = "delegation"
+	AlertRulePermissionUpdate = "permission_update"
+)
+
+// Alert notifier names
+const (
+	AlertNotifierWebhook = "webhook"
+	AlertNotifierEmail   = "email"
+	AlertNotifierLog     = "log"
+)
+
+// AssetLIND identifies the native coin in alert rules; LRC-10 tokens are
+// identified by their numeric ID and LRC-20 tokens by their contract address
+const AssetLIND = "LIND"
+
+// Watchlist represents a named set of addresses owned by a user
+type Watchlist struct {
+	ID        string              `gorm:"primaryKey;type:uuid" json:"id"`
+	UserID    string              `gorm:"index;not null" json:"-"`
+	Name      string              `gorm:"type:varchar(100);not null" json:"name"`
+	Addresses []*WatchlistAddress `gorm:"foreignKey:WatchlistID" json:"addresses,omitempty"`
+	CreatedAt time.Time           `json:"created_at"`
+	UpdatedAt time.Time           `json:"updated_at"`
+}
+
+// WatchlistAddress represents an address on a watchlist
+type WatchlistAddress struct {
+	ID          uint      `gorm:"primarykey" json:"-"`
+	WatchlistID string    `gorm:"uniqueIndex:idx_watchlist_address;type:uuid;not null" json:"-"`
+	Address     string    `gorm:"uniqueIndex:idx_watchlist_address;index;type:varchar(42);not null" json:"address"`
+	Label       string    `gorm:"type:varchar(100)" json:"label,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// AlertRule represents a condition evaluated against activity of the addresses on a watchlist
+type AlertRule struct {
+	ID          string    `gorm:"primaryKey;type:uuid" json:"id"`
+	UserID      string    `gorm:"index;not null" json:"-"`
+	WatchlistID string    `gorm:"index;type:uuid;not null" json:"watchlist_id"`
+	Type        string    `gorm:"type:varchar(30);not null" json:"type"`
+	Asset       string    `gorm:"type:varchar(42)" json:"asset,omitempty"`       // empty matches any asset
+	Threshold   string    `gorm:"type:varchar(100)" json:"threshold,omitempty"` // in the asset's smallest unit
+	Notifier    string    `gorm:"type:varchar(20);not null" json:"notifier"`
+	Target      string    `gorm:"type:varchar(512)" json:"target,omitempty"` // callback URL or email address
+	Secret      string    `gorm:"type:varchar(128)" json:"-"`
+	DedupWindow int64     `json:"dedup_window"` // seconds
+	Active      bool      `gorm:"index;default:true" json:"active"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// Alert represents a fired alert, kept as the alert history
+type Alert struct {
+	ID             string     `gorm:"primaryKey;type:uuid" json:"id"`
+	RuleID         string     `gorm:"index;type:uuid;not null" json:"rule_id"`
+	UserID         string     `gorm:"index;not null" json:"-"`
+	Type           string     `gorm:"type:varchar(30)" json:"type"`
+	Address        string     `gorm:"index;type:varchar(42)" json:"address"`
+	Asset          string     `gorm:"type:varchar(42)" json:"asset,omitempty"`
+	Amount         string     `gorm:"type:varchar(100)" json:"amount,omitempty"`
+	TransactionID  string     `gorm:"type:varchar(64)" json:"transaction_id,omitempty"`
+	BlockNumber    int64      `json:"block_number"`
+	BlockTimestamp int64      `json:"block_timestamp"`
+	Message        string     `gorm:"type:text" json:"message"`
+	DedupKey       string     `gorm:"index;type:varchar(200)" json:"-"`
+	NotifiedAt     *time.Time `json:"notified_at,omitempty"`
+	NotifyError    string     `gorm:"type:text" json:"notify_error,omitempty"`
+	CreatedAt      time.Time  `gorm:"index" json:"created_at"`
+}
+
+// WatchlistRequest is the body of POST /api/watchlists
+type WatchlistRequest struct {
+	Name      string                    `json:"name" binding:"required"`
+	Addresses []WatchlistAddressRequest `json:"addresses"`
+}
+
+// WatchlistAddressRequest is the body of POST /api/watchlists/:id/addresses
+type WatchlistAddressRequest struct {
+	Address string `json:"address" binding:"required"`
+	Label   string `json:"label"`
+}
+
+// AlertRuleRequest is the body of POST /api/alert-rules
+type AlertRuleRequest struct {
+	WatchlistID string `json:"watchlist_id" binding:"required"`
+	Type        string `json:"type" binding:"required,oneof=large_transfer low_balance delegation permission_update"`
+	Asset       string `json:"asset"`
+	Threshold   string `json:"threshold"`
+	Notifier    string `json:"notifier" binding:"required,oneof=webhook email log"`
+	Target      string `json:"target"`
+	DedupWindow int64  `json:"dedup_window"`
+}
+
+// AlertRuleResponse is returned when a rule is created, the webhook secret is only shown once
+type AlertRuleResponse struct {
+	*AlertRule
+	Secret string `json:"secret,omitempty"`
+}
+
+// AlertListRequest represents alert history query parameters
+type AlertListRequest struct {
+	RuleID string `form:"rule_id"`
+	Start  int    `form:"start"`
+	Limit  int    `form:"limit"`
+}
@@ -0,0 +1,61 @@
+// internal/models/balance.go
+package models
+
+import (
+	"strconv"
+	"time"
+)
+
+// Balance delta kinds, the record of a transaction a delta comes from
+const (
+	BalanceDeltaContract = "contract"
+	BalanceDeltaReceipt  = "receipt"
+	BalanceDeltaOpening  = "opening"
+)
+
+// BalanceDeltaTransfer returns the kind of the deltas of the token transfer
+// at a log index, models.NoLogIndex for LRC-10 transfers
+func BalanceDeltaTransfer(logIndex int) string {
+	return "transfer:" + strconv.Itoa(logIndex)
+}
+
+// BalanceDelta represents a change of the balance of an address in one asset,
+// identified like alert rule assets. Summing the deltas of an address up to a
+// block gives its balance at that block. Kind and Seq, the position of the
+// delta among those of its address and asset in the record, identify a delta
+// so indexing a transaction again does not count it twice.
+type BalanceDelta struct {
+	ID             uint      `gorm:"primarykey" json:"-"`
+	Address        string    `gorm:"index:idx_balance_delta;uniqueIndex:idx_balance_delta_source;type:varchar(42)" json:"address"`
+	Asset          string    `gorm:"index:idx_balance_delta;uniqueIndex:idx_balance_delta_source;type:varchar(42)" json:"asset"`
+	BlockNumber    int64     `gorm:"index:idx_balance_delta;index" json:"block_number"`
+	BlockTimestamp int64     `gorm:"index" json:"block_timestamp"`
+	TransactionID  string    `gorm:"uniqueIndex:idx_balance_delta_source;type:varchar(64)" json:"transaction_id"`
+	Kind           string    `gorm:"uniqueIndex:idx_balance_delta_source;type:varchar(20)" json:"-"`
+	Seq            int       `gorm:"uniqueIndex:idx_balance_delta_source" json:"-"`
+	Delta          string    `gorm:"type:numeric(78,0);not null" json:"delta"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// BalancePoint is the balance of an address at the end of a history interval
+type BalancePoint struct {
+	Timestamp int64  `json:"timestamp"`
+	Balance   string `json:"balance"`
+}
+
+// BalanceHistoryRequest represents balance history query parameters, from
+// and to are millisecond timestamps
+type BalanceHistoryRequest struct {
+	Address  string `form:"address" binding:"required"`
+	Token    string `form:"token"`
+	From     int64  `form:"from"`
+	To       int64  `form:"to"`
+	Interval string `form:"interval"`
+}
+
+// BalanceAtBlockRequest represents an "as of block" balance query
+type BalanceAtBlockRequest struct {
+	Address string `form:"address" binding:"required"`
+	Token   string `form:"token"`
+	Block   int64  `form:"block"`
+}
@@ -0,0 +1,148 @@
+// internal/models/contract_stats.go
+package models
+
+import (
+	"time"
+)
+
+// ContractStatDayLength is the length of a contract statistics day in milliseconds
+const ContractStatDayLength = 24 * 60 * 60 * 1000
+
+// ContractTrigger represents a TriggerSmartContract transaction and the energy charged by its receipt.
+// CallerEnergy is paid by the caller from staked energy or by burning EnergyFee, OwnerEnergy by the
+// contract owner.
+type ContractTrigger struct {
+	ID             uint      `gorm:"primarykey" json:"-"`
+	TransactionID  string    `gorm:"uniqueIndex;type:varchar(64)" json:"transaction_id"`
+	BlockNumber    int64     `gorm:"index" json:"block_number"`
+	BlockTimestamp int64     `gorm:"index:idx_contract_trigger" json:"block_timestamp"`
+	Contract       string    `gorm:"index:idx_contract_trigger;type:varchar(42)" json:"contract"`
+	Caller         string    `gorm:"index;type:varchar(42)" json:"caller"`
+	CallValue      int64     `json:"call_value"`
+	EnergyUsed     int64     `json:"energy_used"`
+	CallerEnergy   int64     `json:"caller_energy"`
+	OwnerEnergy    int64     `json:"owner_energy"`
+	EnergyFee      int64     `json:"energy_fee"`
+	Fee            int64     `json:"fee"`
+	Failed         bool      `json:"failed"`
+	Result         string    `gorm:"type:varchar(32)" json:"result"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// ContractDailyStat is the rollup of the triggers of a contract on one UTC day, Day is its start
+type ContractDailyStat struct {
+	ID            uint      `gorm:"primarykey" json:"-"`
+	Contract      string    `gorm:"uniqueIndex:idx_contract_day;type:varchar(42)" json:"contract"`
+	Day           int64     `gorm:"uniqueIndex:idx_contract_day;index" json:"day"`
+	Triggers      int64     `json:"triggers"`
+	Failed        int64     `json:"failed"`
+	UniqueCallers int64     `json:"unique_callers"`
+	CallValue     int64     `json:"call_value"`
+	EnergyUsed    int64     `json:"energy_used"`
+	CallerEnergy  int64     `json:"caller_energy"`
+	OwnerEnergy   int64     `json:"owner_energy"`
+	EnergyFee     int64     `json:"energy_fee"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// ContractDailyCaller is the rollup of the triggers of a contract by one caller on one UTC day
+type ContractDailyCaller struct {
+	ID         uint   `gorm:"primarykey" json:"-"`
+	Contract   string `gorm:"uniqueIndex:idx_contract_day_caller;type:varchar(42)" json:"contract"`
+	Day        int64  `gorm:"uniqueIndex:idx_contract_day_caller" json:"day"`
+	Caller     string `gorm:"uniqueIndex:idx_contract_day_caller;type:varchar(42)" json:"caller"`
+	Triggers   int64  `json:"triggers"`
+	EnergyUsed int64  `json:"energy_used"`
+}
+
+// ContractStatDay returns the start of the UTC day holding a millisecond timestamp
+func ContractStatDay(timestamp int64) int64 {
+	return timestamp / ContractStatDayLength * ContractStatDayLength
+}
+
+// FailureRate returns the share of failed calls among the calls, 0 without calls
+func FailureRate(failed, triggers int64) float64 {
+	if triggers == 0 {
+		return 0
+	}
+	return float64(failed) / float64(triggers)
+}
+
+// SumContractDailyPoints totals a contract statistic series
+func SumContractDailyPoints(points []*ContractDailyPoint) ContractStatTotals {
+	var totals ContractStatTotals
+	for _, point := range points {
+		totals.Triggers += point.Triggers
+		totals.Failed += point.Failed
+		totals.CallValue += point.CallValue
+		totals.EnergyUsed += point.EnergyUsed
+		totals.CallerEnergy += point.CallerEnergy
+		totals.OwnerEnergy += point.OwnerEnergy
+		totals.EnergyFee += point.EnergyFee
+	}
+	totals.FailureRate = FailureRate(totals.Failed, totals.Triggers)
+	return totals
+}
+
+// ContractDailyPoint is one day of a contract statistic series
+type ContractDailyPoint struct {
+	Date          string  `json:"date"`
+	Triggers      int64   `json:"triggers"`
+	Failed        int64   `json:"failed"`
+	FailureRate   float64 `json:"failure_rate"`
+	UniqueCallers int64   `json:"unique_callers"`
+	CallValue     int64   `json:"call_value"`
+	EnergyUsed    int64   `json:"energy_used"`
+	CallerEnergy  int64   `json:"caller_energy"`
+	OwnerEnergy   int64   `json:"owner_energy"`
+	EnergyFee     int64   `json:"energy_fee"`
+}
+
+// ContractStatTotals sums a contract statistic series
+type ContractStatTotals struct {
+	Triggers     int64   `json:"triggers"`
+	Failed       int64   `json:"failed"`
+	FailureRate  float64 `json:"failure_rate"`
+	CallValue    int64   `json:"call_value"`
+	EnergyUsed   int64   `json:"energy_used"`
+	CallerEnergy int64   `json:"caller_energy"`
+	OwnerEnergy  int64   `json:"owner_energy"`
+	EnergyFee    int64   `json:"energy_fee"`
+}
+
+// ContractCallerStat is the activity of one caller of a contract over a period
+type ContractCallerStat struct {
+	Address    string  `json:"address"`
+	Triggers   int64   `json:"triggers"`
+	EnergyUsed int64   `json:"energy_used"`
+	Share      float64 `json:"share"` // of the contract triggers in the period
+}
+
+// ContractStatRequest represents contract statistic query parameters, from and to are millisecond timestamps
+type ContractStatRequest struct {
+	Contract string `form:"contract"`
+	From     int64  `form:"from"`
+	To       int64  `form:"to"`
+	Start    int    `form:"start"`
+	Limit    int    `form:"limit"`
+}
+
+// Request types of the contract statistic endpoints
+type (
+	EnergyStatisticRequest struct {
+		Address string `form:"address"`
+		From    int64  `form:"from"`
+		To      int64  `form:"to"`
+	}
+	EnergyDailyStatisticRequest struct {
+		From int64 `form:"from"`
+		To   int64 `form:"to"`
+	}
+	TriggerStatisticRequest            = ContractStatRequest
+	TriggerAmountStatisticRequest      = ContractStatRequest
+	CallerAddressStatisticRequest      = ContractStatRequest
+	OneContractEnergyStatisticRequest  = ContractStatRequest
+	OneContractTriggerStatisticRequest = ContractStatRequest
+	OneContractCallerStatisticRequest  = ContractStatRequest
+	OneContractCallersRequest          = ContractStatRequest
+)
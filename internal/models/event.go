@@ -1,22 +1,33 @@
-// internal/models/event.go
-package models
-
-import (
-	"time"
-)
-
-// Event represents a blockchain event database model
-type Event struct {
-	ID              uint      `gorm:"primarykey" json:"-"`
-	BlockNumber     int64     `gorm:"index" json:"block_number"`
-	BlockTimestamp  int64     `gorm:"index" json:"block_timestamp"`
-	ContractAddress string    `gorm:"index;type:varchar(42)" json:"contract_address"`
-	EventIndex      string    `json:"event_index"`
-	EventName       string    `gorm:"index;type:varchar(100)" json:"event_name"`
-	EventSignature  string    `gorm:"type:varchar(256)" json:"event_signature"`
-	TransactionID   string    `gorm:"index;type:varchar(64)" json:"transaction_id"`
-	Result          JSON      `gorm:"type:jsonb" json:"result"`
-	ResultType      JSON      `gorm:"type:jsonb" json:"result_type"`
-	Unconfirmed     bool      `gorm:"default:false" json:"unconfirmed"`
-	CreatedAt       time.Time `json:"created_at"`
+// internal/models/event.go
+package models
+
+import (
+	"time"
+)
+
+// Event represents a blockchain event database model
+type Event struct {
+	ID              uint      `gorm:"primarykey" json:"-"`
+	BlockNumber     int64     `gorm:"index" json:"block_number"`
+	BlockTimestamp  int64     `gorm:"index" json:"block_timestamp"`
+	ContractAddress string    `gorm:"index;type:varchar(42)" json:"contract_address"`
+	EventIndex      string    `json:"event_index"`
+	EventName       string    `gorm:"index;type:varchar(100)" json:"event_name"`
+	EventSignature  string    `gorm:"type:varchar(256)" json:"event_signature"`
+	TransactionID   string    `gorm:"index;type:varchar(64)" json:"transaction_id"`
+	Result          JSON      `gorm:"type:jsonb" json:"result"`
+	ResultType      JSON      `gorm:"type:jsonb" json:"result_type"`
+	Unconfirmed     bool      `gorm:"index;default:false" json:"unconfirmed"`
+	CreatedAt       time.Time `json:"created_at"`
+
+	// Raw log fields, kept so eth_getLogs can be answered from the index
+	BlockHash        string `gorm:"index;type:varchar(64)" json:"block_hash"`
+	TransactionIndex int    `json:"transaction_index"`
+	LogIndex         int    `json:"log_index"`
+	Topic0           string `gorm:"index;type:varchar(64)" json:"-"`
+	Topic1           string `gorm:"index;type:varchar(64)" json:"-"`
+	Topic2           string `gorm:"index;type:varchar(64)" json:"-"`
+	Topic3           string `gorm:"index;type:varchar(64)" json:"-"`
+	Topics           JSON   `gorm:"type:jsonb" json:"topics"`
+	Data             string `gorm:"type:text" json:"data"`
 }
\ No newline at end of file
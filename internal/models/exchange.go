@@ -0,0 +1,146 @@
+// internal/models/exchange.go
+package models
+
+import (
+	"time"
+)
+
+// Trade sides stored in ExchangeTrade.Side and MarketTrade.Side, relative to the base token
+const (
+	TradeSideBuy  = "buy"
+	TradeSideSell = "sell"
+)
+
+// Liquidity actions stored in ExchangeLiquidityEvent.Action
+const (
+	LiquidityActionCreate   = "create"
+	LiquidityActionInject   = "inject"
+	LiquidityActionWithdraw = "withdraw"
+)
+
+// Market order states stored in MarketOrder.State, as named by the
+// MarketOrder.State enum of the node
+const (
+	MarketOrderStateActive   = "ACTIVE"
+	MarketOrderStateInactive = "INACTIVE"
+	MarketOrderStateCanceled = "CANCELED"
+)
+
+// ExchangeTrade represents an ExchangeTransactionContract against a Bancor exchange.
+// The base token is the first token of the exchange, the price is in quote tokens per base token.
+type ExchangeTrade struct {
+	ID             uint      `gorm:"primarykey" json:"-"`
+	TransactionID  string    `gorm:"uniqueIndex;type:varchar(64)" json:"transaction_id"`
+	BlockNumber    int64     `gorm:"index" json:"block_number"`
+	BlockTimestamp int64     `gorm:"index:idx_exchange_trade" json:"block_timestamp"`
+	ExchangeID     int64     `gorm:"index:idx_exchange_trade" json:"exchange_id"`
+	Trader         string    `gorm:"index;type:varchar(42)" json:"trader"`
+	Side           string    `gorm:"type:varchar(4)" json:"side"`
+	BaseAmount     int64     `json:"base_amount"`
+	QuoteAmount    int64     `json:"quote_amount"`
+	Price          float64   `json:"price"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// ExchangeLiquidityEvent represents an ExchangeCreate, ExchangeInject or
+// ExchangeWithdraw transaction. Amounts are the signed changes of the exchange balances.
+type ExchangeLiquidityEvent struct {
+	ID                uint      `gorm:"primarykey" json:"-"`
+	TransactionID     string    `gorm:"uniqueIndex;type:varchar(64)" json:"transaction_id"`
+	BlockNumber       int64     `gorm:"index" json:"block_number"`
+	BlockTimestamp    int64     `gorm:"index" json:"block_timestamp"`
+	ExchangeID        int64     `gorm:"index" json:"exchange_id"`
+	Provider          string    `gorm:"index;type:varchar(42)" json:"provider"`
+	Action            string    `gorm:"type:varchar(10)" json:"action"`
+	FirstTokenAmount  int64     `json:"first_token_amount"`
+	SecondTokenAmount int64     `json:"second_token_amount"`
+	CreatedAt         time.Time `json:"created_at"`
+}
+
+// MarketOrder represents an order placed by a MarketSellAsset transaction.
+// FilledQuantity is the part of SellQuantity matched so far.
+type MarketOrder struct {
+	ID             uint      `gorm:"primarykey" json:"-"`
+	OrderID        string    `gorm:"uniqueIndex;type:varchar(64)" json:"order_id"`
+	TransactionID  string    `gorm:"index;type:varchar(64)" json:"transaction_id"`
+	BlockNumber    int64     `gorm:"index" json:"block_number"`
+	BlockTimestamp int64     `json:"block_timestamp"`
+	Owner          string    `gorm:"index;type:varchar(42)" json:"owner"`
+	Pair           string    `gorm:"index;type:varchar(64)" json:"pair"`
+	SellTokenID    string    `gorm:"type:varchar(32)" json:"sell_token_id"`
+	SellQuantity   int64     `json:"sell_quantity"`
+	BuyTokenID     string    `gorm:"type:varchar(32)" json:"buy_token_id"`
+	BuyQuantity    int64     `json:"buy_quantity"`
+	FilledQuantity int64     `json:"filled_quantity"`
+	State          string    `gorm:"type:varchar(10)" json:"state"`
+	CanceledBlock  int64     `json:"canceled_block,omitempty"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// MarketTrade represents one fill of a MarketSellAsset order against a resting order.
+// The price is in quote tokens per base token of the pair.
+type MarketTrade struct {
+	ID             uint      `gorm:"primarykey" json:"-"`
+	TransactionID  string    `gorm:"index;type:varchar(64)" json:"transaction_id"`
+	BlockNumber    int64     `gorm:"index" json:"block_number"`
+	BlockTimestamp int64     `gorm:"index:idx_market_trade" json:"block_timestamp"`
+	Pair           string    `gorm:"index:idx_market_trade;type:varchar(64)" json:"pair"`
+	MakerOrderID   string    `gorm:"type:varchar(64)" json:"maker_order_id"`
+	TakerOrderID   string    `gorm:"type:varchar(64)" json:"taker_order_id"`
+	Taker          string    `gorm:"index;type:varchar(42)" json:"taker"`
+	Side           string    `gorm:"type:varchar(4)" json:"side"`
+	BaseAmount     int64     `json:"base_amount"`
+	QuoteAmount    int64     `json:"quote_amount"`
+	Price          float64   `json:"price"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// MarketPair orders two token IDs into the base and quote token of their
+// market pair and returns the pair key "<base>-<quote>"
+func MarketPair(tokenA, tokenB string) (pair, base, quote string) {
+	base, quote = tokenA, tokenB
+	if quote < base {
+		base, quote = quote, base
+	}
+	return base + "-" + quote, base, quote
+}
+
+// Candle is the OHLCV summary of the trades in one interval, Time is its start
+type Candle struct {
+	Time        int64   `json:"time"`
+	Open        float64 `json:"open"`
+	High        float64 `json:"high"`
+	Low         float64 `json:"low"`
+	Close       float64 `json:"close"`
+	Volume      int64   `json:"volume"`
+	QuoteVolume int64   `json:"quote_volume"`
+	Trades      int64   `json:"trades"`
+}
+
+// TradeVolume is the traded base and quote amounts over a period
+type TradeVolume struct {
+	Volume      int64 `json:"volume"`
+	QuoteVolume int64 `json:"quote_volume"`
+	Trades      int64 `json:"trades"`
+}
+
+// LiquidityPoint is the balance of an exchange at the end of a day
+type LiquidityPoint struct {
+	Date               string `json:"date"`
+	FirstTokenBalance  int64  `json:"first_token_balance"`
+	SecondTokenBalance int64  `json:"second_token_balance"`
+}
+
+// CandleRequest represents candle query parameters, from and to are millisecond timestamps
+type CandleRequest struct {
+	Interval string `form:"interval"`
+	From     int64  `form:"from"`
+	To       int64  `form:"to"`
+}
+
+// TradeHistoryRequest represents trade history query parameters
+type TradeHistoryRequest struct {
+	Start int `form:"start"`
+	Limit int `form:"limit"`
+}
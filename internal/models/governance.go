@@ -0,0 +1,79 @@
+// internal/models/governance.go
+package models
+
+import (
+	"time"
+)
+
+// Proposal states stored in GovernanceProposal.State, as named by the
+// Proposal.State enum of the node
+const (
+	ProposalStatePending     = "PENDING"
+	ProposalStateDisapproved = "DISAPPROVED"
+	ProposalStateApproved    = "APPROVED"
+	ProposalStateCanceled    = "CANCELED"
+)
+
+// GovernanceProposal represents a proposal created by a ProposalCreate transaction.
+// ResolvedBlock is the block in which the proposal was canceled or found resolved at expiry.
+type GovernanceProposal struct {
+	ProposalID     int64     `gorm:"primaryKey;autoIncrement:false" json:"proposal_id"`
+	TransactionID  string    `gorm:"index;type:varchar(64)" json:"transaction_id"`
+	BlockNumber    int64     `gorm:"index" json:"block_number"`
+	Proposer       string    `gorm:"index;type:varchar(42)" json:"proposer"`
+	Parameters     JSON      `gorm:"type:jsonb" json:"parameters"`
+	CreateTime     int64     `json:"create_time"`
+	ExpirationTime int64     `gorm:"index" json:"expiration_time"`
+	State          string    `gorm:"index;type:varchar(20)" json:"state"`
+	ApprovalCount  int       `json:"approval_count"`
+	ResolvedBlock  int64     `json:"resolved_block,omitempty"`
+	ResolvedAt     int64     `json:"resolved_at,omitempty"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// ProposalApproval represents a ProposalApprove transaction, Approve is false
+// when the witness withdrew its approval
+type ProposalApproval struct {
+	ID             uint      `gorm:"primarykey" json:"-"`
+	ProposalID     int64     `gorm:"index" json:"proposal_id"`
+	TransactionID  string    `gorm:"index;type:varchar(64)" json:"transaction_id"`
+	BlockNumber    int64     `gorm:"index" json:"block_number"`
+	BlockTimestamp int64     `json:"block_timestamp"`
+	Witness        string    `gorm:"index;type:varchar(42)" json:"witness"`
+	Approve        bool      `json:"approve"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// ChainParameterChange represents a chain parameter value observed at a
+// maintenance period. OldValue is nil for the first observation of a parameter.
+type ChainParameterChange struct {
+	ID          uint      `gorm:"primarykey" json:"-"`
+	Key         string    `gorm:"index;type:varchar(64)" json:"key"`
+	OldValue    *int64    `json:"old_value"`
+	NewValue    int64     `json:"new_value"`
+	BlockNumber int64     `gorm:"index" json:"block_number"`
+	ChangedAt   int64     `gorm:"index" json:"changed_at"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// ProposalTimeline is an indexed proposal with the approvals cast for it, oldest first
+type ProposalTimeline struct {
+	ProposalResponse
+	Timeline []*ProposalApproval `json:"timeline"`
+}
+
+// AccountProposalRequest represents account proposal query parameters, all
+// proposals are listed when address is empty
+type AccountProposalRequest struct {
+	Address string `form:"address"`
+	Start   int    `form:"start"`
+	Limit   int    `form:"limit"`
+}
+
+// ChainParameterHistoryRequest represents chain parameter history query parameters
+type ChainParameterHistoryRequest struct {
+	Key   string `form:"key"`
+	Start int    `form:"start"`
+	Limit int    `form:"limit"`
+}
@@ -0,0 +1,95 @@
+// internal/models/nft.go
+package models
+
+import (
+	"time"
+)
+
+// NFT standards detected through supportsInterface
+const (
+	NFTStandardLRC721  = "LRC721"
+	NFTStandardLRC1155 = "LRC1155"
+)
+
+// NFTCollection represents an LRC-721 or LRC-1155 contract, first seen at BlockNumber
+type NFTCollection struct {
+	ID          uint      `gorm:"primarykey" json:"-"`
+	Contract    string    `gorm:"uniqueIndex;type:varchar(42)" json:"contract"`
+	Standard    string    `gorm:"index;type:varchar(10)" json:"standard"`
+	Name        string    `gorm:"type:varchar(100)" json:"name"`
+	Symbol      string    `gorm:"type:varchar(20)" json:"symbol"`
+	Owner       string    `gorm:"type:varchar(42)" json:"owner"`
+	Tokens      int64     `json:"tokens"`
+	Transfers   int64     `json:"transfers"`
+	BlockNumber int64     `gorm:"index" json:"block_number"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// NFTToken represents a single token of a collection and its metadata URI, first seen at BlockNumber
+type NFTToken struct {
+	ID          uint      `gorm:"primarykey" json:"-"`
+	Contract    string    `gorm:"uniqueIndex:idx_nft_token;type:varchar(42)" json:"contract"`
+	TokenID     string    `gorm:"uniqueIndex:idx_nft_token;type:varchar(80)" json:"token_id"`
+	URI         string    `gorm:"type:text" json:"uri,omitempty"`
+	BlockNumber int64     `gorm:"index" json:"block_number"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// NFTBalance represents the amount of a token held by an address. LRC-721
+// tokens have a single row with balance 1 for their current owner. A negative
+// balance is the amount sent from a holding that predates the indexed range.
+type NFTBalance struct {
+	ID        uint      `gorm:"primarykey" json:"-"`
+	Contract  string    `gorm:"uniqueIndex:idx_nft_balance;type:varchar(42)" json:"contract"`
+	TokenID   string    `gorm:"uniqueIndex:idx_nft_balance;type:varchar(80)" json:"token_id"`
+	Owner     string    `gorm:"uniqueIndex:idx_nft_balance;index;type:varchar(42)" json:"owner"`
+	Balance   string    `gorm:"type:varchar(100)" json:"balance"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// NFTTransfer represents the movement of one token id, a TransferBatch is
+// stored as one row per id
+type NFTTransfer struct {
+	ID             uint      `gorm:"primarykey" json:"-"`
+	TransactionID  string    `gorm:"index;uniqueIndex:idx_nft_transfer_log;type:varchar(64)" json:"transaction_id"`
+	BlockNumber    int64     `gorm:"index" json:"block_number"`
+	BlockTimestamp int64     `gorm:"index" json:"block_timestamp"`
+	LogIndex       int       `gorm:"uniqueIndex:idx_nft_transfer_log" json:"log_index"`
+	Contract       string    `gorm:"index:idx_nft_transfer_token;type:varchar(42)" json:"contract"`
+	Standard       string    `gorm:"type:varchar(10)" json:"standard"`
+	TokenID        string    `gorm:"index:idx_nft_transfer_token;uniqueIndex:idx_nft_transfer_log;type:varchar(80)" json:"token_id"`
+	Operator       string    `gorm:"type:varchar(42)" json:"operator,omitempty"`
+	From           string    `gorm:"index;type:varchar(42)" json:"from"`
+	To             string    `gorm:"index;type:varchar(42)" json:"to"`
+	Value          string    `gorm:"type:varchar(100)" json:"value"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// NFTOwnedToken is a token held by an address together with its collection and metadata URI
+type NFTOwnedToken struct {
+	Contract string `json:"contract"`
+	Standard string `json:"standard"`
+	Name     string `json:"name,omitempty"`
+	Symbol   string `json:"symbol,omitempty"`
+	TokenID  string `json:"token_id"`
+	Balance  string `json:"balance"`
+	URI      string `json:"uri,omitempty"`
+}
+
+// NFTCollectionsRequest represents collection list query parameters
+type NFTCollectionsRequest struct {
+	Standard string `form:"standard"`
+	Start    int    `form:"start"`
+	Limit    int    `form:"limit"`
+}
+
+// NFTTransfersRequest represents NFT transfer history query parameters
+type NFTTransfersRequest struct {
+	Contract string `form:"contract"`
+	TokenID  string `form:"token_id"`
+	Address  string `form:"address"`
+	Start    int    `form:"start"`
+	Limit    int    `form:"limit"`
+}
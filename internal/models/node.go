@@ -0,0 +1,124 @@
+// internal/models/node.go
+package models
+
+import (
+	"time"
+)
+
+// Node types reported in NodeInfo.NodeType
+const (
+	NodeTypeFull     = "FullNode"
+	NodeTypeSolidity = "SolidityNode"
+	NodeTypeWitness  = "WitnessNode"
+)
+
+// GeoIPRecord represents the cached GeoIP resolution of an IP address.
+// DatabaseBuild is the build epoch of the City database it was resolved with,
+// rows from an older database are resolved again.
+type GeoIPRecord struct {
+	IP             string    `gorm:"primaryKey;type:varchar(45)" json:"ip"`
+	CountryCode    string    `gorm:"index;type:varchar(2)" json:"country_code"`
+	Country        string    `gorm:"type:varchar(64)" json:"country"`
+	City           string    `gorm:"type:varchar(128)" json:"city"`
+	Latitude       float64   `json:"latitude"`
+	Longitude      float64   `json:"longitude"`
+	ASN            uint64    `gorm:"column:asn" json:"asn"`
+	ASOrganization string    `gorm:"type:varchar(255)" json:"as_organization"`
+	Hosting        bool      `json:"hosting"`
+	DatabaseBuild  uint64    `json:"-"`
+	ResolvedAt     time.Time `json:"resolved_at"`
+}
+
+// NodeReport represents the latest telemetry uploaded by a node. Reporter is the
+// witness address that signed the upload or "apikey:<id>" for API key uploads.
+type NodeReport struct {
+	Host                string    `gorm:"primaryKey;type:varchar(255)" json:"host"`
+	Port                int32     `json:"port"`
+	Reporter            string    `gorm:"index;type:varchar(64)" json:"reporter"`
+	NodeType            string    `gorm:"type:varchar(20)" json:"node_type"`
+	Version             string    `gorm:"index;type:varchar(50)" json:"version"`
+	Location            string    `gorm:"type:varchar(128)" json:"location"`
+	BlockHeight         int64     `json:"block_height"`
+	SolidityBlockHeight int64     `json:"solidity_block_height"`
+	Peers               int       `json:"peers"`
+	Syncing             bool      `json:"syncing"`
+	Uptime              int64     `json:"uptime"`
+	ReportedAt          time.Time `gorm:"index" json:"reported_at"`
+	Lag                 int64     `gorm:"-" json:"lag,omitempty"`
+}
+
+// NodeTelemetry represents one telemetry upload of a node, kept for the retention period
+type NodeTelemetry struct {
+	ID                  uint      `gorm:"primarykey" json:"-"`
+	Host                string    `gorm:"index:idx_node_telemetry_host;type:varchar(255)" json:"host"`
+	Port                int32     `json:"port"`
+	Reporter            string    `gorm:"type:varchar(64)" json:"reporter"`
+	NodeType            string    `gorm:"type:varchar(20)" json:"node_type"`
+	Version             string    `gorm:"type:varchar(50)" json:"version"`
+	BlockHeight         int64     `json:"block_height"`
+	SolidityBlockHeight int64     `json:"solidity_block_height"`
+	Peers               int       `json:"peers"`
+	Syncing             bool      `json:"syncing"`
+	Uptime              int64     `json:"uptime"`
+	OSName              string    `gorm:"column:os_name;type:varchar(64)" json:"os_name,omitempty"`
+	CPUCount            int       `gorm:"column:cpu_count" json:"cpu_count,omitempty"`
+	CPURate             float64   `gorm:"column:cpu_rate" json:"cpu_rate,omitempty"`
+	MemoryTotal         int64     `json:"memory_total,omitempty"`
+	MemoryFree          int64     `json:"memory_free,omitempty"`
+	DiskTotal           int64     `json:"disk_total,omitempty"`
+	DiskFree            int64     `json:"disk_free,omitempty"`
+	ReportedAt          time.Time `gorm:"index:idx_node_telemetry_host;index" json:"reported_at"`
+}
+
+// NodeTelemetryUpload is the body of /api/v2/node/overview_upload and
+// /api/v2/node/info_upload. Address is the host:port of the node.
+type NodeTelemetryUpload struct {
+	Address             string           `json:"address" binding:"required"`
+	NodeType            string           `json:"node_type"`
+	Version             string           `json:"version"`
+	Location            string           `json:"location"`
+	BlockHeight         int64            `json:"block_height"`
+	SolidityBlockHeight int64            `json:"solidity_block_height"`
+	Peers               int              `json:"peers"`
+	Syncing             bool             `json:"syncing"`
+	Uptime              int64            `json:"uptime"` // seconds
+	MachineInfo         *NodeMachineInfo `json:"machine_info"`
+}
+
+// NodeMachineInfo is the host machine state in a telemetry upload
+type NodeMachineInfo struct {
+	OSName      string  `json:"os_name"`
+	CPUCount    int     `json:"cpu_count"`
+	CPURate     float64 `json:"cpu_rate"`
+	MemoryTotal int64   `json:"memory_total"`
+	MemoryFree  int64   `json:"memory_free"`
+	DiskTotal   int64   `json:"disk_total"`
+	DiskFree    int64   `json:"disk_free"`
+}
+
+// NodeVersionCount is the number of reporting nodes running a version
+type NodeVersionCount struct {
+	Version    string  `json:"version"`
+	Count      int64   `json:"count"`
+	Percentage float64 `json:"percentage"`
+}
+
+// LaggingNodesRequest represents lagging node query parameters, threshold is in blocks
+type LaggingNodesRequest struct {
+	Threshold int64 `form:"threshold"`
+}
+
+// NodeHistoryRequest represents node telemetry history query parameters, from and to are millisecond timestamps
+type NodeHistoryRequest struct {
+	From  int64 `form:"from"`
+	To    int64 `form:"to"`
+	Start int   `form:"start"`
+	Limit int   `form:"limit"`
+}
+
+// NodeCountryCount is the number of nodes located in a country
+type NodeCountryCount struct {
+	CountryCode string `json:"countryCode"`
+	Country     string `json:"country"`
+	Count       int    `json:"count"`
+}
@@ -0,0 +1,41 @@
+// internal/models/portfolio.go
+package models
+
+// PortfolioResponse aggregates every asset held by an address at an indexed block height
+type PortfolioResponse struct {
+	Address       string            `json:"address"`
+	BlockNumber   int64             `json:"block_number"`
+	LIND          PortfolioLIND     `json:"lind"`
+	LRC10         []*PortfolioToken `json:"lrc10"`
+	LRC20         []*PortfolioToken `json:"lrc20"`
+	NFTs          []*NFTOwnedToken  `json:"nfts"`
+	NFTTotal      int64             `json:"nft_total"`
+	TotalValueUSD *float64          `json:"total_value_usd,omitempty"`
+}
+
+// PortfolioLIND breaks the LIND of an address down by state, amounts in sun.
+// Total is what the address owns: delegated in resources are not included.
+type PortfolioLIND struct {
+	Balance         int64    `json:"balance"`
+	FrozenV1        int64    `json:"frozen_v1"`
+	FrozenV2        int64    `json:"frozen_v2"`
+	DelegatedOut    int64    `json:"delegated_out"`
+	DelegatedIn     int64    `json:"delegated_in"`
+	PendingUnfreeze int64    `json:"pending_unfreeze"`
+	Withdrawable    int64    `json:"withdrawable"`
+	Total           int64    `json:"total"`
+	PriceUSD        *float64 `json:"price_usd,omitempty"`
+	ValueUSD        *float64 `json:"value_usd,omitempty"`
+}
+
+// PortfolioToken is an LRC-10 or LRC-20 balance with its token metadata, ID
+// is the LRC-10 token ID or the LRC-20 contract address
+type PortfolioToken struct {
+	ID       string   `json:"id"`
+	Name     string   `json:"name"`
+	Symbol   string   `json:"symbol"`
+	Decimals int32    `json:"decimals"`
+	Balance  string   `json:"balance"`
+	PriceUSD *float64 `json:"price_usd,omitempty"`
+	ValueUSD *float64 `json:"value_usd,omitempty"`
+}
@@ -0,0 +1,41 @@
+// internal/models/price.go
+package models
+
+// Price sources stored in MarketData.Source
+const (
+	PriceSourceCoinMarketCap = "coinmarketcap"
+	PriceSourceDEX           = "dex"
+	PriceSourceStatic        = "static"
+	PriceSourceMock          = "mock"
+)
+
+// MarketData represents a USD quote of an asset taken by the price feed.
+// Amounts are decimal strings, Timestamp is the time of the quote in milliseconds.
+type MarketData struct {
+	ID        string `gorm:"primaryKey;type:uuid" json:"-"`
+	Pair      string `gorm:"index:idx_pair_timestamp;type:varchar(20);not null" json:"pair"`
+	Price     string `gorm:"type:varchar(100)" json:"price"`
+	Volume24h string `gorm:"column:volume_24h;type:varchar(100)" json:"volume_24h"`
+	High24h   string `gorm:"column:high_24h;type:varchar(100)" json:"high_24h"`
+	Low24h    string `gorm:"column:low_24h;type:varchar(100)" json:"low_24h"`
+	Change24h string `gorm:"column:change_24h;type:varchar(20)" json:"change_24h"`
+	MarketCap string `gorm:"type:varchar(100)" json:"market_cap"`
+	Source    string `gorm:"type:varchar(20)" json:"source"`
+	Timestamp int64  `gorm:"index:idx_pair_timestamp;not null" json:"timestamp"`
+}
+
+func (MarketData) TableName() string {
+	return "market_data"
+}
+
+// MarketDataPair returns the market_data pair of the USD price of a symbol
+func MarketDataPair(symbol string) string {
+	return symbol + "_USD"
+}
+
+// TokenPriceRequest represents token price query parameters, timestamp is in
+// milliseconds and selects the last price taken at or before it
+type TokenPriceRequest struct {
+	Symbol    string `form:"symbol"`
+	Timestamp int64  `form:"timestamp"`
+}
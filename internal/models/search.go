@@ -0,0 +1,61 @@
+// internal/models/search.go
+package models
+
+import (
+	"time"
+)
+
+// Search result types
+const (
+	SearchTypeBlock       = "block"
+	SearchTypeTransaction = "transaction"
+	SearchTypeAddress     = "address"
+	SearchTypeContract    = "contract"
+	SearchTypeToken       = "token"
+	SearchTypeTag         = "tag"
+	SearchTypeWitness     = "witness"
+)
+
+// Contract represents a deployed smart contract. Verified is set by the explorer operators once the
+// contract source has been verified and ranks the contract and its token higher in search results.
+type Contract struct {
+	ID          uint      `gorm:"primarykey" json:"-"`
+	Address     string    `gorm:"uniqueIndex;type:varchar(42)" json:"address"`
+	Name        string    `gorm:"type:varchar(100)" json:"name"`
+	Owner       string    `gorm:"index;type:varchar(42)" json:"owner"`
+	BlockNumber int64     `gorm:"index" json:"block_number"`
+	Verified    bool      `gorm:"default:false" json:"verified"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// SearchMatch is an entity whose name matched a search query, with the signals it is ranked by
+type SearchMatch struct {
+	Type       string
+	ID         string
+	Name       string
+	Symbol     string
+	Standard   string  // LRC-10 or LRC-20 for tokens
+	Similarity float64 // trigram similarity of the best matching name, 0 to 1
+	Prefix     bool    // a name starts with the query
+	Popularity int64   // holders of tokens and contracts, votes of tags and witnesses
+	Verified   bool
+}
+
+// SearchRequest represents search query parameters
+type SearchRequest struct {
+	Query string `form:"query"`
+	Type  string `form:"type"`
+	Limit int    `form:"limit"`
+}
+
+// AutocompleteRequest represents the query parameters of the explorer search box suggestions
+type AutocompleteRequest struct {
+	Query string `form:"query"`
+	Limit int    `form:"limit"`
+}
+
+// AutocompleteResponse lists the suggestions for a partially typed query
+type AutocompleteResponse struct {
+	Query       string         `json:"query"`
+	Suggestions []SearchResult `json:"suggestions"`
+}
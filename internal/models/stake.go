@@ -0,0 +1,59 @@
+// internal/models/stake.go
+package models
+
+// Stake 2.0 resources, as numbered by the ResourceCode enum of the node
+const (
+	ResourceBandwidth  = 0
+	ResourceEnergy     = 1
+	ResourceLindaPower = 2
+)
+
+// StakeResponse combines the Stake 2.0 state of an account, amounts in sun
+type StakeResponse struct {
+	Address                string            `json:"address"`
+	Staked                 StakeAmounts      `json:"staked"`
+	CanDelegate            StakeAmounts      `json:"can_delegate"`
+	DelegatedOut           []StakeDelegation `json:"delegated_out"`
+	DelegatedIn            []StakeDelegation `json:"delegated_in"`
+	Unfreezes              []StakeUnfreeze   `json:"unfreezes"`
+	WithdrawableNow        int64             `json:"withdrawable_now"`
+	AvailableUnfreezeCount int64             `json:"available_unfreeze_count"`
+	Resources              StakeResources    `json:"resources"`
+}
+
+// StakeAmounts is an amount of LIND per resource
+type StakeAmounts struct {
+	Bandwidth  int64 `json:"bandwidth"`
+	Energy     int64 `json:"energy"`
+	LindaPower int64 `json:"linda_power,omitempty"`
+}
+
+// StakeDelegation is a resource delegated to or from another account. Locked
+// delegations cannot be reclaimed before their expire time.
+type StakeDelegation struct {
+	Address    string `json:"address"`
+	Resource   string `json:"resource"`
+	Amount     int64  `json:"amount"`
+	ExpireTime int64  `json:"expire_time,omitempty"`
+	Locked     bool   `json:"locked"`
+}
+
+// StakeUnfreeze is a pending or withdrawable unstake
+type StakeUnfreeze struct {
+	Resource     string `json:"resource"`
+	Amount       int64  `json:"amount"`
+	ExpireTime   int64  `json:"expire_time"`
+	Withdrawable bool   `json:"withdrawable"`
+}
+
+// StakeResources is the current bandwidth, energy and voting power usage of an account
+type StakeResources struct {
+	FreeNetUsed     int64 `json:"free_net_used"`
+	FreeNetLimit    int64 `json:"free_net_limit"`
+	NetUsed         int64 `json:"net_used"`
+	NetLimit        int64 `json:"net_limit"`
+	EnergyUsed      int64 `json:"energy_used"`
+	EnergyLimit     int64 `json:"energy_limit"`
+	LindaPowerUsed  int64 `json:"linda_power_used"`
+	LindaPowerLimit int64 `json:"linda_power_limit"`
+}
@@ -0,0 +1,106 @@
+// internal/models/staking.go
+package models
+
+import (
+	"time"
+)
+
+// Staking actions stored in StakeAction.Action and DelegationAction.Action
+const (
+	StakeActionFreeze     = "freeze"
+	StakeActionUnfreeze   = "unfreeze"
+	StakeActionDelegate   = "delegate"
+	StakeActionUndelegate = "undelegate"
+	StakeActionVote       = "vote"
+	StakeActionWithdraw   = "withdraw_reward"
+)
+
+// StakeAction represents a FreezeBalanceV2 or UnfreezeBalanceV2 transaction
+type StakeAction struct {
+	ID             uint      `gorm:"primarykey" json:"-"`
+	TransactionID  string    `gorm:"uniqueIndex:idx_stake_actions_tx;type:varchar(64)" json:"transaction_id"`
+	BlockNumber    int64     `gorm:"index" json:"block_number"`
+	BlockTimestamp int64     `gorm:"index" json:"block_timestamp"`
+	Owner          string    `gorm:"index;type:varchar(42)" json:"owner"`
+	Action         string    `gorm:"type:varchar(20)" json:"action"`
+	Resource       string    `gorm:"type:varchar(20)" json:"resource"`
+	Amount         int64     `json:"amount"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// DelegationAction represents a DelegateResource or UnDelegateResource transaction.
+// ExpireAt is the end of the lock period of locked delegations.
+type DelegationAction struct {
+	ID             uint      `gorm:"primarykey" json:"-"`
+	TransactionID  string    `gorm:"uniqueIndex:idx_delegation_actions_tx;type:varchar(64)" json:"transaction_id"`
+	BlockNumber    int64     `gorm:"index" json:"block_number"`
+	BlockTimestamp int64     `gorm:"index" json:"block_timestamp"`
+	Owner          string    `gorm:"index;type:varchar(42)" json:"owner"`
+	Receiver       string    `gorm:"index;type:varchar(42)" json:"receiver"`
+	Action         string    `gorm:"type:varchar(20)" json:"action"`
+	Resource       string    `gorm:"index;type:varchar(20)" json:"resource"`
+	Amount         int64     `json:"amount"`
+	Lock           bool      `json:"lock"`
+	ExpireAt       int64     `json:"expire_at,omitempty"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// VoteAction represents one witness voted for by a VoteWitnessContract, which
+// replaces all earlier votes of the voter
+type VoteAction struct {
+	ID             uint      `gorm:"primarykey" json:"-"`
+	TransactionID  string    `gorm:"index;type:varchar(64)" json:"transaction_id"`
+	BlockNumber    int64     `gorm:"index" json:"block_number"`
+	BlockTimestamp int64     `gorm:"index" json:"block_timestamp"`
+	Voter          string    `gorm:"index;type:varchar(42)" json:"voter"`
+	Witness        string    `gorm:"index;type:varchar(42)" json:"witness"`
+	Votes          int64     `json:"votes"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// RewardWithdrawal represents a WithdrawBalance transaction and the reward it paid out
+type RewardWithdrawal struct {
+	ID             uint      `gorm:"primarykey" json:"-"`
+	TransactionID  string    `gorm:"uniqueIndex;type:varchar(64)" json:"transaction_id"`
+	BlockNumber    int64     `gorm:"index" json:"block_number"`
+	BlockTimestamp int64     `gorm:"index" json:"block_timestamp"`
+	Owner          string    `gorm:"index;type:varchar(42)" json:"owner"`
+	Amount         int64     `json:"amount"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// StakingTimelineEntry is one action of an address in its staking timeline.
+// Counterparty is the other side of a delegation or the witness voted for.
+type StakingTimelineEntry struct {
+	TransactionID  string `json:"transaction_id"`
+	BlockNumber    int64  `json:"block_number"`
+	BlockTimestamp int64  `json:"block_timestamp"`
+	Action         string `json:"action"`
+	Resource       string `json:"resource,omitempty"`
+	Amount         int64  `json:"amount"`
+	Counterparty   string `json:"counterparty,omitempty"`
+	Incoming       bool   `json:"incoming,omitempty"`
+}
+
+// DelegatedResourcePoint is the total amount delegated for a resource at the end of a day
+type DelegatedResourcePoint struct {
+	Date        string `json:"date"`
+	Delegated   int64  `json:"delegated"`
+	Undelegated int64  `json:"undelegated"`
+	Total       int64  `json:"total"`
+}
+
+// StakingHistoryRequest represents staking timeline and voter history query parameters
+type StakingHistoryRequest struct {
+	Address string `form:"address" binding:"required"`
+	Start   int    `form:"start"`
+	Limit   int    `form:"limit"`
+}
+
+// DelegatedResourceHistoryRequest represents delegated resource history query
+// parameters, from and to are millisecond timestamps
+type DelegatedResourceHistoryRequest struct {
+	Resource string `form:"resource"`
+	From     int64  `form:"from"`
+	To       int64  `form:"to"`
+}
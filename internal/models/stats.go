@@ -1,14 +1,67 @@
-// internal/models/stats.go
-package models
-
-import (
-	"encoding/json"
-)
-
-// Statistic represents a stored statistic database model
-type Statistic struct {
-	ID        uint            `gorm:"primarykey" json:"-"`
-	Type      string          `gorm:"index;type:varchar(50)" json:"type"`
-	Value     json.RawMessage `gorm:"type:jsonb" json:"value"`
-	Timestamp int64           `gorm:"index" json:"timestamp"`
+// internal/models/stats.go
+package models
+
+import (
+	"encoding/json"
+)
+
+// Intervals of the activity series computed by the aggregator
+const (
+	StatsIntervalHourly = "hourly"
+	StatsIntervalDaily  = "daily"
+)
+
+// Statistic types written by the aggregator. Series buckets are stored as
+// StatTypeSeriesPrefix plus their interval, at the start of the interval.
+const (
+	StatTypeSeriesPrefix = "series_"
+	StatTypeSummary      = "overview_summary"
+)
+
+// Statistic represents a stored statistic database model
+type Statistic struct {
+	ID        uint            `gorm:"primarykey" json:"-"`
+	Type      string          `gorm:"index;type:varchar(50)" json:"type"`
+	Value     json.RawMessage `gorm:"type:jsonb" json:"value"`
+	Timestamp int64           `gorm:"index" json:"timestamp"`
+}
+
+// StatsBucket is the chain activity of one interval, Timestamp is its start.
+// Amounts are in sun, TotalSupply is the LIND held by indexed accounts at its end.
+type StatsBucket struct {
+	Timestamp      int64             `json:"timestamp"`
+	Blocks         int64             `json:"blocks"`
+	Transactions   int64             `json:"transactions"`
+	NewAccounts    int64             `json:"new_accounts"`
+	ActiveAccounts int64             `json:"active_accounts"`
+	FeesBurned     int64             `json:"fees_burned"`
+	EnergyUsed     int64             `json:"energy_used"`
+	NetUsed        int64             `json:"net_used"`
+	LRC20Transfers int64             `json:"lrc20_transfers"`
+	LRC20Volume    map[string]string `json:"lrc20_volume,omitempty"` // raw amounts by token contract
+	TotalSupply    string            `json:"total_supply"`
+}
+
+// StatsSummary holds the chain totals and the activity of the last 24 hours
+type StatsSummary struct {
+	Timestamp         int64  `json:"timestamp"`
+	TotalBlocks       int64  `json:"total_blocks"`
+	TotalTransactions int64  `json:"total_transactions"`
+	TotalAccounts     int64  `json:"total_accounts"`
+	TotalContracts    int64  `json:"total_contracts"`
+	TotalTokens       int64  `json:"total_tokens"`
+	TotalSupply       string `json:"total_supply"`
+	Transactions24h   int64  `json:"transactions_24h"`
+	NewAccounts24h    int64  `json:"new_accounts_24h"`
+	ActiveAccounts24h int64  `json:"active_accounts_24h"`
+	FeesBurned24h     int64  `json:"fees_burned_24h"`
+	EnergyUsed24h     int64  `json:"energy_used_24h"`
+	NetUsed24h        int64  `json:"net_used_24h"`
+	LRC20Transfers24h int64  `json:"lrc20_transfers_24h"`
+}
+
+// OverviewRequest represents overview query parameters, limit applies to series
+type OverviewRequest struct {
+	Type  string `form:"type"`
+	Limit int    `form:"limit"`
 }
\ No newline at end of file
@@ -0,0 +1,133 @@
+// internal/models/tag.go
+package models
+
+// Moderation statuses of a tag. Only approved tags are listed and searched.
+const (
+	TagStatusPending  = "pending"
+	TagStatusApproved = "approved"
+	TagStatusRejected = "rejected"
+)
+
+// Moderation actions
+const (
+	TagActionApprove = "approve"
+	TagActionReject  = "reject"
+)
+
+// Tag is a label an owner gave an address. Signature is the owner signature of
+// the last insert or update and SignedAt its millisecond timestamp, a change
+// signed at or before it is not applied again.
+type Tag struct {
+	ID          int32  `gorm:"primarykey" json:"id"`
+	Address     string `gorm:"uniqueIndex:idx_tag_owner;index;type:varchar(42);not null" json:"address"`
+	Tag         string `gorm:"uniqueIndex:idx_tag_owner;type:varchar(100);not null" json:"tag"`
+	Description string `gorm:"type:text" json:"description"`
+	Owner       string `gorm:"uniqueIndex:idx_tag_owner;index;type:varchar(42);not null" json:"owner"`
+	Signature   string `gorm:"type:text" json:"-"`
+	SignedAt    int64  `gorm:"not null;default:0" json:"-"`
+	Status      string `gorm:"index;type:varchar(16);not null;default:pending" json:"status"`
+	Curated     bool   `gorm:"not null;default:false" json:"curated"`
+	ModeratedBy string `gorm:"type:varchar(42);not null;default:''" json:"moderated_by"`
+	ModeratedAt int64  `gorm:"not null;default:0" json:"moderated_at"`
+	CreatedAt   int64  `json:"created_at"`
+	Votes       int32  `gorm:"default:0" json:"votes"`
+}
+
+// Response returns the public view of a tag
+func (t *Tag) Response() TagResponse {
+	return TagResponse{
+		ID:          t.ID,
+		Address:     t.Address,
+		Tag:         t.Tag,
+		Description: t.Description,
+		Owner:       t.Owner,
+		Status:      t.Status,
+		Curated:     t.Curated,
+		ModeratedBy: t.ModeratedBy,
+		ModeratedAt: t.ModeratedAt,
+		CreatedAt:   t.CreatedAt,
+		Votes:       t.Votes,
+	}
+}
+
+// TagResponses returns the public view of tags
+func TagResponses(tags []*Tag) []TagResponse {
+	responses := make([]TagResponse, 0, len(tags))
+	for _, tag := range tags {
+		responses = append(responses, tag.Response())
+	}
+	return responses
+}
+
+// TagVote is the vote of one signer on a tag, Value is 1 or -1. A new vote of
+// the same signer replaces the previous one when it is signed later, SignedAt
+// is the millisecond timestamp of the vote signature.
+type TagVote struct {
+	ID        uint   `gorm:"primarykey" json:"-"`
+	TagID     int32  `gorm:"uniqueIndex:idx_tag_voter;not null" json:"tag_id"`
+	Voter     string `gorm:"uniqueIndex:idx_tag_voter;index;type:varchar(42);not null" json:"voter"`
+	Value     int32  `json:"value"`
+	Signature string `gorm:"type:text" json:"-"`
+	SignedAt  int64  `gorm:"not null;default:0" json:"-"`
+	CreatedAt int64  `json:"created_at"`
+}
+
+// TagRequest represents tag list query parameters
+type TagRequest struct {
+	Address string `form:"address"`
+	Start   int    `form:"start"`
+	Limit   int    `form:"limit"`
+	Sort    string `form:"sort"`
+}
+
+// TagRecommendRequest represents tag recommendation query parameters
+type TagRecommendRequest struct {
+	Address string `form:"address" binding:"required"`
+	Limit   int    `form:"limit"`
+}
+
+// TagQueueRequest represents moderation queue query parameters
+type TagQueueRequest struct {
+	Start int `form:"start"`
+	Limit int `form:"limit"`
+}
+
+// Signed tag requests. Signature is the hex secp256k1 signature by the signing
+// address of the message returned by tagging.Message for the action, and
+// Timestamp the signing time in milliseconds.
+type (
+	TagInsertRequest struct {
+		Address     string `json:"address" binding:"required"`
+		Tag         string `json:"tag" binding:"required"`
+		Description string `json:"description"`
+		Owner       string `json:"owner" binding:"required"`
+		Timestamp   int64  `json:"timestamp" binding:"required"`
+		Signature   string `json:"signature" binding:"required"`
+	}
+	TagUpdateRequest struct {
+		ID          int32  `json:"id" binding:"required"`
+		Tag         string `json:"tag" binding:"required"`
+		Description string `json:"description"`
+		Timestamp   int64  `json:"timestamp" binding:"required"`
+		Signature   string `json:"signature" binding:"required"`
+	}
+	TagDeleteRequest struct {
+		ID        int32  `json:"id" binding:"required"`
+		Timestamp int64  `json:"timestamp" binding:"required"`
+		Signature string `json:"signature" binding:"required"`
+	}
+	TagVoteRequest struct {
+		ID        int32  `json:"id" binding:"required"`
+		Voter     string `json:"voter" binding:"required"`
+		Vote      int32  `json:"vote" binding:"required"`
+		Timestamp int64  `json:"timestamp" binding:"required"`
+		Signature string `json:"signature" binding:"required"`
+	}
+	TagModerateRequest struct {
+		ID        int32  `json:"id" binding:"required"`
+		Moderator string `json:"moderator" binding:"required"`
+		Action    string `json:"action" binding:"required"`
+		Timestamp int64  `json:"timestamp" binding:"required"`
+		Signature string `json:"signature" binding:"required"`
+	}
+)
@@ -1,100 +1,167 @@
-// internal/models/token.go
-package models
-
-import (
-	"time"
-)
-
-// TokenInfo represents LRC-10 token database model
-type TokenInfo struct {
-	ID          string    `gorm:"primaryKey;type:varchar(100)" json:"id"`
-	Name        string    `gorm:"type:varchar(100)" json:"name"`
-	Symbol      string    `gorm:"type:varchar(20)" json:"symbol"`
-	TotalSupply int64     `json:"total_supply"`
-	Owner       string    `gorm:"type:varchar(42)" json:"owner"`
-	Decimals    int       `json:"decimals"`
-	StartTime   int64     `json:"start_time"`
-	EndTime     int64     `json:"end_time"`
-	URL         string    `gorm:"type:text" json:"url"`
-	Description string    `gorm:"type:text" json:"description"`
-	CreatedAt   time.Time `json:"created_at"`
-}
-
-// LRC20TokenInfo represents LRC20 token database model
-type LRC20TokenInfo struct {
-	ID          uint      `gorm:"primarykey" json:"-"`
-	Contract    string    `gorm:"uniqueIndex;type:varchar(42)" json:"contract"`
-	Name        string    `gorm:"type:varchar(100)" json:"name"`
-	Symbol      string    `gorm:"type:varchar(20)" json:"symbol"`
-	Decimals    int32     `json:"decimals"`
-	TotalSupply string    `gorm:"type:varchar(100)" json:"total_supply"`
-	Owner       string    `gorm:"type:varchar(42)" json:"owner"`
-	IssueTime   int64     `json:"issue_time"`
-	Holders     int64     `json:"holders"`
-	Transfers   int64     `json:"transfers"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
-}
-
-// TokenHolder represents a token holder database model
-type TokenHolder struct {
-	ID              uint      `gorm:"primarykey" json:"-"`
-	ContractAddress string    `gorm:"index;type:varchar(42)" json:"contract_address"`
-	Address         string    `gorm:"index;type:varchar(42)" json:"address"`
-	Balance         string    `gorm:"type:varchar(100)" json:"balance"`
-	Percentage      float64   `json:"percentage"`
-	UpdatedAt       time.Time `json:"updated_at"`
-}
-
-// TokenTransfer represents a token transfer database model
-type TokenTransfer struct {
-	ID             uint      `gorm:"primarykey" json:"-"`
-	TransactionID  string    `gorm:"index;type:varchar(64)" json:"transaction_id"`
-	BlockNumber    int64     `gorm:"index" json:"block_number"`
-	BlockTimestamp int64     `gorm:"index" json:"block_timestamp"`
-	From           string    `gorm:"index;type:varchar(42)" json:"from"`
-	To             string    `gorm:"index;type:varchar(42)" json:"to"`
-	Value          string    `gorm:"type:varchar(100)" json:"value"`
-	TokenAddress   string    `gorm:"index;type:varchar(42)" json:"token_address"`
-	TokenSymbol    string    `gorm:"type:varchar(20)" json:"token_symbol"`
-	TokenDecimals  int32     `json:"token_decimals"`
-	CreatedAt      time.Time `json:"created_at"`
-}
-
-// TokenTransferDB represents a token transfer database model
-type TokenTransferDB struct {
-	ID              uint      `gorm:"primarykey" json:"-"`
-	TransactionID   string    `gorm:"index;type:varchar(64)" json:"transaction_id"`
-	BlockNumber     int64     `gorm:"index" json:"block_number"`
-	BlockTimestamp  int64     `gorm:"index" json:"block_timestamp"`
-	From            string    `gorm:"index;type:varchar(42)" json:"from"`
-	To              string    `gorm:"index;type:varchar(42)" json:"to"`
-	Value           string    `gorm:"type:varchar(100)" json:"value"`
-	TokenAddress    string    `gorm:"index;type:varchar(42)" json:"token_address"`
-	TokenSymbol     string    `gorm:"type:varchar(20)" json:"token_symbol"`
-	TokenDecimals   int32     `json:"token_decimals"`
-	CreatedAt       time.Time `json:"created_at"`
-}
-
-// AssetIssueDB represents an asset issue database model
-type AssetIssueDB struct {
-	ID                       string         `gorm:"primaryKey;type:varchar(100)" json:"id"`
-	OwnerAddress             string         `gorm:"type:varchar(42)" json:"owner_address"`
-	Name                     string         `gorm:"type:varchar(100)" json:"name"`
-	Abbr                     string         `gorm:"type:varchar(20)" json:"abbr"`
-	TotalSupply              int64          `json:"total_supply"`
-	FrozenSupply             JSON           `gorm:"type:jsonb" json:"frozen_supply"` // Store as JSON
-	LindNum                  int32          `json:"lind_num"`
-	Num                      int32          `json:"num"`
-	Precision                int32          `json:"precision"`
-	StartTime                int64          `json:"start_time"`
-	EndTime                  int64          `json:"end_time"`
-	VoteScore                int32          `json:"vote_score"`
-	Description              string         `gorm:"type:text" json:"description"`
-	URL                      string         `gorm:"type:text" json:"url"`
-	FreeAssetNetLimit        int64          `json:"free_asset_net_limit"`
-	PublicFreeAssetNetLimit  int64          `json:"public_free_asset_net_limit"`
-	PublicFreeAssetNetUsage  int64          `json:"public_free_asset_net_usage"`
-	PublicLatestFreeNetTime  int64          `json:"public_latest_free_net_time"`
-	CreatedAt                time.Time      `json:"created_at"`
+// internal/models/token.go
+package models
+
+import (
+	"time"
+)
+
+// TokenInfo represents LRC-10 token database model
+type TokenInfo struct {
+	ID          string    `gorm:"primaryKey;type:varchar(100)" json:"id"`
+	Name        string    `gorm:"type:varchar(100)" json:"name"`
+	Symbol      string    `gorm:"type:varchar(20)" json:"symbol"`
+	TotalSupply int64     `json:"total_supply"`
+	Owner       string    `gorm:"type:varchar(42)" json:"owner"`
+	Decimals    int       `json:"decimals"`
+	LindNum     int32     `json:"lind_num"`
+	Num         int32     `json:"num"`
+	StartTime   int64     `json:"start_time"`
+	EndTime     int64     `json:"end_time"`
+	URL         string    `gorm:"type:text" json:"url"`
+	Description string    `gorm:"type:text" json:"description"`
+	Holders     int64     `json:"holders"`
+	Transfers   int64     `json:"transfers"`
+	BlockNumber int64     `gorm:"index" json:"block_number"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// LRC20TokenInfo represents LRC20 token database model
+type LRC20TokenInfo struct {
+	ID          uint      `gorm:"primarykey" json:"-"`
+	Contract    string    `gorm:"uniqueIndex;type:varchar(42)" json:"contract"`
+	Name        string    `gorm:"type:varchar(100)" json:"name"`
+	Symbol      string    `gorm:"type:varchar(20)" json:"symbol"`
+	Decimals    int32     `json:"decimals"`
+	TotalSupply string    `gorm:"type:varchar(100)" json:"total_supply"`
+	Owner       string    `gorm:"type:varchar(42)" json:"owner"`
+	IssueTime   int64     `json:"issue_time"`
+	Holders     int64     `json:"holders"`
+	Transfers   int64     `json:"transfers"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// TokenHolder represents a token holder database model
+type TokenHolder struct {
+	ID              uint      `gorm:"primarykey" json:"-"`
+	ContractAddress string    `gorm:"uniqueIndex:idx_token_holder;type:varchar(42)" json:"contract_address"`
+	Address         string    `gorm:"uniqueIndex:idx_token_holder;index;type:varchar(42)" json:"address"`
+	Balance         string    `gorm:"type:numeric(78,0);not null;default:0" json:"balance"`
+	Percentage      float64   `json:"percentage"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+// TokenTransfer represents a token transfer database model
+type TokenTransfer struct {
+	ID             uint      `gorm:"primarykey" json:"-"`
+	TransactionID  string    `gorm:"index;type:varchar(64)" json:"transaction_id"`
+	BlockNumber    int64     `gorm:"index" json:"block_number"`
+	BlockTimestamp int64     `gorm:"index" json:"block_timestamp"`
+	From           string    `gorm:"index;type:varchar(42)" json:"from"`
+	To             string    `gorm:"index;type:varchar(42)" json:"to"`
+	Value          string    `gorm:"type:varchar(100)" json:"value"`
+	TokenAddress   string    `gorm:"index;type:varchar(42)" json:"token_address"`
+	TokenSymbol    string    `gorm:"type:varchar(20)" json:"token_symbol"`
+	TokenDecimals  int32     `json:"token_decimals"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// NoLogIndex is the log index of LRC-10 transfers, which come from the
+// contract of a transaction rather than from an event log
+const NoLogIndex = -1
+
+// TokenTransferDB represents a token transfer database model, the schema of
+// the rows read and written as TokenTransferResponse
+type TokenTransferDB struct {
+	ID              uint      `gorm:"primarykey" json:"-"`
+	TransactionID   string    `gorm:"index;uniqueIndex:idx_token_transfer_log;type:varchar(64)" json:"transaction_id"`
+	LogIndex        int       `gorm:"uniqueIndex:idx_token_transfer_log" json:"log_index"`
+	BlockNumber     int64     `gorm:"index" json:"block_number"`
+	BlockTimestamp  int64     `gorm:"index" json:"block_timestamp"`
+	From            string    `gorm:"index;type:varchar(42)" json:"from"`
+	To              string    `gorm:"index;type:varchar(42)" json:"to"`
+	Value           string    `gorm:"type:varchar(100)" json:"value"`
+	TokenAddress    string    `gorm:"index;type:varchar(42)" json:"token_address"`
+	TokenSymbol     string    `gorm:"type:varchar(20)" json:"token_symbol"`
+	TokenDecimals   int32     `json:"token_decimals"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+func (TokenTransferDB) TableName() string {
+	return "token_transfer_responses"
+}
+
+// AssetIssueDB represents an asset issue database model
+type AssetIssueDB struct {
+	ID                       string         `gorm:"primaryKey;type:varchar(100)" json:"id"`
+	OwnerAddress             string         `gorm:"type:varchar(42)" json:"owner_address"`
+	Name                     string         `gorm:"type:varchar(100)" json:"name"`
+	Abbr                     string         `gorm:"type:varchar(20)" json:"abbr"`
+	TotalSupply              int64          `json:"total_supply"`
+	FrozenSupply             JSON           `gorm:"type:jsonb" json:"frozen_supply"` // Store as JSON
+	LindNum                  int32          `json:"lind_num"`
+	Num                      int32          `json:"num"`
+	Precision                int32          `json:"precision"`
+	StartTime                int64          `json:"start_time"`
+	EndTime                  int64          `json:"end_time"`
+	VoteScore                int32          `json:"vote_score"`
+	Description              string         `gorm:"type:text" json:"description"`
+	URL                      string         `gorm:"type:text" json:"url"`
+	FreeAssetNetLimit        int64          `json:"free_asset_net_limit"`
+	PublicFreeAssetNetLimit  int64          `json:"public_free_asset_net_limit"`
+	PublicFreeAssetNetUsage  int64          `json:"public_free_asset_net_usage"`
+	PublicLatestFreeNetTime  int64          `json:"public_latest_free_net_time"`
+	CreatedAt                time.Time      `json:"created_at"`
+}
+
+// TokenAllowance represents the current LRC20 allowance an owner granted a spender
+type TokenAllowance struct {
+	ID             uint      `gorm:"primarykey" json:"-"`
+	Owner          string    `gorm:"uniqueIndex:idx_token_allowance;index;type:varchar(42)" json:"owner"`
+	Spender        string    `gorm:"uniqueIndex:idx_token_allowance;type:varchar(42)" json:"spender"`
+	Contract       string    `gorm:"uniqueIndex:idx_token_allowance;type:varchar(42)" json:"contract"`
+	Allowance      string    `gorm:"type:varchar(100)" json:"allowance"`
+	TransactionID  string    `gorm:"type:varchar(64)" json:"transaction_id"` // last transaction that changed the allowance
+	BlockNumber    int64     `gorm:"index" json:"block_number"`
+	BlockTimestamp int64     `json:"block_timestamp"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// TokenAllowanceChange records an allowance value set in a block, the value an
+// allowance is restored to when later blocks are rolled back
+type TokenAllowanceChange struct {
+	ID             uint   `gorm:"primarykey" json:"-"`
+	Owner          string `gorm:"index:idx_token_allowance_change;type:varchar(42)" json:"owner"`
+	Spender        string `gorm:"index:idx_token_allowance_change;type:varchar(42)" json:"spender"`
+	Contract       string `gorm:"index:idx_token_allowance_change;type:varchar(42)" json:"contract"`
+	Allowance      string `gorm:"type:varchar(100)" json:"allowance"`
+	TransactionID  string `gorm:"type:varchar(64)" json:"transaction_id"`
+	BlockNumber    int64  `gorm:"index" json:"block_number"`
+	BlockTimestamp int64  `json:"block_timestamp"`
+}
+
+// TokenApprovalResponse is an allowance with the metadata of its token
+type TokenApprovalResponse struct {
+	TokenAllowance
+	TokenName     string `json:"token_name"`
+	TokenSymbol   string `json:"token_symbol"`
+	TokenDecimals int32  `json:"token_decimals"`
+	TotalSupply   string `json:"-"`
+	Unlimited     bool   `json:"unlimited" gorm:"-"`
+}
+
+// ApprovalListRequest represents GET /api/account/approvals query parameters
+type ApprovalListRequest struct {
+	Address  string `form:"address" binding:"required"`
+	Contract string `form:"contract"`
+	Start    int    `form:"start"`
+	Limit    int    `form:"limit"`
+}
+
+// RevokeApprovalRequest is the body of POST /api/account/approvals/revoke
+type RevokeApprovalRequest struct {
+	OwnerAddress    string `json:"owner_address" binding:"required"`
+	SpenderAddress  string `json:"spender_address" binding:"required"`
+	ContractAddress string `json:"contract_address" binding:"required"`
+	FeeLimit        int64  `json:"fee_limit"`
 }
\ No newline at end of file
@@ -1,98 +1,128 @@
-// internal/models/transaction.go
-package models
-
-import (
-	"database/sql/driver"
-	"encoding/json"
-	"fmt"
-	"time"
-)
-
-// JSON is a type alias for json.RawMessage
-// type JSON json.RawMessage
-
-// Transaction represents a blockchain transaction database model
-type Transaction struct {
-	ID              uint      `gorm:"primarykey" json:"-"`
-	Hash            string    `gorm:"uniqueIndex;type:varchar(64)" json:"hash"`
-	BlockNumber     int64     `gorm:"index" json:"block_number"`
-	BlockTimestamp  int64     `gorm:"index" json:"block_timestamp"`
-	FromAddress     string    `gorm:"index;type:varchar(42)" json:"from_address"`
-	ToAddress       string    `gorm:"index;type:varchar(42)" json:"to_address"`
-	ContractAddress string    `gorm:"index;type:varchar(42)" json:"contract_address,omitempty"`
-	Amount          int64     `json:"amount,omitempty"`
-	Fee             int64     `json:"fee,omitempty"`
-	EnergyUsed      int64     `json:"energy_used,omitempty"`
-	EnergyFee       int64     `json:"energy_fee,omitempty"`
-	NetUsage        int64     `json:"net_usage,omitempty"`
-	NetFee          int64     `json:"net_fee,omitempty"`
-	Result          int       `json:"result,omitempty"` // 0: success, 1: failed
-	ContractType    int       `json:"contract_type,omitempty"`
-	Data            string    `gorm:"type:text" json:"data,omitempty"`
-	RawData         string    `gorm:"type:text" json:"raw_data,omitempty"`
-	RawDataHex      string    `gorm:"type:text" json:"raw_data_hex,omitempty"`
-	Signature       JSON      `gorm:"type:jsonb" json:"signature,omitempty"`
-	CreatedAt       time.Time `json:"created_at"`
-}
-
-// CallValueInfo represents token transfer information in internal transactions (for database storage)
-type CallValueInfo struct {
-	CallValue int64  `json:"callValue,omitempty"`
-	TokenID   string `json:"tokenId,omitempty"`
-}
-
-// InternalTransaction represents an internal transaction database model
-// This matches the structure from the Linda blockchain API
-type InternalTransaction struct {
-	Hash              string          `gorm:"primaryKey;type:varchar(64)" json:"hash"`
-	CallerAddress     string          `gorm:"index;type:varchar(42)" json:"caller_address"`
-	TransferToAddress string          `gorm:"index;type:varchar(42)" json:"transferTo_address"`
-	CallValueInfo     []CallValueInfo `gorm:"type:jsonb" json:"callValueInfo"`
-	Note              string          `gorm:"type:text" json:"note"` // Hex string that decodes to instruction type
-	Rejected          bool            `json:"rejected"`
-	Extra             JSON            `gorm:"type:jsonb" json:"extra,omitempty"` // For voting details and other extra info
-	BlockTimestamp    int64           `gorm:"index" json:"block_timestamp"`
-	TransactionID     string          `gorm:"index;type:varchar(64)" json:"transaction_id"` // Parent transaction ID
-	CreatedAt         time.Time       `json:"created_at"`
-}
-
-// CallValueInfoWrapper implements sql.Scanner and driver.Valuer for CallValueInfo slice
-type CallValueInfoWrapper []CallValueInfo
-
-// Scan implements the sql.Scanner interface
-func (c *CallValueInfoWrapper) Scan(value interface{}) error {
-	if value == nil {
-		return nil
-	}
-	
-	bytes, ok := value.([]byte)
-	if !ok {
-		return fmt.Errorf("failed to unmarshal CallValueInfo: expected []byte, got %T", value)
-	}
-	
-	var info []CallValueInfo
-	if err := json.Unmarshal(bytes, &info); err != nil {
-		return fmt.Errorf("failed to unmarshal CallValueInfo: %w", err)
-	}
-	
-	*c = info
-	return nil
-}
-
-// Value implements the driver.Valuer interface
-func (c CallValueInfoWrapper) Value() (driver.Value, error) {
-	if c == nil {
-		return nil, nil
-	}
-	return json.Marshal(c)
-}
-
-// Note: The note field is stored as hex string in the database
-// Common note values (hex decoded):
-// - "63616c6c" -> "call"
-// - "667265657a6542616c616e63655632466f72456e65726779" -> "freezeBalanceV2ForEnergy"
-// - "756e667265657a6542616c616e63655632466f7242616e647769647468" -> "unfreezeBalanceV2ForBandwidth"
-// - "64656c65676174655265736f757263654f66456e65726779" -> "delegateResourceOfEnergy"
-// - "756e44656c65676174655265736f757263654f66456e65726779" -> "unDelegateResourceOfEnergy"
-// - "766f74655769746e657373" -> "voteWitness"
-// - "7769746864726177526577617264" -> "withdrawReward"
+// internal/models/transaction.go
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// JSON is a type alias for json.RawMessage
+// type JSON json.RawMessage
+
+// Contract types stored in Transaction.ContractType, as numbered by the
+// Transaction.Contract.ContractType enum in proto/core/Linda.proto
+const (
+	ContractTypeTransfer                = 1
+	ContractTypeTransferAsset           = 2
+	ContractTypeVoteWitness             = 4
+	ContractTypeAssetIssue              = 6
+	ContractTypeParticipateAssetIssue   = 9
+	ContractTypeFreezeBalance           = 11
+	ContractTypeWithdrawBalance         = 13
+	ContractTypeProposalCreate          = 16
+	ContractTypeProposalApprove         = 17
+	ContractTypeProposalDelete          = 18
+	ContractTypeCreateSmartContract     = 30
+	ContractTypeTriggerSmartContract    = 31
+	ContractTypeExchangeCreate          = 41
+	ContractTypeExchangeInject          = 42
+	ContractTypeExchangeWithdraw        = 43
+	ContractTypeExchangeTransaction     = 44
+	ContractTypeAccountPermissionUpdate = 46
+	ContractTypeUpdateBrokerage         = 57
+	ContractTypeMarketSellAsset         = 61
+	ContractTypeMarketCancelOrder       = 62
+	ContractTypeFreezeBalanceV2         = 51
+	ContractTypeUnfreezeBalanceV2       = 52
+	ContractTypeDelegateResource        = 54
+	ContractTypeUnDelegateResource      = 55
+)
+
+// Transaction represents a blockchain transaction database model
+type Transaction struct {
+	ID              uint      `gorm:"primarykey" json:"-"`
+	Hash            string    `gorm:"uniqueIndex;type:varchar(64)" json:"hash"`
+	BlockNumber     int64     `gorm:"index" json:"block_number"`
+	BlockTimestamp  int64     `gorm:"index" json:"block_timestamp"`
+	FromAddress     string    `gorm:"index;type:varchar(42)" json:"from_address"`
+	ToAddress       string    `gorm:"index;type:varchar(42)" json:"to_address"`
+	ContractAddress string    `gorm:"index;type:varchar(42)" json:"contract_address,omitempty"`
+	Amount          int64     `json:"amount,omitempty"`
+	Fee             int64     `json:"fee,omitempty"`
+	EnergyUsed      int64     `json:"energy_used,omitempty"`
+	EnergyFee       int64     `json:"energy_fee,omitempty"`
+	NetUsage        int64     `json:"net_usage,omitempty"`
+	NetFee          int64     `json:"net_fee,omitempty"`
+	Result          int       `json:"result,omitempty"` // 0: success, 1: failed
+	ContractType    int       `json:"contract_type,omitempty"`
+	Data            string    `gorm:"type:text" json:"data,omitempty"`
+	RawData         string    `gorm:"type:text" json:"raw_data,omitempty"`
+	RawDataHex      string    `gorm:"type:text" json:"raw_data_hex,omitempty"`
+	Signature       JSON      `gorm:"type:jsonb" json:"signature,omitempty"`
+	Unconfirmed     bool      `gorm:"index;default:false" json:"unconfirmed"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+// CallValueInfo represents token transfer information in internal transactions (for database storage)
+type CallValueInfo struct {
+	CallValue int64  `json:"callValue,omitempty"`
+	TokenID   string `json:"tokenId,omitempty"`
+}
+
+// InternalTransaction represents an internal transaction database model
+// This matches the structure from the Linda blockchain API
+type InternalTransaction struct {
+	Hash              string          `gorm:"primaryKey;type:varchar(64)" json:"hash"`
+	CallerAddress     string          `gorm:"index;type:varchar(42)" json:"caller_address"`
+	TransferToAddress string          `gorm:"index;type:varchar(42)" json:"transferTo_address"`
+	CallValueInfo     []CallValueInfo `gorm:"type:jsonb" json:"callValueInfo"`
+	Note              string          `gorm:"type:text" json:"note"` // Hex string that decodes to instruction type
+	Rejected          bool            `json:"rejected"`
+	Extra             JSON            `gorm:"type:jsonb" json:"extra,omitempty"` // For voting details and other extra info
+	BlockTimestamp    int64           `gorm:"index" json:"block_timestamp"`
+	TransactionID     string          `gorm:"index;type:varchar(64)" json:"transaction_id"` // Parent transaction ID
+	CreatedAt         time.Time       `json:"created_at"`
+}
+
+// CallValueInfoWrapper implements sql.Scanner and driver.Valuer for CallValueInfo slice
+type CallValueInfoWrapper []CallValueInfo
+
+// Scan implements the sql.Scanner interface
+func (c *CallValueInfoWrapper) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+	
+	bytes, ok := value.([]byte)
+	if !ok {
+		return fmt.Errorf("failed to unmarshal CallValueInfo: expected []byte, got %T", value)
+	}
+	
+	var info []CallValueInfo
+	if err := json.Unmarshal(bytes, &info); err != nil {
+		return fmt.Errorf("failed to unmarshal CallValueInfo: %w", err)
+	}
+	
+	*c = info
+	return nil
+}
+
+// Value implements the driver.Valuer interface
+func (c CallValueInfoWrapper) Value() (driver.Value, error) {
+	if c == nil {
+		return nil, nil
+	}
+	return json.Marshal(c)
+}
+
+// Note: The note field is stored as hex string in the database
+// Common note values (hex decoded):
+// - "63616c6c" -> "call"
+// - "667265657a6542616c616e63655632466f72456e65726779" -> "freezeBalanceV2ForEnergy"
+// - "756e667265657a6542616c616e63655632466f7242616e647769647468" -> "unfreezeBalanceV2ForBandwidth"
+// - "64656c65676174655265736f757263654f66456e65726779" -> "delegateResourceOfEnergy"
+// - "756e44656c65676174655265736f757263654f66456e65726779" -> "unDelegateResourceOfEnergy"
+// - "766f74655769746e657373" -> "voteWitness"
+// - "7769746864726177526577617264" -> "withdrawReward"
@@ -0,0 +1,90 @@
+// internal/models/webhook.go
+package models
+
+import (
+	"time"
+)
+
+// Webhook filter types
+const (
+	WebhookFilterAddress  = "address"
+	WebhookFilterContract = "contract"
+	WebhookFilterEvent    = "event"
+)
+
+// Webhook delivery states
+const (
+	DeliveryStatusPending   = "pending"
+	DeliveryStatusDelivered = "delivered"
+	DeliveryStatusDead      = "dead"
+)
+
+// WebhookSubscription represents an outbound webhook registered by an API key
+type WebhookSubscription struct {
+	ID                string    `gorm:"primaryKey;type:uuid" json:"id"`
+	APIKeyID          string    `gorm:"index;not null" json:"-"`
+	UserID            string    `gorm:"index" json:"-"`
+	CallbackURL       string    `gorm:"type:varchar(512);not null" json:"callback_url"`
+	Secret            string    `gorm:"type:varchar(128);not null" json:"-"`
+	FilterType        string    `gorm:"type:varchar(20);not null" json:"filter_type"`
+	Address           string    `gorm:"index;type:varchar(42)" json:"address,omitempty"`
+	ContractAddress   string    `gorm:"index;type:varchar(42)" json:"contract_address,omitempty"`
+	EventName         string    `gorm:"type:varchar(100)" json:"event_name,omitempty"`
+	WaitForSolidified bool      `gorm:"default:false" json:"wait_for_solidified"`
+	Active            bool      `gorm:"index;default:true" json:"active"`
+	CreatedAt         time.Time `json:"created_at"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
+
+// WebhookDelivery represents one queued or attempted webhook call, kept as the delivery log
+type WebhookDelivery struct {
+	ID                string     `gorm:"primaryKey;type:uuid" json:"id"`
+	SubscriptionID    string     `gorm:"index;type:uuid;not null" json:"subscription_id"`
+	EventType         string     `gorm:"type:varchar(20)" json:"event_type"` // transaction, event
+	BlockNumber       int64      `gorm:"index" json:"block_number"`
+	TransactionID     string     `gorm:"type:varchar(64)" json:"transaction_id"`
+	Payload           JSON       `gorm:"type:jsonb" json:"payload"`
+	WaitForSolidified bool       `gorm:"default:false" json:"wait_for_solidified"`
+	Status            string     `gorm:"index;type:varchar(20)" json:"status"`
+	Attempts          int        `json:"attempts"`
+	NextAttemptAt     time.Time  `gorm:"index" json:"next_attempt_at"`
+	LastError         string     `gorm:"type:text" json:"last_error,omitempty"`
+	ResponseCode      int        `json:"response_code,omitempty"`
+	DeliveredAt       *time.Time `json:"delivered_at,omitempty"`
+	CreatedAt         time.Time  `json:"created_at"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+}
+
+// MonitorRequest is the body of POST /api/monitor and POST /api/webhooks
+type MonitorRequest struct {
+	CallbackURL       string `json:"callback_url" binding:"required,url"`
+	Address           string `json:"address"`
+	ContractAddress   string `json:"contract_address"`
+	Event             string `json:"event"`
+	WaitForSolidified bool   `json:"wait_for_solidified"`
+}
+
+// WebhookSubscriptionResponse is returned when a subscription is created, the
+// secret is only shown once
+type WebhookSubscriptionResponse struct {
+	*WebhookSubscription
+	Secret string `json:"secret"`
+}
+
+// WebhookDeliveryRequest represents delivery log query parameters
+type WebhookDeliveryRequest struct {
+	Status string `form:"status"`
+	Start  int    `form:"start"`
+	Limit  int    `form:"limit"`
+}
+
+// WebhookPayload is the JSON body posted to subscribers
+type WebhookPayload struct {
+	SubscriptionID string                 `json:"subscription_id"`
+	Type           string                 `json:"type"`
+	BlockNumber    int64                  `json:"block_number"`
+	BlockTimestamp int64                  `json:"block_timestamp"`
+	TransactionID  string                 `json:"transaction_id"`
+	Unconfirmed    bool                   `json:"unconfirmed"`
+	Data           map[string]interface{} `json:"data"`
+}
@@ -0,0 +1,102 @@
+// internal/models/witness.go
+package models
+
+import (
+	"time"
+)
+
+// WitnessMissedSlot represents a block slot an active witness did not fill.
+// BlockNumber is the first block produced after the gap.
+type WitnessMissedSlot struct {
+	ID            uint      `gorm:"primarykey" json:"-"`
+	BlockNumber   int64     `gorm:"index" json:"block_number"`
+	Witness       string    `gorm:"uniqueIndex:idx_witness_slot;type:varchar(42)" json:"witness"`
+	SlotTimestamp int64     `gorm:"uniqueIndex:idx_witness_slot" json:"slot_timestamp"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// WitnessVoteSnapshot represents the votes and rank of a witness at the start of a maintenance cycle
+type WitnessVoteSnapshot struct {
+	ID         uint      `gorm:"primarykey" json:"-"`
+	CycleStart int64     `gorm:"uniqueIndex:idx_witness_vote_snapshot" json:"cycle_start"`
+	Witness    string    `gorm:"uniqueIndex:idx_witness_vote_snapshot;index;type:varchar(42)" json:"witness"`
+	VoteCount  int64     `json:"vote_count"`
+	Rank       int       `json:"rank"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// WitnessBrokerageChange represents an UpdateBrokerage transaction, brokerage
+// is the percentage of rewards kept by the witness
+type WitnessBrokerageChange struct {
+	ID             uint      `gorm:"primarykey" json:"-"`
+	TransactionID  string    `gorm:"uniqueIndex:idx_witness_brokerage_changes_tx;type:varchar(64)" json:"transaction_id"`
+	BlockNumber    int64     `gorm:"index" json:"block_number"`
+	BlockTimestamp int64     `json:"block_timestamp"`
+	Witness        string    `gorm:"index;type:varchar(42)" json:"witness"`
+	Brokerage      int       `json:"brokerage"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// WitnessCycleStat is the production of a witness in one maintenance cycle.
+// Expected counts the slots scheduled for the witness that the index has seen.
+type WitnessCycleStat struct {
+	CycleStart   int64   `json:"cycle_start"`
+	Produced     int64   `json:"produced"`
+	Missed       int64   `json:"missed"`
+	Expected     int64   `json:"expected"`
+	Productivity float64 `json:"productivity"`
+}
+
+// WitnessProduction is the number of blocks produced and slots missed by a witness
+type WitnessProduction struct {
+	Witness  string `json:"witness"`
+	Produced int64  `json:"produced"`
+	Missed   int64  `json:"missed"`
+}
+
+// WitnessStatsResponse represents the performance analytics of a witness
+type WitnessStatsResponse struct {
+	Address           string                    `json:"address"`
+	URL               string                    `json:"url"`
+	VoteCount         int64                     `json:"vote_count"`
+	Rank              int                       `json:"rank"`
+	Active            bool                      `json:"active"`
+	Brokerage         int64                     `json:"brokerage"`
+	PendingReward     int64                     `json:"pending_reward"`
+	EstimatedVoterAPR float64                   `json:"estimated_voter_apr"`
+	Produced          int64                     `json:"produced"`
+	Missed            int64                     `json:"missed"`
+	Productivity      float64                   `json:"productivity"`
+	AverageBlockGap   float64                   `json:"average_block_gap_ms"`
+	Cycles            []*WitnessCycleStat       `json:"cycles"`
+	VoteHistory       []*WitnessVoteSnapshot    `json:"vote_history"`
+	BrokerageHistory  []*WitnessBrokerageChange `json:"brokerage_history"`
+}
+
+// WitnessRankingEntry is one witness of the performance ranking
+type WitnessRankingEntry struct {
+	Address           string  `json:"address"`
+	URL               string  `json:"url"`
+	VoteCount         int64   `json:"vote_count"`
+	Rank              int     `json:"rank"`
+	Active            bool    `json:"active"`
+	Brokerage         int64   `json:"brokerage"`
+	EstimatedVoterAPR float64 `json:"estimated_voter_apr"`
+	Produced          int64   `json:"produced"`
+	Missed            int64   `json:"missed"`
+	Productivity      float64 `json:"productivity"`
+}
+
+// WitnessStatsRequest represents witness analytics query parameters, cycles
+// is the number of maintenance cycles covered
+type WitnessStatsRequest struct {
+	Cycles int `form:"cycles"`
+}
+
+// WitnessRankingRequest represents witness ranking query parameters,
+// sort is one of votes, productivity or apr
+type WitnessRankingRequest struct {
+	Sort   string `form:"sort"`
+	Cycles int    `form:"cycles"`
+	Limit  int    `form:"limit"`
+}
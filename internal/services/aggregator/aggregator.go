@@ -0,0 +1,164 @@
+package aggregator
+
+import (
+	"sync"
+	"time"
+
+	"github.com/lindaprotocol/grpc-api-gateway/internal/config"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/storage/repository"
+	"github.com/sirupsen/logrus"
+)
+
+// seriesIntervals are the lengths of the buckets of each activity series
+var seriesIntervals = []struct {
+	name     string
+	interval time.Duration
+}{
+	{models.StatsIntervalHourly, time.Hour},
+	{models.StatsIntervalDaily, 24 * time.Hour},
+}
+
+// Aggregator periodically computes the hourly and daily activity series and the
+// overview summary into the statistics table. The first run backfills the series
+// from the earliest indexed block, later runs recompute them from their latest
+// stored bucket, which may have been incomplete or changed by a reorganization.
+type Aggregator struct {
+	config    config.AggregatorConfig
+	statsRepo *repository.StatsRepository
+	logger    *logrus.Logger
+	stopChan  chan struct{}
+	wg        sync.WaitGroup
+}
+
+func NewAggregator(cfg config.AggregatorConfig, statsRepo *repository.StatsRepository) *Aggregator {
+	if cfg.Interval <= 0 {
+		cfg.Interval = 5 * time.Minute
+	}
+	if cfg.BackfillBatch <= 0 {
+		cfg.BackfillBatch = 168
+	}
+	return &Aggregator{
+		config:    cfg,
+		statsRepo: statsRepo,
+		logger:    logrus.New(),
+		stopChan:  make(chan struct{}),
+	}
+}
+
+// Start begins aggregating
+func (a *Aggregator) Start() {
+	a.logger.Info("Starting statistics aggregator")
+
+	a.wg.Add(1)
+	go func() {
+		defer a.wg.Done()
+
+		ticker := time.NewTicker(a.config.Interval)
+		defer ticker.Stop()
+
+		for {
+			if err := a.Aggregate(); err != nil {
+				a.logger.WithError(err).Error("Failed to aggregate statistics")
+			}
+
+			select {
+			case <-ticker.C:
+			case <-a.stopChan:
+				return
+			}
+		}
+	}()
+}
+
+// Stop halts aggregation
+func (a *Aggregator) Stop() {
+	a.logger.Info("Stopping statistics aggregator")
+	close(a.stopChan)
+	a.wg.Wait()
+}
+
+// Aggregate brings the activity series up to the current bucket and recomputes the summary
+func (a *Aggregator) Aggregate() error {
+	now := time.Now().UnixMilli()
+	for _, series := range seriesIntervals {
+		if err := a.aggregateSeries(series.name, series.interval.Milliseconds(), now); err != nil {
+			return err
+		}
+	}
+	return a.aggregateSummary(now)
+}
+
+// aggregateSeries computes the buckets of a series from its latest stored bucket,
+// or from the earliest indexed block, up to the bucket holding now
+func (a *Aggregator) aggregateSeries(name string, interval, now int64) error {
+	statType := models.StatTypeSeriesPrefix + name
+
+	from, err := a.statsRepo.GetLatestStatisticTimestamp(statType)
+	if err != nil {
+		return err
+	}
+	if from == 0 {
+		if from, err = a.statsRepo.GetFirstBlockTimestamp(); err != nil {
+			return err
+		}
+		if from == 0 {
+			return nil
+		}
+		a.logger.WithFields(logrus.Fields{
+			"series": name,
+			"from":   time.UnixMilli(from).UTC().Format(time.RFC3339),
+		}).Info("Backfilling statistics")
+	}
+	from = from / interval * interval
+	end := now/interval*interval + interval
+
+	for from < end {
+		to := from + int64(a.config.BackfillBatch)*interval
+		if to > end {
+			to = end
+		}
+
+		buckets, err := a.statsRepo.GetActivitySeries(interval, from, to)
+		if err != nil {
+			return err
+		}
+		if err := a.statsRepo.SaveStatisticSeries(statType, buckets); err != nil {
+			return err
+		}
+		from = to
+
+		select {
+		case <-a.stopChan:
+			return nil
+		default:
+		}
+	}
+	return nil
+}
+
+// aggregateSummary computes the chain totals and the activity of the last 24 hours
+func (a *Aggregator) aggregateSummary(now int64) error {
+	summary, err := a.statsRepo.GetTotals()
+	if err != nil {
+		return err
+	}
+
+	day := (24 * time.Hour).Milliseconds()
+	buckets, err := a.statsRepo.GetActivitySeries(day, now-day, now)
+	if err != nil {
+		return err
+	}
+	last := buckets[0]
+
+	summary.Timestamp = now
+	summary.Transactions24h = last.Transactions
+	summary.NewAccounts24h = last.NewAccounts
+	summary.ActiveAccounts24h = last.ActiveAccounts
+	summary.FeesBurned24h = last.FeesBurned
+	summary.EnergyUsed24h = last.EnergyUsed
+	summary.NetUsed24h = last.NetUsed
+	summary.LRC20Transfers24h = last.LRC20Transfers
+
+	return a.statsRepo.ReplaceStatistic(models.StatTypeSummary, summary, now)
+}
@@ -0,0 +1,426 @@
+package alert
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/config"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/blockchain"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/storage/repository"
+	"github.com/lindaprotocol/grpc-api-gateway/pkg/lindapb"
+	"github.com/lindaprotocol/grpc-api-gateway/pkg/utils"
+	"github.com/sirupsen/logrus"
+)
+
+// Kinds of indexed activity evaluated against alert rules
+const (
+	activityTransfer         = "transfer"
+	activityDelegation       = "delegation"
+	activityUndelegation     = "undelegation"
+	activityPermissionUpdate = "permission_update"
+)
+
+// activity is one side of an indexed transfer or account operation
+type activity struct {
+	kind           string
+	address        string
+	counterparty   string
+	outgoing       bool
+	asset          string
+	amount         *big.Int
+	transactionID  string
+	blockNumber    int64
+	blockTimestamp int64
+}
+
+type notification struct {
+	rule  *models.AlertRule
+	alert *models.Alert
+}
+
+// Engine evaluates indexed transfers and account operations against the
+// alert rules of watched addresses and hands fired alerts to notifiers
+type Engine struct {
+	config    config.AlertConfig
+	repo      *repository.AlertRepository
+	tokenRepo *repository.TokenRepository
+	client    *blockchain.Client
+	notifiers map[string]Notifier
+	queue     chan *notification
+	logger    *logrus.Logger
+	stopChan  chan struct{}
+	wg        sync.WaitGroup
+
+	mu       sync.RWMutex
+	watched  map[string][]*models.AlertRule
+	loadedAt time.Time
+}
+
+func NewEngine(cfg config.AlertConfig, repo *repository.AlertRepository, tokenRepo *repository.TokenRepository, client *blockchain.Client) *Engine {
+	if cfg.RuleRefresh <= 0 {
+		cfg.RuleRefresh = 30 * time.Second
+	}
+	if cfg.DefaultDedupWindow <= 0 {
+		cfg.DefaultDedupWindow = 10 * time.Minute
+	}
+	if cfg.QueueSize <= 0 {
+		cfg.QueueSize = 1000
+	}
+
+	e := &Engine{
+		config:    cfg,
+		repo:      repo,
+		tokenRepo: tokenRepo,
+		client:    client,
+		notifiers: make(map[string]Notifier),
+		queue:     make(chan *notification, cfg.QueueSize),
+		logger:    logrus.New(),
+		stopChan:  make(chan struct{}),
+	}
+
+	// Default notifiers
+	e.RegisterNotifier(NewWebhookNotifier(cfg.WebhookTimeout))
+	e.RegisterNotifier(NewSMTPNotifier(cfg.SMTP))
+	e.RegisterNotifier(NewLogNotifier(e.logger))
+
+	return e
+}
+
+// RegisterNotifier adds a notifier, replacing any registered under the same name
+func (e *Engine) RegisterNotifier(n Notifier) {
+	e.notifiers[n.Name()] = n
+}
+
+// Start begins delivering fired alerts
+func (e *Engine) Start() {
+	e.logger.Info("Starting alert engine")
+
+	e.wg.Add(1)
+	go func() {
+		defer e.wg.Done()
+		for {
+			select {
+			case n := <-e.queue:
+				e.notify(n)
+			case <-e.stopChan:
+				return
+			}
+		}
+	}()
+}
+
+// Stop halts alert delivery
+func (e *Engine) Stop() {
+	e.logger.Info("Stopping alert engine")
+	close(e.stopChan)
+	e.wg.Wait()
+}
+
+// CheckTransaction evaluates a native transaction. asset is the LRC-10 token
+// ID of a TransferAssetContract and is ignored for other contract types. The
+// result of tx must already be known, failed transactions are skipped.
+func (e *Engine) CheckTransaction(ctx context.Context, tx *models.Transaction, asset string) {
+	if tx.Result != 0 {
+		return
+	}
+
+	base := activity{
+		transactionID:  tx.Hash,
+		blockNumber:    tx.BlockNumber,
+		blockTimestamp: tx.BlockTimestamp,
+	}
+
+	switch tx.ContractType {
+	case models.ContractTypeTransfer, models.ContractTypeTransferAsset:
+		if tx.ContractType == models.ContractTypeTransfer {
+			asset = models.AssetLIND
+		}
+		base.kind = activityTransfer
+		base.asset = asset
+		base.amount = big.NewInt(tx.Amount)
+		e.checkBothSides(ctx, base, tx.FromAddress, tx.ToAddress)
+	case models.ContractTypeDelegateResource, models.ContractTypeUnDelegateResource:
+		base.kind = activityDelegation
+		if tx.ContractType == models.ContractTypeUnDelegateResource {
+			base.kind = activityUndelegation
+		}
+		base.asset = models.AssetLIND
+		e.checkBothSides(ctx, base, tx.FromAddress, tx.ToAddress)
+	case models.ContractTypeAccountPermissionUpdate:
+		base.kind = activityPermissionUpdate
+		base.address = tx.FromAddress
+		base.outgoing = true
+		e.evaluate(ctx, &base)
+	}
+}
+
+// CheckTokenTransfer evaluates an indexed LRC-20 transfer
+func (e *Engine) CheckTokenTransfer(ctx context.Context, transfer *models.TokenTransferResponse) {
+	amount, ok := new(big.Int).SetString(transfer.Value, 10)
+	if !ok {
+		return
+	}
+
+	e.checkBothSides(ctx, activity{
+		kind:           activityTransfer,
+		asset:          transfer.TokenAddress,
+		amount:         amount,
+		transactionID:  transfer.TransactionID,
+		blockNumber:    transfer.BlockNumber,
+		blockTimestamp: transfer.BlockTimestamp,
+	}, transfer.From, transfer.To)
+}
+
+func (e *Engine) checkBothSides(ctx context.Context, base activity, from, to string) {
+	if from != "" {
+		out := base
+		out.address, out.counterparty, out.outgoing = from, to, true
+		e.evaluate(ctx, &out)
+	}
+	if to != "" && to != from {
+		in := base
+		in.address, in.counterparty, in.outgoing = to, from, false
+		e.evaluate(ctx, &in)
+	}
+}
+
+func (e *Engine) evaluate(ctx context.Context, act *activity) {
+	for _, rule := range e.rulesFor(act.address) {
+		message, ok := e.match(ctx, rule, act)
+		if !ok {
+			continue
+		}
+		if err := e.fire(rule, act, message); err != nil {
+			e.logger.WithError(err).WithField("rule", rule.ID).Error("Failed to fire alert")
+		}
+	}
+}
+
+// match reports whether the activity triggers the rule and describes it
+func (e *Engine) match(ctx context.Context, rule *models.AlertRule, act *activity) (string, bool) {
+	switch rule.Type {
+	case models.AlertRuleLargeTransfer:
+		if act.kind != activityTransfer || (rule.Asset != "" && rule.Asset != act.asset) {
+			return "", false
+		}
+		threshold, ok := new(big.Int).SetString(rule.Threshold, 10)
+		if !ok || act.amount == nil || act.amount.Cmp(threshold) < 0 {
+			return "", false
+		}
+		direction := "received from"
+		if act.outgoing {
+			direction = "sent to"
+		}
+		return fmt.Sprintf("%s %s %s %s %s", act.address, act.amount.String(), act.asset, direction, act.counterparty), true
+
+	case models.AlertRuleLowBalance:
+		// Every outgoing operation pays fees in LIND, so LIND rules are checked on all of them
+		if !act.outgoing || rule.Asset == "" || (rule.Asset != act.asset && rule.Asset != models.AssetLIND) {
+			return "", false
+		}
+		threshold, ok := new(big.Int).SetString(rule.Threshold, 10)
+		if !ok {
+			return "", false
+		}
+		balance, err := e.balance(ctx, rule.Asset, act.address)
+		if err != nil {
+			e.logger.WithError(err).WithField("address", act.address).Warn("Failed to get balance for alert rule")
+			return "", false
+		}
+		if balance.Cmp(threshold) >= 0 {
+			return "", false
+		}
+		return fmt.Sprintf("%s balance of %s dropped to %s, below %s", act.address, rule.Asset, balance.String(), threshold.String()), true
+
+	case models.AlertRuleDelegation:
+		switch act.kind {
+		case activityDelegation:
+			if act.outgoing {
+				return fmt.Sprintf("%s delegated resources to %s", act.address, act.counterparty), true
+			}
+			return fmt.Sprintf("%s received delegated resources from %s", act.address, act.counterparty), true
+		case activityUndelegation:
+			if act.outgoing {
+				return fmt.Sprintf("%s undelegated resources from %s", act.address, act.counterparty), true
+			}
+			return fmt.Sprintf("%s had delegated resources reclaimed by %s", act.address, act.counterparty), true
+		}
+
+	case models.AlertRulePermissionUpdate:
+		if act.kind == activityPermissionUpdate {
+			return fmt.Sprintf("%s updated its account permissions", act.address), true
+		}
+	}
+
+	return "", false
+}
+
+// fire records an alert unless the same rule already fired for the address
+// and asset within the dedup window, then queues its notification
+func (e *Engine) fire(rule *models.AlertRule, act *activity, message string) error {
+	window := e.config.DefaultDedupWindow
+	if rule.DedupWindow > 0 {
+		window = time.Duration(rule.DedupWindow) * time.Second
+	}
+
+	// Balance alerts concern the rule asset rather than the asset that moved
+	asset, amount := act.asset, act.amount
+	if rule.Type == models.AlertRuleLowBalance {
+		asset, amount = rule.Asset, nil
+	}
+
+	now := time.Now()
+	dedupKey := act.address + ":" + asset
+	recent, err := e.repo.HasRecentAlert(rule.ID, dedupKey, now.Add(-window))
+	if err != nil {
+		return err
+	}
+	if recent {
+		return nil
+	}
+
+	alert := &models.Alert{
+		ID:             uuid.New().String(),
+		RuleID:         rule.ID,
+		UserID:         rule.UserID,
+		Type:           rule.Type,
+		Address:        act.address,
+		Asset:          asset,
+		TransactionID:  act.transactionID,
+		BlockNumber:    act.blockNumber,
+		BlockTimestamp: act.blockTimestamp,
+		Message:        message,
+		DedupKey:       dedupKey,
+		CreatedAt:      now,
+	}
+	if amount != nil {
+		alert.Amount = amount.String()
+	}
+	if err := e.repo.SaveAlert(alert); err != nil {
+		return err
+	}
+
+	select {
+	case e.queue <- &notification{rule: rule, alert: alert}:
+	default:
+		alert.NotifyError = "notification queue full"
+		return e.repo.SaveAlert(alert)
+	}
+	return nil
+}
+
+func (e *Engine) notify(n *notification) {
+	notifier, ok := e.notifiers[n.rule.Notifier]
+	if !ok {
+		n.alert.NotifyError = "unknown notifier " + n.rule.Notifier
+	} else {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		err := notifier.Notify(ctx, n.rule, n.alert)
+		cancel()
+		if err != nil {
+			n.alert.NotifyError = err.Error()
+		} else {
+			now := time.Now()
+			n.alert.NotifiedAt = &now
+			n.alert.NotifyError = ""
+		}
+	}
+
+	if n.alert.NotifyError != "" {
+		e.logger.WithField("alert", n.alert.ID).Warn("Failed to deliver alert: " + n.alert.NotifyError)
+	}
+	if err := e.repo.SaveAlert(n.alert); err != nil {
+		e.logger.WithError(err).WithField("alert", n.alert.ID).Error("Failed to record alert notification")
+	}
+}
+
+// rulesFor returns the active rules watching an address, reloading the
+// watched set from the database once the refresh interval has passed
+func (e *Engine) rulesFor(address string) []*models.AlertRule {
+	e.mu.RLock()
+	watched, loadedAt := e.watched, e.loadedAt
+	e.mu.RUnlock()
+
+	if time.Since(loadedAt) >= e.config.RuleRefresh {
+		if fresh, err := e.loadWatched(); err == nil {
+			e.mu.Lock()
+			e.watched = fresh
+			e.loadedAt = time.Now()
+			e.mu.Unlock()
+			watched = fresh
+		} else {
+			// Keep matching against the previous set
+			e.logger.WithError(err).Error("Failed to reload alert rules")
+		}
+	}
+
+	return watched[address]
+}
+
+func (e *Engine) loadWatched() (map[string][]*models.AlertRule, error) {
+	rules, err := e.repo.GetActiveRules()
+	if err != nil {
+		return nil, err
+	}
+
+	byWatchlist := make(map[string][]*models.AlertRule)
+	watchlistIDs := make([]string, 0)
+	for _, rule := range rules {
+		if _, ok := byWatchlist[rule.WatchlistID]; !ok {
+			watchlistIDs = append(watchlistIDs, rule.WatchlistID)
+		}
+		byWatchlist[rule.WatchlistID] = append(byWatchlist[rule.WatchlistID], rule)
+	}
+
+	addresses, err := e.repo.GetAddressesByWatchlists(watchlistIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	watched := make(map[string][]*models.AlertRule)
+	for _, addr := range addresses {
+		watched[addr.Address] = append(watched[addr.Address], byWatchlist[addr.WatchlistID]...)
+	}
+	return watched, nil
+}
+
+// balance returns the current balance of an asset held by an address. LIND
+// and LRC-10 balances are read from the node, LRC-20 balances from the
+// holder table maintained by the indexer.
+func (e *Engine) balance(ctx context.Context, asset, address string) (*big.Int, error) {
+	if asset != models.AssetLIND && !utils.IsTokenID(asset) {
+		value, err := e.tokenRepo.GetHolderBalance(asset, address)
+		if err != nil {
+			return nil, err
+		}
+		balance, ok := new(big.Int).SetString(value, 10)
+		if !ok {
+			return nil, fmt.Errorf("invalid balance %q", value)
+		}
+		return balance, nil
+	}
+
+	hexAddr, err := utils.Base58ToHex(address)
+	if err != nil {
+		return nil, err
+	}
+	rawAddr, err := hex.DecodeString(hexAddr)
+	if err != nil {
+		return nil, err
+	}
+	account, err := e.client.GetAccount(ctx, &lindapb.Account{Address: rawAddr})
+	if err != nil {
+		return nil, err
+	}
+
+	if asset == models.AssetLIND {
+		return big.NewInt(account.Balance), nil
+	}
+	return big.NewInt(account.AssetV2[asset]), nil
+}
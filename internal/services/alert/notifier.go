@@ -0,0 +1,153 @@
+package alert
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lindaprotocol/grpc-api-gateway/internal/config"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/webhook"
+	"github.com/sirupsen/logrus"
+)
+
+// Notifier delivers fired alerts to the channel selected by a rule
+type Notifier interface {
+	// Name is the value of AlertRule.Notifier handled by this notifier
+	Name() string
+	Notify(ctx context.Context, rule *models.AlertRule, alert *models.Alert) error
+}
+
+// WebhookNotifier posts alerts as JSON to the rule target, signed the same
+// way as webhook deliveries
+type WebhookNotifier struct {
+	httpClient *http.Client
+}
+
+func NewWebhookNotifier(timeout time.Duration) *WebhookNotifier {
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	return &WebhookNotifier{httpClient: webhook.NewCallbackClient(timeout)}
+}
+
+func (n *WebhookNotifier) Name() string {
+	return models.AlertNotifierWebhook
+}
+
+func (n *WebhookNotifier) Notify(ctx context.Context, rule *models.AlertRule, alert *models.Alert) error {
+	body, err := json.Marshal(alert)
+	if err != nil {
+		return err
+	}
+
+	timestamp := time.Now().Unix()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, rule.Target, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(webhook.HeaderSignature, "sha256="+webhook.Sign(rule.Secret, timestamp, body))
+	req.Header.Set(webhook.HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(webhook.HeaderDelivery, alert.ID)
+
+	resp, err := n.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("callback returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// SMTPNotifier emails alerts to the rule target
+type SMTPNotifier struct {
+	config config.SMTPConfig
+}
+
+func NewSMTPNotifier(cfg config.SMTPConfig) *SMTPNotifier {
+	if cfg.Port == 0 {
+		cfg.Port = 587
+	}
+	return &SMTPNotifier{config: cfg}
+}
+
+func (n *SMTPNotifier) Name() string {
+	return models.AlertNotifierEmail
+}
+
+func (n *SMTPNotifier) Notify(ctx context.Context, rule *models.AlertRule, alert *models.Alert) error {
+	if n.config.Host == "" {
+		return fmt.Errorf("smtp is not configured")
+	}
+
+	// The target goes into a header, refuse anything but a single plain address
+	if strings.ContainsAny(rule.Target, "\r\n") {
+		return fmt.Errorf("invalid email target")
+	}
+	to, err := mail.ParseAddress(rule.Target)
+	if err != nil {
+		return fmt.Errorf("invalid email target: %w", err)
+	}
+
+	var msg strings.Builder
+	msg.WriteString("From: " + n.config.From + "\r\n")
+	msg.WriteString("To: " + to.String() + "\r\n")
+	msg.WriteString("Subject: [Linda alert] " + alert.Type + " on " + alert.Address + "\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	msg.WriteString("\r\n")
+	msg.WriteString(alert.Message + "\r\n\r\n")
+	if alert.TransactionID != "" {
+		msg.WriteString("Transaction: " + alert.TransactionID + "\r\n")
+	}
+	msg.WriteString(fmt.Sprintf("Block: %d\r\n", alert.BlockNumber))
+	msg.WriteString("Rule: " + rule.ID + "\r\n")
+
+	var auth smtp.Auth
+	if n.config.Username != "" {
+		auth = smtp.PlainAuth("", n.config.Username, n.config.Password, n.config.Host)
+	}
+	addr := fmt.Sprintf("%s:%d", n.config.Host, n.config.Port)
+	return smtp.SendMail(addr, auth, n.config.From, []string{to.Address}, []byte(msg.String()))
+}
+
+// LogNotifier writes alerts to the log, used in tests and local setups
+type LogNotifier struct {
+	logger *logrus.Logger
+}
+
+func NewLogNotifier(logger *logrus.Logger) *LogNotifier {
+	if logger == nil {
+		logger = logrus.New()
+	}
+	return &LogNotifier{logger: logger}
+}
+
+func (n *LogNotifier) Name() string {
+	return models.AlertNotifierLog
+}
+
+func (n *LogNotifier) Notify(ctx context.Context, rule *models.AlertRule, alert *models.Alert) error {
+	n.logger.WithFields(logrus.Fields{
+		"rule":        rule.ID,
+		"type":        alert.Type,
+		"address":     alert.Address,
+		"asset":       alert.Asset,
+		"amount":      alert.Amount,
+		"transaction": alert.TransactionID,
+		"block":       alert.BlockNumber,
+	}).Warn(alert.Message)
+	return nil
+}
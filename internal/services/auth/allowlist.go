@@ -1,180 +1,244 @@
-package auth
-
-import (
-	"time"
-
-	"github.com/google/uuid"
-	"gorm.io/gorm"
-)
-
-type Allowlist struct {
-	ID        string    `gorm:"primaryKey;type:uuid"`
-	APIKeyID  string    `gorm:"index;not null"`
-	UserID    string    `gorm:"index;not null"`
-	Type      string    `gorm:"not null"` // user_agent, origin, contract_address, api_method
-	Value     string    `gorm:"not null"`
-	CreatedAt time.Time `gorm:"not null"`
-}
-
-func (Allowlist) TableName() string {
-	return "allowlists"
-}
-
-type AllowlistService struct {
-	db *gorm.DB
-}
-
-func NewAllowlistService(db *gorm.DB) *AllowlistService {
-	return &AllowlistService{db: db}
-}
-
-// AddToAllowlist adds an entry to the allowlist
-func (s *AllowlistService) AddToAllowlist(apiKeyID, userID, allowlistType, value string) error {
-	entry := &Allowlist{
-		ID:        uuid.New().String(),
-		APIKeyID:  apiKeyID,
-		UserID:    userID,
-		Type:      allowlistType,
-		Value:     value,
-		CreatedAt: time.Now(),
-	}
-	return s.db.Create(entry).Error
-}
-
-// RemoveFromAllowlist removes an entry from the allowlist
-func (s *AllowlistService) RemoveFromAllowlist(entryID string) error {
-	return s.db.Delete(&Allowlist{}, "id = ?", entryID).Error
-}
-
-// GetAllowlist returns all allowlist entries for an API key
-func (s *AllowlistService) GetAllowlist(apiKeyID string) (map[string][]string, error) {
-	var entries []Allowlist
-	if err := s.db.Where("api_key_id = ?", apiKeyID).Find(&entries).Error; err != nil {
-		return nil, err
-	}
-
-	result := make(map[string][]string)
-	for _, entry := range entries {
-		result[entry.Type] = append(result[entry.Type], entry.Value)
-	}
-	return result, nil
-}
-
-// CheckUserAgent validates if a user agent is allowed
-func (s *AllowlistService) CheckUserAgent(apiKeyID, userAgent string) bool {
-	if apiKeyID == "" {
-		return true
-	}
-
-	var count int64
-	s.db.Model(&Allowlist{}).
-		Where("api_key_id = ? AND type = ?", apiKeyID, "user_agent").
-		Count(&count)
-
-	if count == 0 {
-		return true // No restrictions
-	}
-
-	var allowed bool
-	s.db.Raw(`
-		SELECT EXISTS(
-			SELECT 1 FROM allowlists 
-			WHERE api_key_id = ? 
-			AND type = 'user_agent' 
-			AND ? LIKE '%' || value || '%'
-		)`, apiKeyID, userAgent).Scan(&allowed)
-
-	return allowed
-}
-
-// CheckOrigin validates if an origin is allowed
-func (s *AllowlistService) CheckOrigin(apiKeyID, origin string) bool {
-	if apiKeyID == "" {
-		return true
-	}
-
-	var count int64
-	s.db.Model(&Allowlist{}).
-		Where("api_key_id = ? AND type = ?", apiKeyID, "origin").
-		Count(&count)
-
-	if count == 0 {
-		return true
-	}
-
-	var allowed bool
-	s.db.Raw(`
-		SELECT EXISTS(
-			SELECT 1 FROM allowlists 
-			WHERE api_key_id = ? 
-			AND type = 'origin' 
-			AND ? LIKE value
-		)`, apiKeyID, origin).Scan(&allowed)
-
-	// Check wildcard patterns
-	if !allowed {
-		s.db.Raw(`
-			SELECT EXISTS(
-				SELECT 1 FROM allowlists 
-				WHERE api_key_id = ? 
-				AND type = 'origin' 
-				AND ? LIKE REPLACE(value, '*', '%')
-			)`, apiKeyID, origin).Scan(&allowed)
-	}
-
-	return allowed
-}
-
-// CheckContractAddress validates if a contract address is allowed
-func (s *AllowlistService) CheckContractAddress(apiKeyID, address string) bool {
-	if apiKeyID == "" {
-		return true
-	}
-
-	var count int64
-	s.db.Model(&Allowlist{}).
-		Where("api_key_id = ? AND type = ?", apiKeyID, "contract_address").
-		Count(&count)
-
-	if count == 0 {
-		return true
-	}
-
-	var allowed bool
-	s.db.Raw(`
-		SELECT EXISTS(
-			SELECT 1 FROM allowlists 
-			WHERE api_key_id = ? 
-			AND type = 'contract_address' 
-			AND value = ?
-		)`, apiKeyID, address).Scan(&allowed)
-
-	return allowed
-}
-
-// CheckAPIMethod validates if an API method is allowed
-func (s *AllowlistService) CheckAPIMethod(apiKeyID, method string) bool {
-	if apiKeyID == "" {
-		return true
-	}
-
-	var count int64
-	s.db.Model(&Allowlist{}).
-		Where("api_key_id = ? AND type = ?", apiKeyID, "api_method").
-		Count(&count)
-
-	if count == 0 {
-		return true
-	}
-
-	var allowed bool
-	s.db.Raw(`
-		SELECT EXISTS(
-			SELECT 1 FROM allowlists 
-			WHERE api_key_id = ? 
-			AND type = 'api_method' 
-			AND value = ?
-		)`, apiKeyID, method).Scan(&allowed)
-
-	return allowed
+package auth
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type Allowlist struct {
+	ID        string    `gorm:"primaryKey;type:uuid"`
+	APIKeyID  string    `gorm:"index;not null"`
+	UserID    string    `gorm:"index;not null"`
+	Type      string    `gorm:"not null"` // user_agent, origin, contract_address, api_method, node_host
+	Value     string    `gorm:"not null"`
+	CreatedAt time.Time `gorm:"not null"`
+}
+
+func (Allowlist) TableName() string {
+	return "allowlists"
+}
+
+// JSON-RPC methods are stored under the api_method type as "jsonrpc:<method>".
+// A leading "!" turns the entry into a deny rule and "jsonrpc:*" allows every method.
+const (
+	JSONRPCMethodPrefix = "jsonrpc:"
+	DenyPrefix          = "!"
+)
+
+// IsJSONRPCMethodEntry reports whether an api_method value targets JSON-RPC
+func IsJSONRPCMethodEntry(value string) bool {
+	return strings.HasPrefix(strings.TrimPrefix(value, DenyPrefix), JSONRPCMethodPrefix)
+}
+
+type AllowlistService struct {
+	db *gorm.DB
+}
+
+func NewAllowlistService(db *gorm.DB) *AllowlistService {
+	return &AllowlistService{db: db}
+}
+
+// AddToAllowlist adds an entry to the allowlist
+func (s *AllowlistService) AddToAllowlist(apiKeyID, userID, allowlistType, value string) error {
+	entry := &Allowlist{
+		ID:        uuid.New().String(),
+		APIKeyID:  apiKeyID,
+		UserID:    userID,
+		Type:      allowlistType,
+		Value:     value,
+		CreatedAt: time.Now(),
+	}
+	return s.db.Create(entry).Error
+}
+
+// RemoveFromAllowlist removes an entry from the allowlist
+func (s *AllowlistService) RemoveFromAllowlist(entryID string) error {
+	return s.db.Delete(&Allowlist{}, "id = ?", entryID).Error
+}
+
+// GetAllowlist returns all allowlist entries for an API key
+func (s *AllowlistService) GetAllowlist(apiKeyID string) (map[string][]string, error) {
+	var entries []Allowlist
+	if err := s.db.Where("api_key_id = ?", apiKeyID).Find(&entries).Error; err != nil {
+		return nil, err
+	}
+
+	result := make(map[string][]string)
+	for _, entry := range entries {
+		result[entry.Type] = append(result[entry.Type], entry.Value)
+	}
+	return result, nil
+}
+
+// CheckUserAgent validates if a user agent is allowed
+func (s *AllowlistService) CheckUserAgent(apiKeyID, userAgent string) bool {
+	if apiKeyID == "" {
+		return true
+	}
+
+	var count int64
+	s.db.Model(&Allowlist{}).
+		Where("api_key_id = ? AND type = ?", apiKeyID, "user_agent").
+		Count(&count)
+
+	if count == 0 {
+		return true // No restrictions
+	}
+
+	var allowed bool
+	s.db.Raw(`
+		SELECT EXISTS(
+			SELECT 1 FROM allowlists 
+			WHERE api_key_id = ? 
+			AND type = 'user_agent' 
+			AND ? LIKE '%' || value || '%'
+		)`, apiKeyID, userAgent).Scan(&allowed)
+
+	return allowed
+}
+
+// CheckOrigin validates if an origin is allowed
+func (s *AllowlistService) CheckOrigin(apiKeyID, origin string) bool {
+	if apiKeyID == "" {
+		return true
+	}
+
+	var count int64
+	s.db.Model(&Allowlist{}).
+		Where("api_key_id = ? AND type = ?", apiKeyID, "origin").
+		Count(&count)
+
+	if count == 0 {
+		return true
+	}
+
+	var allowed bool
+	s.db.Raw(`
+		SELECT EXISTS(
+			SELECT 1 FROM allowlists 
+			WHERE api_key_id = ? 
+			AND type = 'origin' 
+			AND ? LIKE value
+		)`, apiKeyID, origin).Scan(&allowed)
+
+	// Check wildcard patterns
+	if !allowed {
+		s.db.Raw(`
+			SELECT EXISTS(
+				SELECT 1 FROM allowlists 
+				WHERE api_key_id = ? 
+				AND type = 'origin' 
+				AND ? LIKE REPLACE(value, '*', '%')
+			)`, apiKeyID, origin).Scan(&allowed)
+	}
+
+	return allowed
+}
+
+// CheckContractAddress validates if a contract address is allowed
+func (s *AllowlistService) CheckContractAddress(apiKeyID, address string) bool {
+	if apiKeyID == "" {
+		return true
+	}
+
+	var count int64
+	s.db.Model(&Allowlist{}).
+		Where("api_key_id = ? AND type = ?", apiKeyID, "contract_address").
+		Count(&count)
+
+	if count == 0 {
+		return true
+	}
+
+	var allowed bool
+	s.db.Raw(`
+		SELECT EXISTS(
+			SELECT 1 FROM allowlists 
+			WHERE api_key_id = ? 
+			AND type = 'contract_address' 
+			AND value = ?
+		)`, apiKeyID, address).Scan(&allowed)
+
+	return allowed
+}
+
+// CheckAPIMethod validates if an API method is allowed
+func (s *AllowlistService) CheckAPIMethod(apiKeyID, method string) bool {
+	if apiKeyID == "" {
+		return true
+	}
+
+	// JSON-RPC entries share the type but are checked by CheckJSONRPCMethod
+	var count int64
+	s.db.Model(&Allowlist{}).
+		Where("api_key_id = ? AND type = ?", apiKeyID, "api_method").
+		Where("value NOT LIKE ? AND value NOT LIKE ?", JSONRPCMethodPrefix+"%", DenyPrefix+JSONRPCMethodPrefix+"%").
+		Count(&count)
+
+	if count == 0 {
+		return true
+	}
+
+	var allowed bool
+	s.db.Raw(`
+		SELECT EXISTS(
+			SELECT 1 FROM allowlists 
+			WHERE api_key_id = ? 
+			AND type = 'api_method' 
+			AND value = ?
+		)`, apiKeyID, method).Scan(&allowed)
+
+	return allowed
+}
+
+// AllowJSONRPCMethod adds a JSON-RPC method to an API key's allowlist
+func (s *AllowlistService) AllowJSONRPCMethod(apiKeyID, userID, method string) error {
+	return s.AddToAllowlist(apiKeyID, userID, "api_method", JSONRPCMethodPrefix+method)
+}
+
+// DenyJSONRPCMethod adds a JSON-RPC method to an API key's denylist
+func (s *AllowlistService) DenyJSONRPCMethod(apiKeyID, userID, method string) error {
+	return s.AddToAllowlist(apiKeyID, userID, "api_method", DenyPrefix+JSONRPCMethodPrefix+method)
+}
+
+// CheckJSONRPCMethod validates if a JSON-RPC method is allowed
+func (s *AllowlistService) CheckJSONRPCMethod(apiKeyID, method string) bool {
+	if apiKeyID == "" {
+		return true
+	}
+
+	var values []string
+	s.db.Model(&Allowlist{}).
+		Where("api_key_id = ? AND type = ?", apiKeyID, "api_method").
+		Pluck("value", &values)
+
+	return JSONRPCMethodAllowed(values, method)
+}
+
+// JSONRPCMethodAllowed evaluates api_method entries for a JSON-RPC method.
+// Deny rules win over allow rules, and a key without allow rules may call
+// any method that is not denied.
+func JSONRPCMethodAllowed(entries []string, method string) bool {
+	restricted := false
+	allowed := false
+	for _, entry := range entries {
+		if !IsJSONRPCMethodEntry(entry) {
+			continue
+		}
+		if strings.HasPrefix(entry, DenyPrefix) {
+			if strings.TrimPrefix(entry, DenyPrefix+JSONRPCMethodPrefix) == method {
+				return false
+			}
+			continue
+		}
+		restricted = true
+		name := strings.TrimPrefix(entry, JSONRPCMethodPrefix)
+		if name == "*" || name == method {
+			allowed = true
+		}
+	}
+	return !restricted || allowed
 }
\ No newline at end of file
@@ -0,0 +1,367 @@
+package event
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/blockchain"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/storage/repository"
+	"github.com/lindaprotocol/grpc-api-gateway/pkg/lindapb"
+	"github.com/lindaprotocol/grpc-api-gateway/pkg/utils"
+)
+
+// JSON-RPC error codes used by eth_getLogs
+const (
+	CodeInvalidParams = -32602
+	CodeLimitExceeded = -32005
+)
+
+const defaultMaxLogResults = 10000
+
+// ErrLogsNotIndexed is returned when a query reaches past the indexed height,
+// the caller should forward the request to the node instead
+var ErrLogsNotIndexed = errors.New("requested range is not indexed yet")
+
+// LogQueryError is an eth_getLogs error with its JSON-RPC code
+type LogQueryError struct {
+	Code    int
+	Message string
+}
+
+func (e *LogQueryError) Error() string {
+	return e.Message
+}
+
+// RPCLog is a log entry in eth_getLogs format
+type RPCLog struct {
+	Address          string   `json:"address"`
+	Topics           []string `json:"topics"`
+	Data             string   `json:"data"`
+	BlockNumber      string   `json:"blockNumber"`
+	BlockHash        string   `json:"blockHash"`
+	TransactionHash  string   `json:"transactionHash"`
+	TransactionIndex string   `json:"transactionIndex"`
+	LogIndex         string   `json:"logIndex"`
+	Removed          bool     `json:"removed"`
+}
+
+// LogService answers eth_getLogs from the events table
+type LogService struct {
+	eventRepo     *repository.EventRepository
+	blockRepo     *repository.BlockRepository
+	client        *blockchain.Client
+	maxBlockRange int64
+	maxResults    int
+}
+
+func NewLogService(eventRepo *repository.EventRepository, blockRepo *repository.BlockRepository, client *blockchain.Client, maxBlockRange int64, maxResults int) *LogService {
+	if maxResults <= 0 {
+		maxResults = defaultMaxLogResults
+	}
+	return &LogService{
+		eventRepo:     eventRepo,
+		blockRepo:     blockRepo,
+		client:        client,
+		maxBlockRange: maxBlockRange,
+		maxResults:    maxResults,
+	}
+}
+
+// GetLogs executes an eth_getLogs call with the raw JSON-RPC params
+func (s *LogService) GetLogs(ctx context.Context, params []interface{}) ([]*RPCLog, error) {
+	filter := map[string]interface{}{}
+	if len(params) > 0 {
+		f, ok := params[0].(map[string]interface{})
+		if !ok {
+			return nil, invalidParams("filter must be an object")
+		}
+		filter = f
+	}
+
+	addresses, err := parseLogAddresses(filter["address"])
+	if err != nil {
+		return nil, err
+	}
+	topics, err := parseLogTopics(filter["topics"])
+	if err != nil {
+		return nil, err
+	}
+
+	var events []*models.Event
+	if rawHash, ok := filter["blockHash"]; ok && rawHash != nil {
+		if filter["fromBlock"] != nil || filter["toBlock"] != nil {
+			return nil, invalidParams("cannot specify both blockHash and fromBlock/toBlock")
+		}
+		blockHash, err := normalizeHash(rawHash)
+		if err != nil {
+			return nil, err
+		}
+
+		indexed, err := s.eventRepo.HasBlockLogs(blockHash)
+		if err != nil {
+			return nil, err
+		}
+		if !indexed {
+			return nil, ErrLogsNotIndexed
+		}
+
+		events, err = s.eventRepo.GetLogs(addresses, topics, 0, 0, blockHash, true, s.maxResults+1)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		fromBlock, toBlock, err := s.resolveRange(ctx, filter["fromBlock"], filter["toBlock"])
+		if err != nil {
+			return nil, err
+		}
+		if s.maxBlockRange > 0 && toBlock-fromBlock+1 > s.maxBlockRange {
+			return nil, &LogQueryError{
+				Code:    CodeLimitExceeded,
+				Message: fmt.Sprintf("block range exceeds the limit of %d blocks", s.maxBlockRange),
+			}
+		}
+
+		lastIndexed, err := s.blockRepo.GetLastIndexedBlock()
+		if err != nil {
+			return nil, ErrLogsNotIndexed
+		}
+		// The newest block row can be written before all of its events
+		indexedTo := lastIndexed - 1
+		if toBlock > indexedTo {
+			// A range ending at the head is answered up to the last indexed
+			// block once the indexer has passed the solidified head, the
+			// blocks it has not reached are not confirmed yet
+			if !isHeadTag(filter["toBlock"]) {
+				return nil, ErrLogsNotIndexed
+			}
+			solid, err := s.client.GetNowBlockSolidity(ctx, &lindapb.EmptyMessage{})
+			if err != nil || indexedTo < solid.BlockHeader.RawData.Number {
+				return nil, ErrLogsNotIndexed
+			}
+			toBlock = indexedTo
+			if fromBlock > toBlock {
+				if !isHeadTag(filter["fromBlock"]) {
+					return nil, ErrLogsNotIndexed
+				}
+				fromBlock = toBlock
+			}
+		}
+
+		// Logs of unconfirmed blocks can still be reverted, they are only
+		// returned when the range ends at "pending"
+		includeUnconfirmed := filter["toBlock"] == "pending"
+
+		events, err = s.eventRepo.GetLogs(addresses, topics, fromBlock, toBlock, "", includeUnconfirmed, s.maxResults+1)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(events) > s.maxResults {
+		return nil, &LogQueryError{
+			Code:    CodeLimitExceeded,
+			Message: fmt.Sprintf("query returned more than %d results", s.maxResults),
+		}
+	}
+
+	logs := make([]*RPCLog, 0, len(events))
+	for _, e := range events {
+		logs = append(logs, toRPCLog(e))
+	}
+	return logs, nil
+}
+
+// resolveRange converts fromBlock/toBlock tags into block numbers
+func (s *LogService) resolveRange(ctx context.Context, from, to interface{}) (int64, int64, error) {
+	var head int64 = -1
+	resolve := func(tag interface{}) (int64, error) {
+		var value string
+		switch v := tag.(type) {
+		case nil:
+			value = "latest"
+		case string:
+			value = v
+		case float64:
+			return int64(v), nil
+		default:
+			return 0, invalidParams("invalid block tag")
+		}
+
+		switch value {
+		case "earliest":
+			return 0, nil
+		case "latest", "pending", "safe", "finalized":
+			if head < 0 {
+				block, err := s.client.GetNowBlock(ctx, &lindapb.EmptyMessage{})
+				if err != nil {
+					return 0, err
+				}
+				head = block.BlockHeader.RawData.Number
+			}
+			return head, nil
+		}
+
+		if !strings.HasPrefix(value, "0x") {
+			return 0, invalidParams("invalid block number " + value)
+		}
+		num, err := strconv.ParseInt(value[2:], 16, 64)
+		if err != nil || num < 0 {
+			return 0, invalidParams("invalid block number " + value)
+		}
+		return num, nil
+	}
+
+	fromBlock, err := resolve(from)
+	if err != nil {
+		return 0, 0, err
+	}
+	toBlock, err := resolve(to)
+	if err != nil {
+		return 0, 0, err
+	}
+	if fromBlock > toBlock {
+		return 0, 0, invalidParams("fromBlock is greater than toBlock")
+	}
+	return fromBlock, toBlock, nil
+}
+
+// isHeadTag reports whether a block tag resolves to the head of the chain
+func isHeadTag(tag interface{}) bool {
+	switch tag {
+	case nil, "latest", "pending", "safe", "finalized":
+		return true
+	}
+	return false
+}
+
+// parseLogAddresses accepts a single address or a list, in 0x hex or base58
+func parseLogAddresses(value interface{}) ([]string, error) {
+	var raw []interface{}
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		raw = []interface{}{v}
+	case []interface{}:
+		raw = v
+	default:
+		return nil, invalidParams("invalid address filter")
+	}
+
+	addresses := make([]string, 0, len(raw))
+	for _, item := range raw {
+		addr, ok := item.(string)
+		if !ok {
+			return nil, invalidParams("invalid address filter")
+		}
+		if utils.IsValidBase58Address(addr) {
+			addresses = append(addresses, addr)
+			continue
+		}
+		base58Addr, err := utils.HexToBase58(strings.TrimPrefix(strings.ToLower(addr), "0x"))
+		if err != nil {
+			return nil, invalidParams("invalid address " + addr)
+		}
+		addresses = append(addresses, base58Addr)
+	}
+	return addresses, nil
+}
+
+// parseLogTopics returns the OR-set for each topic position
+func parseLogTopics(value interface{}) ([][]string, error) {
+	if value == nil {
+		return nil, nil
+	}
+	positions, ok := value.([]interface{})
+	if !ok {
+		return nil, invalidParams("invalid topics filter")
+	}
+	if len(positions) > 4 {
+		return nil, invalidParams("too many topics, at most 4 are allowed")
+	}
+
+	topics := make([][]string, len(positions))
+	for i, position := range positions {
+		switch v := position.(type) {
+		case nil:
+			// wildcard
+		case string:
+			topic, err := normalizeHash(v)
+			if err != nil {
+				return nil, err
+			}
+			topics[i] = []string{topic}
+		case []interface{}:
+			for _, alt := range v {
+				if alt == nil {
+					// a null alternative matches anything
+					topics[i] = nil
+					break
+				}
+				topic, err := normalizeHash(alt)
+				if err != nil {
+					return nil, err
+				}
+				topics[i] = append(topics[i], topic)
+			}
+		default:
+			return nil, invalidParams("invalid topics filter")
+		}
+	}
+	return topics, nil
+}
+
+// normalizeHash validates a 32 byte hash and returns it as lowercase hex without prefix
+func normalizeHash(value interface{}) (string, error) {
+	s, ok := value.(string)
+	if !ok {
+		return "", invalidParams("invalid hash")
+	}
+	h := strings.TrimPrefix(strings.ToLower(s), "0x")
+	if len(h) != 64 {
+		return "", invalidParams("invalid hash " + s)
+	}
+	if _, err := hex.DecodeString(h); err != nil {
+		return "", invalidParams("invalid hash " + s)
+	}
+	return h, nil
+}
+
+func toRPCLog(e *models.Event) *RPCLog {
+	address := e.ContractAddress
+	if hexAddr, err := utils.Base58ToHex(e.ContractAddress); err == nil && len(hexAddr) == 42 {
+		// Strip the 0x30 prefix byte for EVM style addresses
+		address = hexAddr[2:]
+	}
+
+	var topics []string
+	if len(e.Topics) > 0 {
+		_ = json.Unmarshal(e.Topics, &topics)
+	}
+	rpcTopics := make([]string, 0, len(topics))
+	for _, topic := range topics {
+		rpcTopics = append(rpcTopics, "0x"+topic)
+	}
+
+	return &RPCLog{
+		Address:          "0x" + address,
+		Topics:           rpcTopics,
+		Data:             "0x" + e.Data,
+		BlockNumber:      "0x" + strconv.FormatInt(e.BlockNumber, 16),
+		BlockHash:        "0x" + e.BlockHash,
+		TransactionHash:  "0x" + e.TransactionID,
+		TransactionIndex: "0x" + strconv.FormatInt(int64(e.TransactionIndex), 16),
+		LogIndex:         "0x" + strconv.FormatInt(int64(e.LogIndex), 16),
+		Removed:          false,
+	}
+}
+
+func invalidParams(message string) error {
+	return &LogQueryError{Code: CodeInvalidParams, Message: message}
+}
@@ -1,135 +1,217 @@
-package event
-
-import (
-	"encoding/hex"
-	"math/big"
-
-	"github.com/ethereum/go-ethereum/accounts/abi"
-	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/crypto"
-)
-
-type EventParser struct {
-	// Cache of parsed event ABIs
-	eventSignatures map[string]abi.Event
-}
-
-func NewEventParser() *EventParser {
-	return &EventParser{
-		eventSignatures: make(map[string]abi.Event),
-	}
-}
-
-// ParseEvent parses event topics and data into named parameters
-func (p *EventParser) ParseEvent(topics [][]byte, data []byte) (string, map[string]interface{}, map[string]string) {
-	if len(topics) == 0 {
-		return "", nil, nil
-	}
-
-	// First topic is event signature
-	eventSig := topics[0]
-	eventName := p.getEventName(eventSig)
-
-	// Parse indexed parameters (from topics[1:])
-	indexedParams := make(map[string]interface{})
-	indexedTypes := make(map[string]string)
-
-	// Parse non-indexed parameters (from data)
-	nonIndexedParams := make(map[string]interface{})
-	nonIndexedTypes := make(map[string]string)
-
-	// This is a simplified parser - in production, you'd need the full ABI
-	// For now, we'll handle common event types
-
-	switch eventName {
-	case "Transfer":
-		if len(topics) >= 3 {
-			// Transfer(address indexed from, address indexed to, uint256 value)
-			indexedParams["from"] = common.BytesToAddress(topics[1]).Hex()
-			indexedTypes["from"] = "address"
-			
-			indexedParams["to"] = common.BytesToAddress(topics[2]).Hex()
-			indexedTypes["to"] = "address"
-			
-			if len(data) >= 32 {
-				value := new(big.Int).SetBytes(data[:32])
-				nonIndexedParams["value"] = value.String()
-				nonIndexedTypes["value"] = "uint256"
-			}
-		}
-
-	case "Approval":
-		if len(topics) >= 3 {
-			// Approval(address indexed owner, address indexed spender, uint256 value)
-			indexedParams["owner"] = common.BytesToAddress(topics[1]).Hex()
-			indexedTypes["owner"] = "address"
-			
-			indexedParams["spender"] = common.BytesToAddress(topics[2]).Hex()
-			indexedTypes["spender"] = "address"
-			
-			if len(data) >= 32 {
-				value := new(big.Int).SetBytes(data[:32])
-				nonIndexedParams["value"] = value.String()
-				nonIndexedTypes["value"] = "uint256"
-			}
-		}
-
-	default:
-		// Generic parsing
-		for i, topic := range topics[1:] {
-			paramName := "topic" + string(rune(i))
-			indexedParams[paramName] = hex.EncodeToString(topic)
-			indexedTypes[paramName] = "bytes32"
-		}
-		
-		if len(data) > 0 {
-			nonIndexedParams["data"] = hex.EncodeToString(data)
-			nonIndexedTypes["data"] = "bytes"
-		}
-	}
-
-	// Merge indexed and non-indexed parameters
-	result := make(map[string]interface{})
-	resultTypes := make(map[string]string)
-
-	for k, v := range indexedParams {
-		result[k] = v
-		resultTypes[k] = indexedTypes[k]
-	}
-	for k, v := range nonIndexedParams {
-		result[k] = v
-		resultTypes[k] = nonIndexedTypes[k]
-	}
-
-	return eventName, result, resultTypes
-}
-
-// getEventName attempts to get event name from signature hash
-func (p *EventParser) getEventName(signatureHash []byte) string {
-	// Common event signatures
-	commonEvents := map[string]string{
-		crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)")).Hex(): "Transfer",
-		crypto.Keccak256Hash([]byte("Approval(address,address,uint256)")).Hex():  "Approval",
-	}
-
-	hashHex := "0x" + hex.EncodeToString(signatureHash)
-	if name, ok := commonEvents[hashHex]; ok {
-		return name
-	}
-
-	return "UnknownEvent"
-}
-
-// DecodeEventData decodes event data using ABI
-func (p *EventParser) DecodeEventData(eventABI abi.Event, data []byte) (map[string]interface{}, error) {
-	// This would use the actual ABI to decode parameters
-	// For now, return empty map
-	return make(map[string]interface{}), nil
-}
-
-// EncodeEventTopics encodes indexed parameters into topics
-func (p *EventParser) EncodeEventTopics(eventABI abi.Event, params map[string]interface{}) ([][]byte, error) {
-	// This would encode indexed parameters according to ABI
-	// For now, return empty topics
-	return [][]byte{}, nil
+package event
+
+import (
+	"encoding/hex"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+type EventParser struct {
+	// Cache of parsed event ABIs
+	eventSignatures map[string]abi.Event
+}
+
+func NewEventParser() *EventParser {
+	return &EventParser{
+		eventSignatures: make(map[string]abi.Event),
+	}
+}
+
+// ParseEvent parses event topics and data into named parameters
+func (p *EventParser) ParseEvent(topics [][]byte, data []byte) (string, map[string]interface{}, map[string]string) {
+	if len(topics) == 0 {
+		return "", nil, nil
+	}
+
+	// First topic is event signature
+	eventSig := topics[0]
+	eventName := p.getEventName(eventSig)
+
+	// Parse indexed parameters (from topics[1:])
+	indexedParams := make(map[string]interface{})
+	indexedTypes := make(map[string]string)
+
+	// Parse non-indexed parameters (from data)
+	nonIndexedParams := make(map[string]interface{})
+	nonIndexedTypes := make(map[string]string)
+
+	// This is a simplified parser - in production, you'd need the full ABI
+	// For now, we'll handle common event types
+
+	switch eventName {
+	case "Transfer":
+		if len(topics) >= 4 {
+			// LRC-721 Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+			indexedParams["from"] = common.BytesToAddress(topics[1]).Hex()
+			indexedTypes["from"] = "address"
+
+			indexedParams["to"] = common.BytesToAddress(topics[2]).Hex()
+			indexedTypes["to"] = "address"
+
+			indexedParams["tokenId"] = new(big.Int).SetBytes(topics[3]).String()
+			indexedTypes["tokenId"] = "uint256"
+		} else if len(topics) >= 3 {
+			// Transfer(address indexed from, address indexed to, uint256 value)
+			indexedParams["from"] = common.BytesToAddress(topics[1]).Hex()
+			indexedTypes["from"] = "address"
+			
+			indexedParams["to"] = common.BytesToAddress(topics[2]).Hex()
+			indexedTypes["to"] = "address"
+			
+			if len(data) >= 32 {
+				value := new(big.Int).SetBytes(data[:32])
+				nonIndexedParams["value"] = value.String()
+				nonIndexedTypes["value"] = "uint256"
+			}
+		}
+
+	case "TransferSingle":
+		if len(topics) >= 4 {
+			// TransferSingle(address indexed operator, address indexed from, address indexed to, uint256 id, uint256 value)
+			indexedParams["operator"] = common.BytesToAddress(topics[1]).Hex()
+			indexedTypes["operator"] = "address"
+
+			indexedParams["from"] = common.BytesToAddress(topics[2]).Hex()
+			indexedTypes["from"] = "address"
+
+			indexedParams["to"] = common.BytesToAddress(topics[3]).Hex()
+			indexedTypes["to"] = "address"
+
+			if len(data) >= 64 {
+				nonIndexedParams["id"] = new(big.Int).SetBytes(data[:32]).String()
+				nonIndexedTypes["id"] = "uint256"
+
+				nonIndexedParams["value"] = new(big.Int).SetBytes(data[32:64]).String()
+				nonIndexedTypes["value"] = "uint256"
+			}
+		}
+
+	case "TransferBatch":
+		if len(topics) >= 4 {
+			// TransferBatch(address indexed operator, address indexed from, address indexed to, uint256[] ids, uint256[] values)
+			indexedParams["operator"] = common.BytesToAddress(topics[1]).Hex()
+			indexedTypes["operator"] = "address"
+
+			indexedParams["from"] = common.BytesToAddress(topics[2]).Hex()
+			indexedTypes["from"] = "address"
+
+			indexedParams["to"] = common.BytesToAddress(topics[3]).Hex()
+			indexedTypes["to"] = "address"
+
+			if len(data) >= 64 {
+				ids, idsOK := decodeUint256Array(data, 0)
+				values, valuesOK := decodeUint256Array(data, 32)
+				if idsOK && valuesOK && len(ids) == len(values) {
+					nonIndexedParams["ids"] = ids
+					nonIndexedTypes["ids"] = "uint256[]"
+
+					nonIndexedParams["values"] = values
+					nonIndexedTypes["values"] = "uint256[]"
+				}
+			}
+		}
+
+	case "Approval":
+		if len(topics) >= 3 {
+			// Approval(address indexed owner, address indexed spender, uint256 value)
+			indexedParams["owner"] = common.BytesToAddress(topics[1]).Hex()
+			indexedTypes["owner"] = "address"
+			
+			indexedParams["spender"] = common.BytesToAddress(topics[2]).Hex()
+			indexedTypes["spender"] = "address"
+			
+			if len(data) >= 32 {
+				value := new(big.Int).SetBytes(data[:32])
+				nonIndexedParams["value"] = value.String()
+				nonIndexedTypes["value"] = "uint256"
+			}
+		}
+
+	default:
+		// Generic parsing
+		for i, topic := range topics[1:] {
+			paramName := "topic" + string(rune(i))
+			indexedParams[paramName] = hex.EncodeToString(topic)
+			indexedTypes[paramName] = "bytes32"
+		}
+		
+		if len(data) > 0 {
+			nonIndexedParams["data"] = hex.EncodeToString(data)
+			nonIndexedTypes["data"] = "bytes"
+		}
+	}
+
+	// Merge indexed and non-indexed parameters
+	result := make(map[string]interface{})
+	resultTypes := make(map[string]string)
+
+	for k, v := range indexedParams {
+		result[k] = v
+		resultTypes[k] = indexedTypes[k]
+	}
+	for k, v := range nonIndexedParams {
+		result[k] = v
+		resultTypes[k] = nonIndexedTypes[k]
+	}
+
+	return eventName, result, resultTypes
+}
+
+// getEventName attempts to get event name from signature hash
+func (p *EventParser) getEventName(signatureHash []byte) string {
+	// Common event signatures
+	commonEvents := map[string]string{
+		crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)")).Hex(): "Transfer",
+		crypto.Keccak256Hash([]byte("Approval(address,address,uint256)")).Hex():  "Approval",
+		crypto.Keccak256Hash([]byte("TransferSingle(address,address,address,uint256,uint256)")).Hex():     "TransferSingle",
+		crypto.Keccak256Hash([]byte("TransferBatch(address,address,address,uint256[],uint256[])")).Hex(): "TransferBatch",
+	}
+
+	hashHex := "0x" + hex.EncodeToString(signatureHash)
+	if name, ok := commonEvents[hashHex]; ok {
+		return name
+	}
+
+	return "UnknownEvent"
+}
+
+// decodeUint256Array decodes a dynamic uint256[] whose offset is stored at
+// the given head position of the ABI encoded data
+func decodeUint256Array(data []byte, head int) ([]string, bool) {
+	if len(data) < head+32 {
+		return nil, false
+	}
+	offset := new(big.Int).SetBytes(data[head : head+32])
+	if !offset.IsUint64() || offset.Uint64()+32 > uint64(len(data)) {
+		return nil, false
+	}
+	start := int(offset.Uint64())
+	length := new(big.Int).SetBytes(data[start : start+32])
+	if !length.IsUint64() || length.Uint64() > uint64(len(data)-start-32)/32 {
+		return nil, false
+	}
+
+	items := make([]string, 0, length.Uint64())
+	for i := 0; i < int(length.Uint64()); i++ {
+		pos := start + 32 + i*32
+		items = append(items, new(big.Int).SetBytes(data[pos:pos+32]).String())
+	}
+	return items, true
+}
+
+// DecodeEventData decodes event data using ABI
+func (p *EventParser) DecodeEventData(eventABI abi.Event, data []byte) (map[string]interface{}, error) {
+	// This would use the actual ABI to decode parameters
+	// For now, return empty map
+	return make(map[string]interface{}), nil
+}
+
+// EncodeEventTopics encodes indexed parameters into topics
+func (p *EventParser) EncodeEventTopics(eventABI abi.Event, params map[string]interface{}) ([][]byte, error) {
+	// This would encode indexed parameters according to ABI
+	// For now, return empty topics
+	return [][]byte{}, nil
 }
\ No newline at end of file
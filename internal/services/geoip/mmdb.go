@@ -0,0 +1,340 @@
+package geoip
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"net"
+	"os"
+)
+
+// metadataMarker precedes the metadata map at the end of a MaxMind DB file
+var metadataMarker = []byte("\xAB\xCD\xEFMaxMind.com")
+
+// maxDecodeDepth bounds the nesting of maps, arrays and pointers a value may
+// have, so a crafted file cannot exhaust the stack
+const maxDecodeDepth = 512
+
+// dataSectionSeparator is the run of zero bytes between the search tree and the data section
+const dataSectionSeparator = 16
+
+// MaxMind DB data types
+const (
+	typeExtended  = 0
+	typePointer   = 1
+	typeString    = 2
+	typeDouble    = 3
+	typeBytes     = 4
+	typeUint16    = 5
+	typeUint32    = 6
+	typeMap       = 7
+	typeInt32     = 8
+	typeUint64    = 9
+	typeUint128   = 10
+	typeArray     = 11
+	typeContainer = 12
+	typeEndMarker = 13
+	typeBool      = 14
+	typeFloat     = 15
+)
+
+// Database is a MaxMind DB (.mmdb) file loaded into memory, such as
+// GeoLite2-City or GeoLite2-ASN
+type Database struct {
+	buffer       []byte
+	data         []byte
+	nodeCount    uint
+	recordSize   uint
+	ipVersion    uint
+	ipv4Start    uint
+	DatabaseType string
+	BuildEpoch   uint64
+}
+
+// OpenDatabase reads a MaxMind DB file
+func OpenDatabase(path string) (*Database, error) {
+	buffer, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	start := bytes.LastIndex(buffer, metadataMarker)
+	if start < 0 {
+		return nil, fmt.Errorf("%s is not a MaxMind DB file", path)
+	}
+
+	d := &decoder{buffer: buffer[start+len(metadataMarker):]}
+	value, _, err := d.decode(0, 0)
+	if err != nil {
+		return nil, fmt.Errorf("invalid MaxMind DB metadata: %w", err)
+	}
+	metadata, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("invalid MaxMind DB metadata")
+	}
+
+	db := &Database{
+		buffer:     buffer,
+		nodeCount:  uint(metadataUint(metadata, "node_count")),
+		recordSize: uint(metadataUint(metadata, "record_size")),
+		ipVersion:  uint(metadataUint(metadata, "ip_version")),
+		BuildEpoch: metadataUint(metadata, "build_epoch"),
+	}
+	db.DatabaseType, _ = metadata["database_type"].(string)
+
+	switch db.recordSize {
+	case 24, 28, 32:
+	default:
+		return nil, fmt.Errorf("unsupported MaxMind DB record size %d", db.recordSize)
+	}
+
+	treeSize := db.nodeCount * db.recordSize / 4
+	if treeSize+dataSectionSeparator > uint(start) {
+		return nil, errors.New("invalid MaxMind DB search tree size")
+	}
+	db.data = buffer[treeSize+dataSectionSeparator : start]
+
+	// IPv4 addresses are looked up under ::/96 in IPv6 databases
+	if db.ipVersion == 6 {
+		node := uint(0)
+		for i := 0; i < 96 && node < db.nodeCount; i++ {
+			node = db.readRecord(node, 0)
+		}
+		db.ipv4Start = node
+	}
+
+	return db, nil
+}
+
+// Lookup returns the record of the network containing ip, nil when there is none
+func (db *Database) Lookup(ip net.IP) (map[string]interface{}, error) {
+	node, bits := uint(0), ip.To4()
+	if bits != nil {
+		node = db.ipv4Start
+	} else if db.ipVersion == 6 {
+		bits = ip.To16()
+	}
+	if bits == nil {
+		return nil, fmt.Errorf("cannot look up %s in an IPv%d database", ip, db.ipVersion)
+	}
+
+	for i := 0; i < len(bits)*8 && node < db.nodeCount; i++ {
+		bit := uint(bits[i>>3]>>(7-uint(i&7))) & 1
+		node = db.readRecord(node, bit)
+	}
+
+	if node == db.nodeCount {
+		return nil, nil
+	}
+	if node < db.nodeCount {
+		return nil, errors.New("invalid MaxMind DB search tree")
+	}
+
+	offset := node - db.nodeCount - dataSectionSeparator
+	if offset >= uint(len(db.data)) {
+		return nil, errors.New("invalid MaxMind DB data pointer")
+	}
+
+	d := &decoder{buffer: db.data}
+	value, _, err := d.decode(offset, 0)
+	if err != nil {
+		return nil, err
+	}
+	record, _ := value.(map[string]interface{})
+	return record, nil
+}
+
+// readRecord reads the left (bit 0) or right (bit 1) record of a search tree node
+func (db *Database) readRecord(node, bit uint) uint {
+	switch db.recordSize {
+	case 24:
+		b := db.buffer[node*6+bit*3:]
+		return uint(b[0])<<16 | uint(b[1])<<8 | uint(b[2])
+	case 28:
+		b := db.buffer[node*7:]
+		if bit == 0 {
+			return uint(b[3]&0xF0)<<20 | uint(b[0])<<16 | uint(b[1])<<8 | uint(b[2])
+		}
+		return uint(b[3]&0x0F)<<24 | uint(b[4])<<16 | uint(b[5])<<8 | uint(b[6])
+	default:
+		return uint(binary.BigEndian.Uint32(db.buffer[node*8+bit*4:]))
+	}
+}
+
+// decoder decodes values of the MaxMind DB data section format. Pointers are
+// offsets from the start of buffer.
+type decoder struct {
+	buffer []byte
+}
+
+// decode decodes the value at offset, nested depth maps, arrays and pointers
+// deep, and returns it with the offset following it
+func (d *decoder) decode(offset, depth uint) (interface{}, uint, error) {
+	if depth > maxDecodeDepth {
+		return nil, 0, errors.New("MaxMind DB data nested too deeply")
+	}
+	kind, size, offset, err := d.decodeControl(offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if kind == typePointer {
+		pointer, next, err := d.decodePointer(size, offset)
+		if err != nil {
+			return nil, 0, err
+		}
+		value, _, err := d.decode(pointer, depth+1)
+		return value, next, err
+	}
+
+	// Every entry takes at least one byte, larger sizes are not allocated
+	if (kind == typeMap || kind == typeArray) && size > uint(len(d.buffer))-offset {
+		return nil, 0, errors.New("unexpected end of MaxMind DB data")
+	}
+
+	switch kind {
+	case typeMap:
+		record := make(map[string]interface{}, size)
+		for i := uint(0); i < size; i++ {
+			key, next, err := d.decode(offset, depth+1)
+			if err != nil {
+				return nil, 0, err
+			}
+			name, ok := key.(string)
+			if !ok {
+				return nil, 0, errors.New("invalid MaxMind DB map key")
+			}
+			if record[name], offset, err = d.decode(next, depth+1); err != nil {
+				return nil, 0, err
+			}
+		}
+		return record, offset, nil
+	case typeArray:
+		values := make([]interface{}, size)
+		for i := range values {
+			if values[i], offset, err = d.decode(offset, depth+1); err != nil {
+				return nil, 0, err
+			}
+		}
+		return values, offset, nil
+	case typeBool:
+		return size != 0, offset, nil
+	case typeContainer, typeEndMarker:
+		return nil, offset, nil
+	}
+
+	end := offset + size
+	if end > uint(len(d.buffer)) {
+		return nil, 0, errors.New("unexpected end of MaxMind DB data")
+	}
+	b := d.buffer[offset:end]
+
+	switch kind {
+	case typeString:
+		return string(b), end, nil
+	case typeBytes:
+		return append([]byte(nil), b...), end, nil
+	case typeDouble:
+		if size != 8 {
+			return nil, 0, errors.New("invalid MaxMind DB double")
+		}
+		return math.Float64frombits(binary.BigEndian.Uint64(b)), end, nil
+	case typeFloat:
+		if size != 4 {
+			return nil, 0, errors.New("invalid MaxMind DB float")
+		}
+		return float64(math.Float32frombits(binary.BigEndian.Uint32(b))), end, nil
+	case typeUint16, typeUint32, typeUint64:
+		var value uint64
+		for _, c := range b {
+			value = value<<8 | uint64(c)
+		}
+		return value, end, nil
+	case typeInt32:
+		var value uint32
+		for _, c := range b {
+			value = value<<8 | uint32(c)
+		}
+		return int64(int32(value)), end, nil
+	case typeUint128:
+		return new(big.Int).SetBytes(b), end, nil
+	}
+
+	return nil, 0, fmt.Errorf("unknown MaxMind DB data type %d", kind)
+}
+
+// decodeControl reads the control byte of a value and returns its type and size
+// and the offset of its payload
+func (d *decoder) decodeControl(offset uint) (kind, size, next uint, err error) {
+	if offset >= uint(len(d.buffer)) {
+		return 0, 0, 0, errors.New("unexpected end of MaxMind DB data")
+	}
+	control := d.buffer[offset]
+	offset++
+
+	kind = uint(control >> 5)
+	if kind == typePointer {
+		return kind, uint(control & 0x1F), offset, nil
+	}
+	if kind == typeExtended {
+		if offset >= uint(len(d.buffer)) {
+			return 0, 0, 0, errors.New("unexpected end of MaxMind DB data")
+		}
+		kind = 7 + uint(d.buffer[offset])
+		offset++
+	}
+
+	size = uint(control & 0x1F)
+	if size >= 29 {
+		extra := size - 28
+		if offset+extra > uint(len(d.buffer)) {
+			return 0, 0, 0, errors.New("unexpected end of MaxMind DB data")
+		}
+		var value uint
+		for _, c := range d.buffer[offset : offset+extra] {
+			value = value<<8 | uint(c)
+		}
+		switch size {
+		case 29:
+			size = 29 + value
+		case 30:
+			size = 285 + value
+		default:
+			size = 65821 + value
+		}
+		offset += extra
+	}
+	return kind, size, offset, nil
+}
+
+// decodePointer reads a pointer whose control byte carried the bits in size
+func (d *decoder) decodePointer(size, offset uint) (uint, uint, error) {
+	length := (size>>3)&0x3 + 1
+	if offset+length > uint(len(d.buffer)) {
+		return 0, 0, errors.New("unexpected end of MaxMind DB data")
+	}
+
+	var value uint
+	if length < 4 {
+		value = size & 0x7
+	}
+	for _, c := range d.buffer[offset : offset+length] {
+		value = value<<8 | uint(c)
+	}
+
+	switch length {
+	case 2:
+		value += 2048
+	case 3:
+		value += 526336
+	}
+	return value, offset + length, nil
+}
+
+func metadataUint(metadata map[string]interface{}, key string) uint64 {
+	value, _ := metadata[key].(uint64)
+	return value
+}
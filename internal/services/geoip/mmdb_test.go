@@ -0,0 +1,73 @@
+package geoip
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+// nestedArrays encodes depth arrays of one element around a uint16
+func nestedArrays(depth int) []byte {
+	data := bytes.Repeat([]byte{0x01, 0x04}, depth)
+	return append(data, 0xA1, 0x05)
+}
+
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+		want interface{}
+	}{
+		{"string", []byte{0x43, 'a', 'b', 'c'}, "abc"},
+		{"uint16", []byte{0xA2, 0x01, 0x02}, uint64(0x0102)},
+		{"bool", []byte{0x01, 0x07}, true},
+		{"map", []byte{0xE1, 0x41, 'a', 0xA1, 0x05}, map[string]interface{}{"a": uint64(5)}},
+		{"array", []byte{0x02, 0x04, 0x41, 'a', 0x41, 'b'}, []interface{}{"a", "b"}},
+		{"pointer", []byte{0x20, 0x02, 0x41, 'a'}, "a"},
+		{"nested", nestedArrays(3), []interface{}{[]interface{}{[]interface{}{uint64(5)}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &decoder{buffer: tt.data}
+			got, _, err := d.decode(0, 0)
+			if err != nil {
+				t.Fatalf("decode: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("decode = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodeMalformed(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"pointer to itself", []byte{0x20, 0x00}},
+		{"pointers to each other", []byte{0x20, 0x02, 0x20, 0x00}},
+		{"array containing itself", []byte{0x01, 0x04, 0x20, 0x00}},
+		{"nested too deeply", nestedArrays(maxDecodeDepth + 1)},
+		{"map larger than data", []byte{0xFF, 0xFF, 0xFF, 0xFF}},
+		{"array larger than data", []byte{0x1F, 0x04, 0xFF, 0xFF, 0xFF}},
+		{"truncated string", []byte{0x45, 'a'}},
+		{"truncated pointer", []byte{0x28}},
+		{"non-string map key", []byte{0xE1, 0xA1, 0x05, 0xA1, 0x05}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &decoder{buffer: tt.data}
+			if value, _, err := d.decode(0, 0); err == nil {
+				t.Errorf("decode = %#v, want an error", value)
+			}
+		})
+	}
+}
+
+func TestDecodeDepthLimit(t *testing.T) {
+	d := &decoder{buffer: nestedArrays(maxDecodeDepth)}
+	if _, _, err := d.decode(0, 0); err != nil {
+		t.Errorf("decode at the depth limit: %v", err)
+	}
+}
@@ -0,0 +1,182 @@
+package geoip
+
+import (
+	"context"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/lindaprotocol/grpc-api-gateway/internal/config"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/storage/repository"
+	"github.com/sirupsen/logrus"
+)
+
+// hostingProviders are substrings of the AS organization names of cloud and
+// hosting providers, nodes in their networks are reported as hosted
+var hostingProviders = []string{
+	"amazon", "google", "microsoft", "alibaba", "tencent", "oracle", "digitalocean",
+	"hetzner", "ovh", "linode", "akamai", "vultr", "contabo", "choopa", "scaleway",
+	"leaseweb", "hostinger", "ionos", "huawei cloud",
+}
+
+// Resolver resolves IP addresses to their location and autonomous system from
+// local MaxMind City and ASN databases, caching the results in Postgres
+type Resolver struct {
+	city     *Database
+	asn      *Database
+	nodeRepo *repository.NodeRepository
+	logger   *logrus.Logger
+}
+
+// NewResolver opens the configured MaxMind databases. A database that cannot
+// be opened is logged and left out, lookups then only return cached records.
+func NewResolver(cfg config.IPGeoConfig, nodeRepo *repository.NodeRepository) *Resolver {
+	r := &Resolver{
+		nodeRepo: nodeRepo,
+		logger:   logrus.New(),
+	}
+
+	var err error
+	if cfg.MaxMindDBPath != "" {
+		if r.city, err = OpenDatabase(cfg.MaxMindDBPath); err != nil {
+			r.logger.WithError(err).Warn("Failed to open MaxMind City database")
+		}
+	}
+	if cfg.MaxMindASNDBPath != "" {
+		if r.asn, err = OpenDatabase(cfg.MaxMindASNDBPath); err != nil {
+			r.logger.WithError(err).Warn("Failed to open MaxMind ASN database")
+		}
+	}
+
+	return r
+}
+
+// Resolve returns the GeoIP records of IP addresses, keyed by IP. Addresses
+// without a cached record from the current database are looked up and cached.
+func (r *Resolver) Resolve(ips []string) (map[string]*models.GeoIPRecord, error) {
+	records, err := r.nodeRepo.GetGeoIPRecords(ips)
+	if err != nil {
+		return nil, err
+	}
+	if r.city == nil && r.asn == nil {
+		return records, nil
+	}
+
+	var resolved []*models.GeoIPRecord
+	for _, ip := range ips {
+		if cached, ok := records[ip]; ok && cached.DatabaseBuild == r.build() {
+			continue
+		}
+		record, err := r.lookup(ip)
+		if err != nil {
+			r.logger.WithError(err).WithField("ip", ip).Debug("GeoIP lookup failed")
+			continue
+		}
+		records[ip] = record
+		resolved = append(resolved, record)
+	}
+
+	if err := r.nodeRepo.SaveGeoIPRecords(resolved); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// ResolveHost returns the IP address of a node host, looking up host names in DNS
+func ResolveHost(ctx context.Context, host string) string {
+	if ip := net.ParseIP(host); ip != nil {
+		return ip.String()
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil || len(addrs) == 0 {
+		return ""
+	}
+	return addrs[0].IP.String()
+}
+
+// lookup resolves an IP address in the MaxMind databases
+func (r *Resolver) lookup(ip string) (*models.GeoIPRecord, error) {
+	record := &models.GeoIPRecord{
+		IP:            ip,
+		DatabaseBuild: r.build(),
+		ResolvedAt:    time.Now(),
+	}
+
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return record, nil
+	}
+
+	if r.city != nil {
+		city, err := r.city.Lookup(parsed)
+		if err != nil {
+			return nil, err
+		}
+		record.CountryCode, _ = field(city, "country", "iso_code").(string)
+		record.Country = englishName(field(city, "country", "names"))
+		record.City = englishName(field(city, "city", "names"))
+		record.Latitude, _ = field(city, "location", "latitude").(float64)
+		record.Longitude, _ = field(city, "location", "longitude").(float64)
+	}
+
+	if r.asn != nil {
+		asn, err := r.asn.Lookup(parsed)
+		if err != nil {
+			return nil, err
+		}
+		record.ASN, _ = field(asn, "autonomous_system_number").(uint64)
+		record.ASOrganization, _ = field(asn, "autonomous_system_organization").(string)
+		record.Hosting = isHostingProvider(record.ASOrganization)
+	}
+
+	return record, nil
+}
+
+// build returns the build epoch of the City database, or of the ASN database without one
+func (r *Resolver) build() uint64 {
+	if r.city != nil {
+		return r.city.BuildEpoch
+	}
+	if r.asn != nil {
+		return r.asn.BuildEpoch
+	}
+	return 0
+}
+
+// field reads a value nested in maps of a MaxMind record
+func field(record map[string]interface{}, path ...string) interface{} {
+	var value interface{} = record
+	for _, key := range path {
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		value = m[key]
+	}
+	return value
+}
+
+// englishName reads the English entry of a MaxMind names map
+func englishName(names interface{}) string {
+	m, _ := names.(map[string]interface{})
+	name, _ := m["en"].(string)
+	return name
+}
+
+func isHostingProvider(organization string) bool {
+	organization = strings.ToLower(organization)
+	if organization == "" {
+		return false
+	}
+	for _, provider := range hostingProviders {
+		if strings.Contains(organization, provider) {
+			return true
+		}
+	}
+	return false
+}
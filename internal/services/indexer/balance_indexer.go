@@ -0,0 +1,199 @@
+// internal/services/indexer/balance_indexer.go
+package indexer
+
+import (
+	"context"
+	"encoding/hex"
+	"strconv"
+
+	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
+	"github.com/lindaprotocol/grpc-api-gateway/pkg/lindapb"
+	"github.com/lindaprotocol/grpc-api-gateway/pkg/utils"
+)
+
+// BalanceIndexer struct: Indexer for native LIND balance deltas. LRC-10 and
+// LRC-20 deltas are recorded by the token repository with their transfers.
+type BalanceIndexer struct {
+	indexer *Indexer
+}
+
+// NewBalanceIndexer creates a new balance indexer
+func NewBalanceIndexer(indexer *Indexer) *BalanceIndexer {
+	return &BalanceIndexer{
+		indexer: indexer,
+	}
+}
+
+// IndexTransaction records the LIND moved by the contract of a successful
+// transaction, callValue is the call_value of smart contract calls and
+// frozenBalance the amount staked by freeze contracts
+func (bi *BalanceIndexer) IndexTransaction(ctx context.Context, tx *models.Transaction, callValue, frozenBalance int64) error {
+	txID := hex.EncodeToString([]byte(tx.Hash))
+
+	var deltas []*models.BalanceDelta
+	switch tx.ContractType {
+	case models.ContractTypeTransfer, models.ContractTypeParticipateAssetIssue:
+		// Participations pay amount LIND to the issuer for the asset
+		deltas = bi.move(tx, txID, tx.FromAddress, tx.ToAddress, tx.Amount)
+	case models.ContractTypeTriggerSmartContract:
+		deltas = bi.move(tx, txID, tx.FromAddress, tx.ContractAddress, callValue)
+	case models.ContractTypeFreezeBalance, models.ContractTypeFreezeBalanceV2:
+		deltas = bi.move(tx, txID, tx.FromAddress, "", frozenBalance)
+	}
+
+	return bi.save(ctx, models.BalanceDeltaContract, deltas)
+}
+
+// IndexTransactionInfo records the LIND changes only known from the receipt
+// of a transaction: the fee burnt, stake and reward withdrawals, and the
+// call values of internal transactions
+func (bi *BalanceIndexer) IndexTransactionInfo(ctx context.Context, info *lindapb.TransactionInfo) error {
+	tx, err := bi.indexer.txRepo.GetByHash(string(info.Id))
+	if err != nil {
+		return err
+	}
+	txID := hex.EncodeToString(info.Id)
+
+	deltas := bi.move(tx, txID, tx.FromAddress, "", info.Fee)
+	deltas = append(deltas, bi.move(tx, txID, "", tx.FromAddress, info.UnfreezeAmount+info.WithdrawAmount+info.WithdrawExpireAmount)...)
+
+	for _, itx := range bi.indexer.txIndexer.ExtractInternalTransactions(info) {
+		if itx.Rejected {
+			continue
+		}
+		for _, value := range itx.CallValueInfo {
+			// Call values with a token ID move LRC-10 tokens, not LIND
+			if value.TokenID != "" {
+				continue
+			}
+			deltas = append(deltas, bi.move(tx, txID, itx.CallerAddress, itx.TransferToAddress, value.CallValue)...)
+		}
+	}
+
+	return bi.save(ctx, models.BalanceDeltaReceipt, deltas)
+}
+
+// save records deltas of a kind, preceded by the opening balance of the
+// addresses they are the first deltas of
+func (bi *BalanceIndexer) save(ctx context.Context, kind string, deltas []*models.BalanceDelta) error {
+	for _, delta := range deltas {
+		delta.Kind = kind
+	}
+
+	// Addresses already had their balance when indexing starts above genesis
+	if bi.indexer.config.StartBlock > 0 {
+		openings, err := bi.openingBalances(ctx, deltas)
+		if err != nil {
+			return err
+		}
+		deltas = append(openings, deltas...)
+	}
+	return bi.indexer.balanceRepo.SaveDeltas(deltas)
+}
+
+// openingBalanceWindow is the number of blocks below the solidified head in
+// which an address first seen is seeded with the balance of the node
+const openingBalanceWindow = 20
+
+// openingBalances returns an opening delta for each address without recorded
+// deltas, marking where its indexed history starts. The node only returns the
+// latest balance, less the deltas being recorded it is the balance the address
+// had before them when it is first seen near the head, and the opening seeds it
+// before the first indexed block. Addresses first seen further back have no
+// known balance before their first delta, their opening is zero at its block.
+func (bi *BalanceIndexer) openingBalances(ctx context.Context, deltas []*models.BalanceDelta) ([]*models.BalanceDelta, error) {
+	if len(deltas) == 0 {
+		return nil, nil
+	}
+	first := deltas[0]
+	seed := first.BlockNumber >= bi.indexer.solidified()-openingBalanceWindow
+
+	changes := make(map[string]int64)
+	var addresses []string
+	for _, delta := range deltas {
+		amount, err := strconv.ParseInt(delta.Delta, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := changes[delta.Address]; !ok {
+			addresses = append(addresses, delta.Address)
+		}
+		changes[delta.Address] += amount
+	}
+
+	var openings []*models.BalanceDelta
+	for _, address := range addresses {
+		recorded, err := bi.indexer.balanceRepo.HasDeltas(address, models.AssetLIND)
+		if err != nil {
+			return nil, err
+		}
+		if recorded {
+			continue
+		}
+
+		opening := &models.BalanceDelta{
+			Address:        address,
+			Asset:          models.AssetLIND,
+			BlockNumber:    first.BlockNumber,
+			BlockTimestamp: first.BlockTimestamp,
+			Kind:           models.BalanceDeltaOpening,
+			Delta:          "0",
+		}
+		if seed {
+			balance, err := bi.nodeBalance(ctx, address)
+			if err != nil {
+				return nil, err
+			}
+			opening.BlockNumber = bi.indexer.config.StartBlock - 1
+			opening.BlockTimestamp = 0
+			opening.Delta = strconv.FormatInt(balance-changes[address], 10)
+		}
+		openings = append(openings, opening)
+	}
+	return openings, nil
+}
+
+// nodeBalance returns the latest LIND balance of an address on the node
+func (bi *BalanceIndexer) nodeBalance(ctx context.Context, address string) (int64, error) {
+	hexAddr, err := utils.Base58ToHex(address)
+	if err != nil {
+		return 0, err
+	}
+	rawAddr, err := hex.DecodeString(hexAddr)
+	if err != nil {
+		return 0, err
+	}
+	account, err := bi.indexer.blockchainClient.GetAccount(ctx, &lindapb.Account{Address: rawAddr})
+	if err != nil {
+		return 0, err
+	}
+	return account.Balance, nil
+}
+
+// move returns the deltas moving amount sun of LIND between two addresses, an
+// empty address is outside of any balance (burnt, staked or withdrawn)
+func (bi *BalanceIndexer) move(tx *models.Transaction, txID, from, to string, amount int64) []*models.BalanceDelta {
+	if amount <= 0 {
+		return nil
+	}
+
+	deltas := make([]*models.BalanceDelta, 0, 2)
+	if from != "" {
+		deltas = append(deltas, bi.delta(tx, txID, from, -amount))
+	}
+	if to != "" {
+		deltas = append(deltas, bi.delta(tx, txID, to, amount))
+	}
+	return deltas
+}
+
+func (bi *BalanceIndexer) delta(tx *models.Transaction, txID, address string, amount int64) *models.BalanceDelta {
+	return &models.BalanceDelta{
+		Address:        address,
+		Asset:          models.AssetLIND,
+		BlockNumber:    tx.BlockNumber,
+		BlockTimestamp: tx.BlockTimestamp,
+		TransactionID:  txID,
+		Delta:          strconv.FormatInt(amount, 10),
+	}
+}
@@ -0,0 +1,98 @@
+// internal/services/indexer/contract_indexer.go
+package indexer
+
+import (
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
+	"github.com/lindaprotocol/grpc-api-gateway/pkg/lindapb"
+)
+
+// pendingTrigger is a smart contract call waiting for the receipt that
+// carries its energy usage and result
+type pendingTrigger struct {
+	tx        *models.Transaction
+	contract  string
+	caller    string
+	callValue int64
+}
+
+// ContractIndexer struct: Indexer for smart contract calls and the daily per-contract statistics
+// rolled up from them
+type ContractIndexer struct {
+	indexer *Indexer
+
+	mu      sync.Mutex
+	pending map[string]*pendingTrigger // by transaction ID
+}
+
+// NewContractIndexer creates a new contract indexer
+func NewContractIndexer(indexer *Indexer) *ContractIndexer {
+	return &ContractIndexer{
+		indexer: indexer,
+		pending: make(map[string]*pendingTrigger),
+	}
+}
+
+// IndexTransaction keeps a smart contract call, successful or not, until its receipt is indexed
+func (ci *ContractIndexer) IndexTransaction(tx *models.Transaction, trigger *triggerSmartContract) {
+	if trigger == nil || len(trigger.ContractAddress) == 0 {
+		return
+	}
+	ci.mu.Lock()
+	ci.pending[hex.EncodeToString([]byte(tx.Hash))] = &pendingTrigger{
+		tx:        tx,
+		contract:  addressBase58(trigger.ContractAddress),
+		caller:    addressBase58(trigger.OwnerAddress),
+		callValue: trigger.CallValue,
+	}
+	ci.mu.Unlock()
+}
+
+// Reset drops the calls of a block whose receipts could not be fetched
+func (ci *ContractIndexer) Reset() {
+	ci.mu.Lock()
+	ci.pending = make(map[string]*pendingTrigger)
+	ci.mu.Unlock()
+}
+
+// IndexTransactionInfo records a pending call with the energy charged to its
+// caller and to the contract owner and adds it to the daily rollups
+func (ci *ContractIndexer) IndexTransactionInfo(info *lindapb.TransactionInfo) error {
+	txID := hex.EncodeToString(info.Id)
+
+	ci.mu.Lock()
+	pending, ok := ci.pending[txID]
+	delete(ci.pending, txID)
+	ci.mu.Unlock()
+	if !ok {
+		return nil
+	}
+
+	tx := pending.tx
+	trigger := &models.ContractTrigger{
+		TransactionID:  txID,
+		BlockNumber:    tx.BlockNumber,
+		BlockTimestamp: tx.BlockTimestamp,
+		Contract:       pending.contract,
+		Caller:         pending.caller,
+		CallValue:      pending.callValue,
+		Fee:            info.Fee,
+		Failed:         info.Result == lindapb.TransactionInfo_FAILED,
+		CreatedAt:      time.Now(),
+	}
+	if receipt := info.Receipt; receipt != nil {
+		trigger.EnergyUsed = receipt.EnergyUsageTotal
+		trigger.OwnerEnergy = receipt.OriginEnergyUsage
+		trigger.CallerEnergy = receipt.EnergyUsageTotal - receipt.OriginEnergyUsage
+		trigger.EnergyFee = receipt.EnergyFee
+		trigger.Result = receipt.Result.String()
+		if receipt.Result != lindapb.Transaction_Result_SUCCESS {
+			trigger.Failed = true
+		}
+	}
+
+	return ci.indexer.statsRepo.SaveContractTrigger(trigger)
+}
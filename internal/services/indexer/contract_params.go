@@ -0,0 +1,188 @@
+// internal/services/indexer/contract_params.go
+package indexer
+
+import (
+	"encoding/hex"
+	"errors"
+	"strconv"
+
+	"github.com/lindaprotocol/grpc-api-gateway/pkg/lindapb"
+	"github.com/lindaprotocol/grpc-api-gateway/pkg/utils"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+)
+
+// errMissingParameter is returned for a contract without a parameter
+var errMissingParameter = errors.New("contract has no parameter")
+
+// decodeContract unmarshals the parameter of a transaction contract into the
+// typed message of its contract type. It returns nil for contract types the
+// indexer does not read.
+func decodeContract(contract *lindapb.Transaction_Contract) (proto.Message, error) {
+	var msg proto.Message
+	switch contract.Type {
+	case lindapb.Transaction_Contract_AccountCreateContract:
+		msg = &lindapb.AccountCreateContract{}
+	case lindapb.Transaction_Contract_TransferContract:
+		msg = &lindapb.TransferContract{}
+	case lindapb.Transaction_Contract_TransferAssetContract:
+		msg = &lindapb.TransferAssetContract{}
+	case lindapb.Transaction_Contract_ParticipateAssetIssueContract:
+		msg = &lindapb.ParticipateAssetIssueContract{}
+	case lindapb.Transaction_Contract_VoteWitnessContract:
+		msg = &lindapb.VoteWitnessContract{}
+	case lindapb.Transaction_Contract_WitnessCreateContract:
+		msg = &lindapb.WitnessCreateContract{}
+	case lindapb.Transaction_Contract_AssetIssueContract:
+		msg = &lindapb.AssetIssueContract{}
+	case lindapb.Transaction_Contract_FreezeBalanceContract:
+		msg = &lindapb.FreezeBalanceContract{}
+	case lindapb.Transaction_Contract_UnfreezeBalanceContract:
+		msg = &lindapb.UnfreezeBalanceContract{}
+	case lindapb.Transaction_Contract_WithdrawBalanceContract:
+		msg = &lindapb.WithdrawBalanceContract{}
+	case lindapb.Transaction_Contract_ProposalCreateContract:
+		msg = &lindapb.ProposalCreateContract{}
+	case lindapb.Transaction_Contract_ProposalApproveContract:
+		msg = &lindapb.ProposalApproveContract{}
+	case lindapb.Transaction_Contract_ProposalDeleteContract:
+		msg = &lindapb.ProposalDeleteContract{}
+	case lindapb.Transaction_Contract_CreateSmartContract:
+		msg = &lindapb.CreateSmartContract{}
+	case lindapb.Transaction_Contract_ExchangeCreateContract:
+		msg = &lindapb.ExchangeCreateContract{}
+	case lindapb.Transaction_Contract_ExchangeInjectContract:
+		msg = &lindapb.ExchangeInjectContract{}
+	case lindapb.Transaction_Contract_ExchangeWithdrawContract:
+		msg = &lindapb.ExchangeWithdrawContract{}
+	case lindapb.Transaction_Contract_ExchangeTransactionContract:
+		msg = &lindapb.ExchangeTransactionContract{}
+	case lindapb.Transaction_Contract_AccountPermissionUpdateContract:
+		msg = &lindapb.AccountPermissionUpdateContract{}
+	case lindapb.Transaction_Contract_FreezeBalanceV2Contract:
+		msg = &lindapb.FreezeBalanceV2Contract{}
+	case lindapb.Transaction_Contract_UnfreezeBalanceV2Contract:
+		msg = &lindapb.UnfreezeBalanceV2Contract{}
+	case lindapb.Transaction_Contract_WithdrawExpireUnfreezeContract:
+		msg = &lindapb.WithdrawExpireUnfreezeContract{}
+	case lindapb.Transaction_Contract_DelegateResourceContract:
+		msg = &lindapb.DelegateResourceContract{}
+	case lindapb.Transaction_Contract_UnDelegateResourceContract:
+		msg = &lindapb.UnDelegateResourceContract{}
+	case lindapb.Transaction_Contract_CancelAllUnfreezeV2Contract:
+		msg = &lindapb.CancelAllUnfreezeV2Contract{}
+	case lindapb.Transaction_Contract_UpdateBrokerageContract:
+		msg = &lindapb.UpdateBrokerageContract{}
+	case lindapb.Transaction_Contract_MarketSellAssetContract:
+		msg = &lindapb.MarketSellAssetContract{}
+	case lindapb.Transaction_Contract_MarketCancelOrderContract:
+		msg = &lindapb.MarketCancelOrderContract{}
+	default:
+		return nil, nil
+	}
+
+	if contract.Parameter == nil {
+		return nil, errMissingParameter
+	}
+	if err := proto.Unmarshal(contract.Parameter.Value, msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// triggerSmartContract holds the parameter of a TriggerSmartContract. The
+// message is missing from the generated bindings, so it is read field by field.
+type triggerSmartContract struct {
+	OwnerAddress    []byte
+	ContractAddress []byte
+	CallValue       int64
+	CallTokenValue  int64
+	TokenID         int64
+}
+
+// decodeTriggerSmartContract unmarshals the parameter of a smart contract call
+func decodeTriggerSmartContract(contract *lindapb.Transaction_Contract) (*triggerSmartContract, error) {
+	if contract.Parameter == nil {
+		return nil, errMissingParameter
+	}
+
+	trigger := &triggerSmartContract{}
+	b := contract.Parameter.Value
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		b = b[n:]
+
+		switch {
+		case typ == protowire.BytesType && (num == 1 || num == 2):
+			value, n := protowire.ConsumeBytes(b)
+			if n < 0 {
+				return nil, protowire.ParseError(n)
+			}
+			if num == 1 {
+				trigger.OwnerAddress = value
+			} else {
+				trigger.ContractAddress = value
+			}
+			b = b[n:]
+		case typ == protowire.VarintType && (num == 3 || num == 5 || num == 6):
+			value, n := protowire.ConsumeVarint(b)
+			if n < 0 {
+				return nil, protowire.ParseError(n)
+			}
+			switch num {
+			case 3:
+				trigger.CallValue = int64(value)
+			case 5:
+				trigger.CallTokenValue = int64(value)
+			case 6:
+				trigger.TokenID = int64(value)
+			}
+			b = b[n:]
+		default:
+			// data and fields added by later node versions
+			n := protowire.ConsumeFieldValue(num, typ, b)
+			if n < 0 {
+				return nil, protowire.ParseError(n)
+			}
+			b = b[n:]
+		}
+	}
+	return trigger, nil
+}
+
+// transactionContract decodes the first contract of a transaction, nil when it
+// has none or it is of a type the indexer does not read
+func transactionContract(tx *lindapb.Transaction) (proto.Message, error) {
+	if tx == nil || tx.RawData == nil || len(tx.RawData.Contract) == 0 {
+		return nil, nil
+	}
+	return decodeContract(tx.RawData.Contract[0])
+}
+
+// contractOwner returns the base58 owner_address of a decoded contract
+func contractOwner(contract proto.Message) string {
+	if owned, ok := contract.(interface{ GetOwnerAddress() []byte }); ok {
+		return addressBase58(owned.GetOwnerAddress())
+	}
+	return ""
+}
+
+// addressBase58 converts a 21 byte address to base58, empty when it is unset
+func addressBase58(address []byte) string {
+	if len(address) == 0 {
+		return ""
+	}
+	return utils.MustHexToBase58(hex.EncodeToString(address))
+}
+
+// formatTokenID returns the LRC-10 token ID of the token_id field of a smart
+// contract call, empty when no token is sent
+func formatTokenID(id int64) string {
+	if id == 0 {
+		return ""
+	}
+	return strconv.FormatInt(id, 10)
+}
@@ -1,201 +1,276 @@
-// internal/services/indexer/event_indexer.go
-package indexer
-
-import (
-	"context"
-	"errors"
-	"math/big"
-
-	"github.com/lindaprotocol/grpc-api-gateway/internal/services/event"
-	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
-	"github.com/lindaprotocol/grpc-api-gateway/pkg/lindapb"
-	"github.com/lindaprotocol/grpc-api-gateway/pkg/utils"
-)
-
-type EventIndexer struct {
-	indexer *Indexer
-	parser  *event.EventParser
-}
-
-func NewEventIndexer(indexer *Indexer) *EventIndexer {
-	return &EventIndexer{
-		indexer: indexer,
-		parser:  event.NewEventParser(),
-	}
-}
-
-// IndexEvents indexes events from a transaction
-func (ei *EventIndexer) IndexEvents(ctx context.Context, tx *lindapb.Transaction, txInfo *lindapb.TransactionInfo, block *lindapb.Block) error {
-	if txInfo == nil || len(txInfo.Log) == 0 {
-		return nil
-	}
-
-	for i, log := range txInfo.Log {
-		event := &models.EventResponse{
-			BlockNumber:          txInfo.BlockNumber,
-			BlockTimestamp:       txInfo.BlockTimeStamp,
-			ContractAddress:      utils.MustHexToBase58(string(log.Address)),
-			EventIndex:           string(rune(i)),
-			TransactionID:        string(txInfo.Id),
-			Result:               make(map[string]interface{}),
-			ResultType:           make(map[string]string),
-			Unconfirmed:          false,
-		}
-
-		// Parse event name and parameters
-		if len(log.Topics) > 0 {
-			eventName, params, paramTypes := ei.parser.ParseEvent(log.Topics, log.Data)
-			event.EventName = eventName
-			event.Event = eventName
-			event.Result = params
-			event.ResultType = paramTypes
-		}
-
-		// Save to repository
-		if err := ei.indexer.eventRepo.SaveEvent(event); err != nil {
-			return err
-		}
-
-		// If this is a token transfer, also index as transfer
-		if event.EventName == "Transfer" {
-			if err := ei.indexer.tokenIndexer.IndexTokenTransfer(ctx, event); err != nil {
-				ei.indexer.logger.WithError(err).Error("Failed to index token transfer")
-			}
-		}
-	}
-
-	return nil
-}
-
-// IndexEventsFromBlock indexes all events in a block
-func (ei *EventIndexer) IndexEventsFromBlock(ctx context.Context, block *lindapb.Block) error {
-	// Get transaction infos for the block
-	txInfos, err := ei.indexer.blockchainClient.GetTransactionInfoByBlockNum(ctx, &lindapb.NumberMessage{
-		Num: block.BlockHeader.RawData.Number,
-	})
-	if err != nil {
-		return err
-	}
-
-	// Map transactions by ID for quick lookup
-	txMap := make(map[string]*lindapb.Transaction)
-	for _, tx := range block.Transactions {
-		txMap[string(tx.TxID)] = tx
-	}
-
-	// Index each transaction's events
-	for _, info := range txInfos.TransactionInfo {
-		tx, ok := txMap[string(info.Id)]
-		if !ok {
-			continue
-		}
-		if err := ei.IndexEvents(ctx, tx, info, block); err != nil {
-			ei.indexer.logger.WithError(err).WithField("tx", string(info.Id)).Error("Failed to index events")
-		}
-	}
-
-	return nil
-}
-
-// GetEventsByFilter retrieves events based on filters
-func (ei *EventIndexer) GetEventsByFilter(filter *event.EventFilter) ([]*models.EventResponse, int64, error) {
-	return ei.indexer.eventRepo.GetEvents(
-		filter.ContractAddress,
-		filter.EventName,
-		filter.TransactionID,
-		filter.BlockNumber,
-		filter.FromTimestamp,
-		filter.ToTimestamp,
-		filter.Offset,
-		filter.Limit,
-		filter.Sort,
-		filter.Confirmed,
-	)
-}
-
-// ParseEventLog parses a raw event log into a structured event
-func (ei *EventIndexer) ParseEventLog(log *lindapb.Log, blockNumber, blockTimestamp int64, txID string) (*models.EventResponse, error) {
-	event := &models.EventResponse{
-		BlockNumber:     blockNumber,
-		BlockTimestamp:  blockTimestamp,
-		ContractAddress: utils.MustHexToBase58(string(log.Address)),
-		TransactionID:   txID,
-		Result:          make(map[string]interface{}),
-		ResultType:      make(map[string]string),
-		Unconfirmed:     false,
-	}
-
-	// Parse event name from first topic
-	if len(log.Topics) > 0 {
-		eventName, params, paramTypes := ei.parser.ParseEvent(log.Topics, log.Data)
-		event.EventName = eventName
-		event.Event = eventName
-		event.Result = params
-		event.ResultType = paramTypes
-	}
-
-	return event, nil
-}
-
-// ParseTransferEvent parses a transfer event specifically
-func (ei *EventIndexer) ParseTransferEvent(event *models.EventResponse) (from, to, value string, err error) {
-	if event.EventName != "Transfer" {
-		return "", "", "", nil
-	}
-
-	fromVal, ok := event.Result["from"]
-	if !ok {
-		return "", "", "", nil
-	}
-	from, ok = fromVal.(string)
-	if !ok {
-		return "", "", "", nil
-	}
-
-	toVal, ok := event.Result["to"]
-	if !ok {
-		return "", "", "", nil
-	}
-	to, ok = toVal.(string)
-	if !ok {
-		return "", "", "", nil
-	}
-
-	valueVal, ok := event.Result["value"]
-	if !ok {
-		return "", "", "", nil
-	}
-	value, ok = valueVal.(string)
-	if !ok {
-		return "", "", "", nil
-	}
-
-	return from, to, value, nil
-}
-
-// IsERC20Transfer checks if an event is an ERC20 transfer
-func (ei *EventIndexer) IsERC20Transfer(event *models.EventResponse) bool {
-	if event.EventName != "Transfer" {
-		return false
-	}
-	_, hasFrom := event.Result["from"]
-	_, hasTo := event.Result["to"]
-	_, hasValue := event.Result["value"]
-	return hasFrom && hasTo && hasValue
-}
-
-// GetTokenTransferValue returns the transfer value as *big.Int
-func (ei *EventIndexer) GetTokenTransferValue(event *models.EventResponse) (*big.Int, error) {
-	if !ei.IsERC20Transfer(event) {
-		return nil, nil
-	}
-	valueStr, ok := event.Result["value"].(string)
-	if !ok {
-		return nil, errors.New("value field is not a string")
-	}
-	val, success := new(big.Int).SetString(valueStr, 10)
-	if !success {
-		return nil, errors.New("failed to parse value as big.Int")
-	}
-	return val, nil
+// internal/services/indexer/event_indexer.go
+package indexer
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/event"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
+	"github.com/lindaprotocol/grpc-api-gateway/pkg/lindapb"
+	"github.com/lindaprotocol/grpc-api-gateway/pkg/utils"
+)
+
+type EventIndexer struct {
+	indexer *Indexer
+	parser  *event.EventParser
+}
+
+func NewEventIndexer(indexer *Indexer) *EventIndexer {
+	return &EventIndexer{
+		indexer: indexer,
+		parser:  event.NewEventParser(),
+	}
+}
+
+// IndexEvents indexes events from a transaction. txIndex is the position of the
+// transaction in its block and logIndex the block-wide index of its first log.
+func (ei *EventIndexer) IndexEvents(ctx context.Context, tx *lindapb.Transaction, txInfo *lindapb.TransactionInfo, block *lindapb.Block, txIndex, logIndex int) error {
+	if txInfo == nil || len(txInfo.Log) == 0 {
+		return nil
+	}
+
+	blockHash := ""
+	if block != nil {
+		blockHash = hex.EncodeToString(block.BlockID)
+	}
+	if tx == nil && block != nil && txIndex < len(block.Transactions) {
+		tx = block.Transactions[txIndex]
+	}
+
+	// Possible callers of transferFrom, resolved on the first transfer
+	var spenders []string
+
+	for i, log := range txInfo.Log {
+		event := &models.EventResponse{
+			BlockNumber:          txInfo.BlockNumber,
+			BlockTimestamp:       txInfo.BlockTimeStamp,
+			ContractAddress:      utils.MustHexToBase58(hex.EncodeToString(log.Address)),
+			EventIndex:           string(rune(i)),
+			TransactionID:        hex.EncodeToString(txInfo.Id),
+			Result:               make(map[string]interface{}),
+			ResultType:           make(map[string]string),
+			Unconfirmed:          ei.indexer.unconfirmed(txInfo.BlockNumber),
+			BlockHash:            blockHash,
+			TransactionIndex:     txIndex,
+			LogIndex:             logIndex + i,
+			Data:                 hex.EncodeToString(log.Data),
+		}
+		for _, topic := range log.Topics {
+			event.Topics = append(event.Topics, hex.EncodeToString(topic))
+		}
+
+		// Parse event name and parameters
+		if len(log.Topics) > 0 {
+			eventName, params, paramTypes := ei.parser.ParseEvent(log.Topics, log.Data)
+			event.EventName = eventName
+			event.Event = eventName
+			event.Result = params
+			event.ResultType = paramTypes
+		}
+
+		// Save to repository
+		if err := ei.indexer.eventRepo.SaveEvent(event); err != nil {
+			return err
+		}
+
+		// Queue webhook deliveries for matching subscriptions
+		if ei.indexer.webhooks != nil {
+			if err := ei.indexer.webhooks.NotifyEvent(event); err != nil {
+				ei.indexer.logger.WithError(err).Error("Failed to enqueue event webhooks")
+			}
+		}
+
+		// If this is a token transfer, also index as transfer
+		if ei.indexer.nftIndexer.IsNFTTransfer(event) {
+			if err := ei.indexer.nftIndexer.IndexTransfer(ctx, event); err != nil {
+				ei.indexer.logger.WithError(err).Error("Failed to index NFT transfer")
+			}
+		} else if event.EventName == "Transfer" {
+			if err := ei.indexer.tokenIndexer.IndexTokenTransfer(ctx, event); err != nil {
+				ei.indexer.logger.WithError(err).Error("Failed to index token transfer")
+			}
+			if spenders == nil {
+				spenders = transactionCallers(tx, txInfo)
+			}
+			if err := ei.indexer.tokenIndexer.IndexTransferFrom(ctx, event, spenders); err != nil {
+				ei.indexer.logger.WithError(err).Error("Failed to update token allowance")
+			}
+		} else if event.EventName == "Approval" {
+			if err := ei.indexer.tokenIndexer.IndexApproval(ctx, event); err != nil {
+				ei.indexer.logger.WithError(err).Error("Failed to index token approval")
+			}
+		}
+	}
+
+	return nil
+}
+
+// transactionCallers returns the sender of a transaction followed by the
+// contracts that made internal calls, as base58 addresses
+func transactionCallers(tx *lindapb.Transaction, txInfo *lindapb.TransactionInfo) []string {
+	callers := []string{}
+	seen := make(map[string]bool)
+	add := func(address string) {
+		if address != "" && !seen[address] {
+			seen[address] = true
+			callers = append(callers, address)
+		}
+	}
+
+	if contract, err := transactionContract(tx); err == nil && contract != nil {
+		add(contractOwner(contract))
+	}
+	for _, itx := range txInfo.InternalTransactions {
+		if len(itx.FromAddress) > 0 {
+			add(utils.MustHexToBase58(hex.EncodeToString(itx.FromAddress)))
+		}
+	}
+
+	return callers
+}
+
+// eventAddress converts a 0x prefixed event address to base58
+func eventAddress(addr string) string {
+	return utils.MustHexToBase58(strings.TrimPrefix(addr, "0x"))
+}
+
+// IndexEventsFromBlock indexes all events in a block
+func (ei *EventIndexer) IndexEventsFromBlock(ctx context.Context, block *lindapb.Block) error {
+	// Get transaction infos for the block
+	txInfos, err := ei.indexer.blockchainClient.GetTransactionInfoByBlockNum(ctx, &lindapb.NumberMessage{
+		Num: block.BlockHeader.RawData.Number,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Map transactions by ID for quick lookup
+	txMap := make(map[string]*lindapb.Transaction)
+	for _, tx := range block.Transactions {
+		txMap[string(tx.TxID)] = tx
+	}
+
+	// Index each transaction's events
+	logIndex := 0
+	for txIndex, info := range txInfos.TransactionInfo {
+		tx, ok := txMap[string(info.Id)]
+		if !ok {
+			logIndex += len(info.Log)
+			continue
+		}
+		if err := ei.IndexEvents(ctx, tx, info, block, txIndex, logIndex); err != nil {
+			ei.indexer.logger.WithError(err).WithField("tx", string(info.Id)).Error("Failed to index events")
+		}
+		logIndex += len(info.Log)
+	}
+
+	return nil
+}
+
+// GetEventsByFilter retrieves events based on filters
+func (ei *EventIndexer) GetEventsByFilter(filter *event.EventFilter) ([]*models.EventResponse, int64, error) {
+	return ei.indexer.eventRepo.GetEvents(
+		filter.ContractAddress,
+		filter.EventName,
+		filter.TransactionID,
+		filter.BlockNumber,
+		filter.FromTimestamp,
+		filter.ToTimestamp,
+		filter.Offset,
+		filter.Limit,
+		filter.Sort,
+		filter.Confirmed,
+		filter.Unconfirmed,
+	)
+}
+
+// ParseEventLog parses a raw event log into a structured event
+func (ei *EventIndexer) ParseEventLog(log *lindapb.Log, blockNumber, blockTimestamp int64, txID string) (*models.EventResponse, error) {
+	event := &models.EventResponse{
+		BlockNumber:     blockNumber,
+		BlockTimestamp:  blockTimestamp,
+		ContractAddress: utils.MustHexToBase58(string(log.Address)),
+		TransactionID:   txID,
+		Result:          make(map[string]interface{}),
+		ResultType:      make(map[string]string),
+		Unconfirmed:     false,
+	}
+
+	// Parse event name from first topic
+	if len(log.Topics) > 0 {
+		eventName, params, paramTypes := ei.parser.ParseEvent(log.Topics, log.Data)
+		event.EventName = eventName
+		event.Event = eventName
+		event.Result = params
+		event.ResultType = paramTypes
+	}
+
+	return event, nil
+}
+
+// ParseTransferEvent parses a transfer event specifically
+func (ei *EventIndexer) ParseTransferEvent(event *models.EventResponse) (from, to, value string, err error) {
+	if event.EventName != "Transfer" {
+		return "", "", "", nil
+	}
+
+	fromVal, ok := event.Result["from"]
+	if !ok {
+		return "", "", "", nil
+	}
+	from, ok = fromVal.(string)
+	if !ok {
+		return "", "", "", nil
+	}
+
+	toVal, ok := event.Result["to"]
+	if !ok {
+		return "", "", "", nil
+	}
+	to, ok = toVal.(string)
+	if !ok {
+		return "", "", "", nil
+	}
+
+	valueVal, ok := event.Result["value"]
+	if !ok {
+		return "", "", "", nil
+	}
+	value, ok = valueVal.(string)
+	if !ok {
+		return "", "", "", nil
+	}
+
+	return from, to, value, nil
+}
+
+// IsERC20Transfer checks if an event is an ERC20 transfer
+func (ei *EventIndexer) IsERC20Transfer(event *models.EventResponse) bool {
+	if event.EventName != "Transfer" {
+		return false
+	}
+	_, hasFrom := event.Result["from"]
+	_, hasTo := event.Result["to"]
+	_, hasValue := event.Result["value"]
+	return hasFrom && hasTo && hasValue
+}
+
+// GetTokenTransferValue returns the transfer value as *big.Int
+func (ei *EventIndexer) GetTokenTransferValue(event *models.EventResponse) (*big.Int, error) {
+	if !ei.IsERC20Transfer(event) {
+		return nil, nil
+	}
+	valueStr, ok := event.Result["value"].(string)
+	if !ok {
+		return nil, errors.New("value field is not a string")
+	}
+	val, success := new(big.Int).SetString(valueStr, 10)
+	if !success {
+		return nil, errors.New("failed to parse value as big.Int")
+	}
+	return val, nil
 }
\ No newline at end of file
@@ -0,0 +1,241 @@
+// internal/services/indexer/exchange_indexer.go
+package indexer
+
+import (
+	"context"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
+	"github.com/lindaprotocol/grpc-api-gateway/pkg/lindapb"
+	"google.golang.org/protobuf/proto"
+)
+
+// pendingExchangeTx is a successful exchange or market transaction waiting
+// for the receipt that carries its amounts
+type pendingExchangeTx struct {
+	tx       *models.Transaction
+	contract proto.Message
+}
+
+// ExchangeIndexer struct: Indexer for Bancor exchange trades and liquidity and market orders and fills
+type ExchangeIndexer struct {
+	indexer *Indexer
+
+	mu          sync.Mutex
+	pending     map[string]*pendingExchangeTx // by transaction ID
+	firstTokens map[int64]string              // first token of each exchange
+}
+
+// NewExchangeIndexer creates a new exchange indexer
+func NewExchangeIndexer(indexer *Indexer) *ExchangeIndexer {
+	return &ExchangeIndexer{
+		indexer:     indexer,
+		pending:     make(map[string]*pendingExchangeTx),
+		firstTokens: make(map[int64]string),
+	}
+}
+
+// IndexTransaction keeps the decoded contract of a successful exchange or
+// market transaction until its receipt is indexed
+func (ei *ExchangeIndexer) IndexTransaction(tx *models.Transaction, contract proto.Message) {
+	switch contract.(type) {
+	case *lindapb.ExchangeCreateContract, *lindapb.ExchangeInjectContract, *lindapb.ExchangeWithdrawContract,
+		*lindapb.ExchangeTransactionContract, *lindapb.MarketSellAssetContract, *lindapb.MarketCancelOrderContract:
+		ei.mu.Lock()
+		ei.pending[hex.EncodeToString([]byte(tx.Hash))] = &pendingExchangeTx{tx: tx, contract: contract}
+		ei.mu.Unlock()
+	}
+}
+
+// Reset drops the transactions of a block whose receipts could not be fetched
+func (ei *ExchangeIndexer) Reset() {
+	ei.mu.Lock()
+	ei.pending = make(map[string]*pendingExchangeTx)
+	ei.mu.Unlock()
+}
+
+// IndexTransactionInfo records the trade, liquidity change or order of a
+// pending transaction from the amounts in its receipt
+func (ei *ExchangeIndexer) IndexTransactionInfo(ctx context.Context, info *lindapb.TransactionInfo) error {
+	txID := hex.EncodeToString(info.Id)
+
+	ei.mu.Lock()
+	pending, ok := ei.pending[txID]
+	delete(ei.pending, txID)
+	ei.mu.Unlock()
+	if !ok {
+		return nil
+	}
+
+	repo := ei.indexer.exchangeRepo
+	tx := pending.tx
+
+	switch c := pending.contract.(type) {
+	case *lindapb.ExchangeCreateContract:
+		ei.mu.Lock()
+		ei.firstTokens[info.ExchangeId] = string(c.FirstTokenId)
+		ei.mu.Unlock()
+		return repo.SaveLiquidityEvent(&models.ExchangeLiquidityEvent{
+			TransactionID:     txID,
+			BlockNumber:       tx.BlockNumber,
+			BlockTimestamp:    tx.BlockTimestamp,
+			ExchangeID:        info.ExchangeId,
+			Provider:          tx.FromAddress,
+			Action:            models.LiquidityActionCreate,
+			FirstTokenAmount:  c.FirstTokenBalance,
+			SecondTokenAmount: c.SecondTokenBalance,
+			CreatedAt:         time.Now(),
+		})
+
+	case *lindapb.ExchangeInjectContract:
+		return ei.indexLiquidity(ctx, tx, txID, models.LiquidityActionInject, c.ExchangeId, string(c.TokenId),
+			c.Quant, info.ExchangeInjectAnotherAmount)
+
+	case *lindapb.ExchangeWithdrawContract:
+		return ei.indexLiquidity(ctx, tx, txID, models.LiquidityActionWithdraw, c.ExchangeId, string(c.TokenId),
+			-c.Quant, -info.ExchangeWithdrawAnotherAmount)
+
+	case *lindapb.ExchangeTransactionContract:
+		exchangeID := c.ExchangeId
+		firstToken, err := ei.firstToken(ctx, exchangeID)
+		if err != nil {
+			return err
+		}
+
+		// Selling the first token of the exchange sells the base token
+		trade := &models.ExchangeTrade{
+			TransactionID:  txID,
+			BlockNumber:    tx.BlockNumber,
+			BlockTimestamp: tx.BlockTimestamp,
+			ExchangeID:     exchangeID,
+			Trader:         tx.FromAddress,
+			Side:           models.TradeSideSell,
+			BaseAmount:     c.Quant,
+			QuoteAmount:    info.ExchangeReceivedAmount,
+			CreatedAt:      time.Now(),
+		}
+		if string(c.TokenId) != firstToken {
+			trade.Side = models.TradeSideBuy
+			trade.BaseAmount, trade.QuoteAmount = trade.QuoteAmount, trade.BaseAmount
+		}
+		if trade.BaseAmount > 0 {
+			trade.Price = float64(trade.QuoteAmount) / float64(trade.BaseAmount)
+		}
+		return repo.SaveExchangeTrade(trade)
+
+	case *lindapb.MarketSellAssetContract:
+		return ei.indexMarketOrder(tx, c, info)
+
+	case *lindapb.MarketCancelOrderContract:
+		return repo.CancelMarketOrder(hex.EncodeToString(c.OrderId), tx.BlockNumber)
+	}
+
+	return nil
+}
+
+// indexLiquidity records the liquidity added or removed by an ExchangeInject or
+// ExchangeWithdraw transaction, quant of tokenID and another of the other token
+func (ei *ExchangeIndexer) indexLiquidity(ctx context.Context, tx *models.Transaction, txID, action string, exchangeID int64, tokenID string, quant, another int64) error {
+	firstToken, err := ei.firstToken(ctx, exchangeID)
+	if err != nil {
+		return err
+	}
+
+	event := &models.ExchangeLiquidityEvent{
+		TransactionID:     txID,
+		BlockNumber:       tx.BlockNumber,
+		BlockTimestamp:    tx.BlockTimestamp,
+		ExchangeID:        exchangeID,
+		Provider:          tx.FromAddress,
+		Action:            action,
+		FirstTokenAmount:  quant,
+		SecondTokenAmount: another,
+		CreatedAt:         time.Now(),
+	}
+	if tokenID != firstToken {
+		event.FirstTokenAmount, event.SecondTokenAmount = another, quant
+	}
+	return ei.indexer.exchangeRepo.SaveLiquidityEvent(event)
+}
+
+// indexMarketOrder records the order placed by a MarketSellAsset transaction and
+// the fills it matched against resting orders
+func (ei *ExchangeIndexer) indexMarketOrder(tx *models.Transaction, order *lindapb.MarketSellAssetContract, info *lindapb.TransactionInfo) error {
+	repo := ei.indexer.exchangeRepo
+	txID := hex.EncodeToString(info.Id)
+	orderID := hex.EncodeToString(info.OrderId)
+	sellToken := string(order.SellTokenId)
+	buyToken := string(order.BuyTokenId)
+	pair, base, _ := models.MarketPair(sellToken, buyToken)
+
+	if err := repo.SaveMarketOrder(&models.MarketOrder{
+		OrderID:        orderID,
+		TransactionID:  txID,
+		BlockNumber:    tx.BlockNumber,
+		BlockTimestamp: tx.BlockTimestamp,
+		Owner:          tx.FromAddress,
+		Pair:           pair,
+		SellTokenID:    sellToken,
+		SellQuantity:   order.SellTokenValue,
+		BuyTokenID:     buyToken,
+		BuyQuantity:    order.BuyTokenValue,
+		State:          models.MarketOrderStateActive,
+		CreatedAt:      time.Now(),
+	}); err != nil {
+		return err
+	}
+
+	// The taker sells FillSellQuantity of its sell token to the maker
+	trades := make([]*models.MarketTrade, 0, len(info.OrderDetails))
+	fills := make(map[string]int64)
+	for _, detail := range info.OrderDetails {
+		trade := &models.MarketTrade{
+			TransactionID:  txID,
+			BlockNumber:    tx.BlockNumber,
+			BlockTimestamp: tx.BlockTimestamp,
+			Pair:           pair,
+			MakerOrderID:   hex.EncodeToString(detail.MakerOrderId),
+			TakerOrderID:   hex.EncodeToString(detail.TakerOrderId),
+			Taker:          tx.FromAddress,
+			Side:           models.TradeSideSell,
+			BaseAmount:     detail.FillSellQuantity,
+			QuoteAmount:    detail.FillBuyQuantity,
+			CreatedAt:      time.Now(),
+		}
+		if sellToken != base {
+			trade.Side = models.TradeSideBuy
+			trade.BaseAmount, trade.QuoteAmount = trade.QuoteAmount, trade.BaseAmount
+		}
+		if trade.BaseAmount > 0 {
+			trade.Price = float64(trade.QuoteAmount) / float64(trade.BaseAmount)
+		}
+		trades = append(trades, trade)
+
+		fills[trade.TakerOrderID] += detail.FillSellQuantity
+		fills[trade.MakerOrderID] += detail.FillBuyQuantity
+	}
+	return repo.SaveMarketTrades(trades, fills)
+}
+
+// firstToken returns the first token of an exchange
+func (ei *ExchangeIndexer) firstToken(ctx context.Context, exchangeID int64) (string, error) {
+	ei.mu.Lock()
+	token, ok := ei.firstTokens[exchangeID]
+	ei.mu.Unlock()
+	if ok {
+		return token, nil
+	}
+
+	exchange, err := ei.indexer.blockchainClient.GetExchangeById(ctx, &lindapb.NumberMessage{Num: exchangeID})
+	if err != nil {
+		return "", err
+	}
+	token = string(exchange.FirstTokenId)
+
+	ei.mu.Lock()
+	ei.firstTokens[exchangeID] = token
+	ei.mu.Unlock()
+	return token, nil
+}
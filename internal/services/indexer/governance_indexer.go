@@ -0,0 +1,262 @@
+// internal/services/indexer/governance_indexer.go
+package indexer
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
+	"github.com/lindaprotocol/grpc-api-gateway/pkg/lindapb"
+	"github.com/lindaprotocol/grpc-api-gateway/pkg/utils"
+	"google.golang.org/protobuf/proto"
+)
+
+// errProposalNotFound is returned when the node does not list the proposal created by a transaction
+var errProposalNotFound = errors.New("created proposal not found on node")
+
+// defaultProposalExpireTime is how long a proposal stays open before the
+// maintenance period that settles it, the node default
+const defaultProposalExpireTime = 3 * 24 * time.Hour
+
+// GovernanceIndexer struct: Indexer for proposals, proposal approvals and chain parameter changes.
+// Maintenance periods are followed from block timestamps: maintenance times are interval
+// milliseconds apart and aligned on anchor, the next maintenance time reported by the node.
+type GovernanceIndexer struct {
+	indexer *Indexer
+
+	mu       sync.Mutex
+	anchor   int64
+	interval int64
+}
+
+// NewGovernanceIndexer creates a new governance indexer
+func NewGovernanceIndexer(indexer *Indexer) *GovernanceIndexer {
+	return &GovernanceIndexer{
+		indexer: indexer,
+	}
+}
+
+// IndexTransaction records a successful ProposalCreate, ProposalApprove or ProposalDelete transaction
+func (gi *GovernanceIndexer) IndexTransaction(ctx context.Context, tx *models.Transaction, contract proto.Message) error {
+	repo := gi.indexer.governanceRepo
+	txID := hex.EncodeToString([]byte(tx.Hash))
+
+	switch c := contract.(type) {
+	case *lindapb.ProposalCreateContract:
+		gi.mu.Lock()
+		defer gi.mu.Unlock()
+		if err := gi.loadSchedule(ctx); err != nil {
+			return err
+		}
+
+		// The node stamps proposals with the time of the block before the one
+		// executing them, and expires them at a maintenance time
+		createTime := tx.BlockTimestamp - models.BlockInterval.Milliseconds()
+		if prev, err := gi.indexer.blockRepo.GetByNumber(tx.BlockNumber - 1); err == nil {
+			createTime = prev.Timestamp
+		}
+		current := gi.nextMaintenanceAfter(createTime)
+		round := (createTime + defaultProposalExpireTime.Milliseconds() - current) / gi.interval
+
+		id, err := gi.nextProposalID(ctx, tx.FromAddress, createTime)
+		if err != nil {
+			return err
+		}
+		parameters, err := json.Marshal(c.Parameters)
+		if err != nil {
+			return err
+		}
+		return repo.SaveProposal(&models.GovernanceProposal{
+			ProposalID:     id,
+			TransactionID:  txID,
+			BlockNumber:    tx.BlockNumber,
+			Proposer:       tx.FromAddress,
+			Parameters:     models.JSON(parameters),
+			CreateTime:     createTime,
+			ExpirationTime: current + (round+1)*gi.interval,
+			State:          models.ProposalStatePending,
+			CreatedAt:      time.Now(),
+		})
+
+	case *lindapb.ProposalApproveContract:
+		return repo.SaveApproval(&models.ProposalApproval{
+			ProposalID:     c.ProposalId,
+			TransactionID:  txID,
+			BlockNumber:    tx.BlockNumber,
+			BlockTimestamp: tx.BlockTimestamp,
+			Witness:        tx.FromAddress,
+			Approve:        c.IsAddApproval,
+			CreatedAt:      time.Now(),
+		})
+
+	case *lindapb.ProposalDeleteContract:
+		return repo.ResolveProposal(c.ProposalId, models.ProposalStateCanceled, tx.BlockNumber, tx.BlockTimestamp)
+	}
+
+	return nil
+}
+
+// IndexBlock resolves expired proposals and records chain parameter changes
+// on the first block of each maintenance period
+func (gi *GovernanceIndexer) IndexBlock(ctx context.Context, block *models.Block) error {
+	gi.mu.Lock()
+	defer gi.mu.Unlock()
+
+	if block.Number == 0 {
+		return nil
+	}
+	prev, err := gi.indexer.blockRepo.GetByNumber(block.Number - 1)
+	if err != nil {
+		// The first indexed block has no previous block to compare with
+		return nil
+	}
+	if err := gi.loadSchedule(ctx); err != nil {
+		return err
+	}
+	if block.Timestamp < gi.nextMaintenanceAfter(prev.Timestamp) {
+		return nil
+	}
+
+	if err := gi.resolveProposals(ctx, block); err != nil {
+		return err
+	}
+
+	// The node only reports the parameters in force now, they are recorded
+	// when the maintenance time crossed is the last one the node passed
+	next, err := gi.indexer.blockchainClient.GetNextMaintenanceTime(ctx, &lindapb.EmptyMessage{})
+	if err != nil {
+		return err
+	}
+	if gi.nextMaintenanceAfter(block.Timestamp) != next.Num {
+		return nil
+	}
+	gi.anchor = next.Num
+	return gi.recordParameters(ctx, block)
+}
+
+// loadSchedule reads the maintenance time and interval the periods are
+// aligned on, once. They stay aligned as long as the interval is unchanged,
+// the anchor is moved to the node's each time a live maintenance is indexed.
+func (gi *GovernanceIndexer) loadSchedule(ctx context.Context) error {
+	if gi.interval > 0 {
+		return nil
+	}
+
+	client := gi.indexer.blockchainClient
+	next, err := client.GetNextMaintenanceTime(ctx, &lindapb.EmptyMessage{})
+	if err != nil {
+		return err
+	}
+	interval := defaultMaintenanceInterval.Milliseconds()
+	if params, err := client.GetChainParameters(ctx, &lindapb.EmptyMessage{}); err == nil {
+		for _, p := range params.ChainParameter {
+			if p.Key == "getMaintenanceTimeInterval" && p.Value > 0 {
+				interval = p.Value
+			}
+		}
+	}
+
+	gi.anchor = next.Num
+	gi.interval = interval
+	return nil
+}
+
+// nextMaintenanceAfter returns the first maintenance time after a timestamp
+func (gi *GovernanceIndexer) nextMaintenanceAfter(timestamp int64) int64 {
+	elapsed := timestamp - gi.anchor
+	periods := elapsed / gi.interval
+	if elapsed < 0 && elapsed%gi.interval != 0 {
+		periods--
+	}
+	return gi.anchor + (periods+1)*gi.interval
+}
+
+// nextProposalID returns the ID of a created proposal. The node numbers
+// proposals in creation order, only the first proposal indexed above genesis
+// is looked up on the node by its proposer and creation time.
+func (gi *GovernanceIndexer) nextProposalID(ctx context.Context, proposer string, createTime int64) (int64, error) {
+	latest, found, err := gi.indexer.governanceRepo.GetLatestProposalID()
+	if err != nil {
+		return 0, err
+	}
+	if found {
+		return latest + 1, nil
+	}
+	if gi.indexer.config.StartBlock == 0 {
+		return 1, nil
+	}
+
+	proposals, err := gi.indexer.blockchainClient.ListProposals(ctx, &lindapb.EmptyMessage{})
+	if err != nil {
+		return 0, err
+	}
+	for _, p := range proposals.Proposals {
+		if p.CreateTime == createTime && utils.MustHexToBase58(hex.EncodeToString(p.ProposerAddress)) == proposer {
+			return p.ProposalId, nil
+		}
+	}
+	return 0, errProposalNotFound
+}
+
+// resolveProposals records the outcome of pending proposals that have expired.
+// The node keeps the final state of settled proposals, so it is the state
+// they were given at this maintenance.
+func (gi *GovernanceIndexer) resolveProposals(ctx context.Context, block *models.Block) error {
+	repo := gi.indexer.governanceRepo
+
+	proposals, err := repo.GetExpiredPendingProposals(block.Timestamp)
+	if err != nil {
+		return err
+	}
+	for _, proposal := range proposals {
+		p, err := gi.indexer.blockchainClient.GetProposalById(ctx, &lindapb.NumberMessage{Num: proposal.ProposalID})
+		if err != nil {
+			return err
+		}
+		// The node settles proposals during maintenance
+		if p.State == lindapb.Proposal_PENDING {
+			continue
+		}
+		if err := repo.ResolveProposal(proposal.ProposalID, p.State.String(), block.Number, block.Timestamp); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// recordParameters saves the chain parameters that differ from their last observed value
+func (gi *GovernanceIndexer) recordParameters(ctx context.Context, block *models.Block) error {
+	repo := gi.indexer.governanceRepo
+
+	params, err := gi.indexer.blockchainClient.GetChainParameters(ctx, &lindapb.EmptyMessage{})
+	if err != nil {
+		return err
+	}
+	latest, err := repo.GetLatestParameters()
+	if err != nil {
+		return err
+	}
+
+	var changes []*models.ChainParameterChange
+	for _, p := range params.ChainParameter {
+		change := &models.ChainParameterChange{
+			Key:         p.Key,
+			NewValue:    p.Value,
+			BlockNumber: block.Number,
+			ChangedAt:   block.Timestamp,
+			CreatedAt:   time.Now(),
+		}
+		if old, ok := latest[p.Key]; ok {
+			if old == p.Value {
+				continue
+			}
+			change.OldValue = &old
+		}
+		changes = append(changes, change)
+	}
+	return repo.SaveParameterChanges(changes)
+}
@@ -1,235 +1,521 @@
-// internal/services/indexer/indexer.go
-package indexer
-
-import (
-	"context"
-	"sync"
-	"time"
-
-	"github.com/lindaprotocol/grpc-api-gateway/internal/config"
-	"github.com/lindaprotocol/grpc-api-gateway/internal/services/blockchain"
-	"github.com/lindaprotocol/grpc-api-gateway/internal/services/storage/repository"
-	"github.com/lindaprotocol/grpc-api-gateway/pkg/lindapb"
-	"github.com/sirupsen/logrus"
-)
-
-// Indexer struct: Main indexer service
-type Indexer struct {
-	config           *config.IndexerConfig
-	blockchainClient *blockchain.Client
-	blockRepo        *repository.BlockRepository
-	txRepo           *repository.TransactionRepository
-	tokenRepo        *repository.TokenRepository
-	eventRepo        *repository.EventRepository
-	statsRepo        *repository.StatsRepository
-	
-	logger           *logrus.Logger
-	stopChan         chan struct{}
-	wg               sync.WaitGroup
-	currentBlock     int64
-	
-	// Indexer components
-	blockIndexer     *BlockIndexer
-	txIndexer        *TransactionIndexer
-	tokenIndexer     *TokenIndexer
-	eventIndexer     *EventIndexer
-}
-
-// NewIndexer creates a new indexer instance
-func NewIndexer(
-	cfg *config.IndexerConfig,
-	client *blockchain.Client,
-	blockRepo *repository.BlockRepository,
-	txRepo *repository.TransactionRepository,
-	tokenRepo *repository.TokenRepository,
-	eventRepo *repository.EventRepository,
-	statsRepo *repository.StatsRepository,
-) *Indexer {
-	idx := &Indexer{
-		config:           cfg,
-		blockchainClient: client,
-		blockRepo:        blockRepo,
-		txRepo:           txRepo,
-		tokenRepo:        tokenRepo,
-		eventRepo:        eventRepo,
-		statsRepo:        statsRepo,
-		logger:           logrus.New(),
-		stopChan:         make(chan struct{}),
-		currentBlock:     0,
-	}
-	
-	// Initialize indexers
-	idx.blockIndexer = NewBlockIndexer(idx)
-	idx.txIndexer = NewTransactionIndexer(idx)
-	idx.tokenIndexer = NewTokenIndexer(idx)
-	idx.eventIndexer = NewEventIndexer(idx)
-	
-	return idx
-}
-
-// Start begins the indexing process
-func (i *Indexer) Start() error {
-	i.logger.Info("Starting blockchain indexer")
-	
-	// Get last indexed block
-	lastBlock, err := i.blockRepo.GetLastIndexedBlock()
-	if err == nil {
-		i.currentBlock = lastBlock
-	} else {
-		i.currentBlock = i.config.StartBlock
-	}
-
-	// Start workers
-	for w := 0; w < i.config.MaxWorkers; w++ {
-		i.wg.Add(1)
-		go i.worker(w)
-	}
-
-	// Start sync ticker
-	ticker := time.NewTicker(i.config.SyncInterval)
-	go func() {
-		for {
-			select {
-			case <-ticker.C:
-				i.sync()
-			case <-i.stopChan:
-				ticker.Stop()
-				return
-			}
-		}
-	}()
-
-	return nil
-}
-
-// Stop halts the indexing process
-func (i *Indexer) Stop() error {
-	i.logger.Info("Stopping blockchain indexer")
-	close(i.stopChan)
-	i.wg.Wait()
-	return nil
-}
-
-func (i *Indexer) worker(id int) {
-	defer i.wg.Done()
-	i.logger.WithField("worker", id).Info("Indexer worker started")
-
-	for {
-		select {
-		case <-i.stopChan:
-			i.logger.WithField("worker", id).Info("Indexer worker stopped")
-			return
-		default:
-			// Workers pick up tasks from a queue
-			time.Sleep(100 * time.Millisecond)
-		}
-	}
-}
-
-func (i *Indexer) sync() {
-	ctx := context.Background()
-
-	// Get latest block
-	nowBlock, err := i.blockchainClient.GetNowBlock(ctx, &lindapb.EmptyMessage{})
-	if err != nil {
-		i.logger.WithError(err).Error("Failed to get now block")
-		return
-	}
-
-	latestBlock := nowBlock.BlockHeader.RawData.Number
-	i.logger.WithFields(logrus.Fields{
-		"current": i.currentBlock,
-		"latest":  latestBlock,
-	}).Info("Syncing blocks")
-
-	// Sync blocks in batches
-	for i.currentBlock < latestBlock {
-		select {
-		case <-i.stopChan:
-			return
-		default:
-			endBlock := i.currentBlock + int64(i.config.BlockBatchSize)
-			if endBlock > latestBlock {
-				endBlock = latestBlock
-			}
-
-			if err := i.syncBlockRange(ctx, i.currentBlock+1, endBlock); err != nil {
-				i.logger.WithError(err).Error("Failed to sync block range")
-				time.Sleep(5 * time.Second)
-				break
-			}
-
-			i.currentBlock = endBlock
-		}
-	}
-}
-
-func (i *Indexer) syncBlockRange(ctx context.Context, start, end int64) error {
-	i.logger.WithFields(logrus.Fields{
-		"start": start,
-		"end":   end,
-	}).Info("Syncing block range")
-
-	for blockNum := start; blockNum <= end; blockNum++ {
-		if err := i.syncBlock(ctx, blockNum); err != nil {
-			return err
-		}
-	}
-
-	return nil
-}
-
-// syncBlock fetches and indexes a single block
-func (i *Indexer) syncBlock(ctx context.Context, blockNum int64) error {
-	// Get block from full node
-	block, err := i.blockchainClient.GetBlockByNum(ctx, &lindapb.NumberMessage{
-		Num: blockNum,
-	})
-	if err != nil {
-		return err
-	}
-
-	// Get block info from solidity node (confirmed)
-	blockSolidity, err := i.blockchainClient.GetBlockByNumSolidity(ctx, &lindapb.NumberMessage{
-		Num: blockNum,
-	})
-	if err != nil {
-		// Not confirmed yet, use full node data
-		blockSolidity = block
-	}
-
-	// Index block
-	if err := i.blockIndexer.IndexBlock(blockSolidity); err != nil {
-		return err
-	}
-
-	// Get block timestamp
-	blockTimestamp := blockSolidity.BlockHeader.RawData.Timestamp
-
-	// Index transactions
-	for _, tx := range blockSolidity.Transactions {
-		// FIX: Add blockTimestamp as the 4th argument
-		if err := i.txIndexer.IndexTransaction(ctx, tx, blockNum, blockTimestamp); err != nil {
-			i.logger.WithError(err).WithField("tx", string(tx.TxID)).Error("Failed to index transaction")
-		}
-	}
-
-	// Get transaction infos
-	txInfos, err := i.blockchainClient.GetTransactionInfoByBlockNumSolidity(ctx, &lindapb.NumberMessage{
-		Num: blockNum,
-	})
-	if err == nil {
-		for _, info := range txInfos.TransactionInfo {
-			if err := i.txIndexer.IndexTransactionInfo(info); err != nil {
-				i.logger.WithError(err).Error("Failed to index transaction info")
-			}
-			
-			// Index events from transaction info
-			if err := i.eventIndexer.IndexEvents(ctx, nil, info, blockSolidity); err != nil {
-				i.logger.WithError(err).Error("Failed to index events")
-			}
-		}
-	}
-
-	return nil
+// internal/services/indexer/indexer.go
+package indexer
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/lindaprotocol/grpc-api-gateway/internal/config"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/alert"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/blockchain"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/storage/repository"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/webhook"
+	"github.com/lindaprotocol/grpc-api-gateway/pkg/lindapb"
+	"github.com/lindaprotocol/grpc-api-gateway/pkg/utils"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// errReorg is returned when a block does not extend the indexed chain
+var errReorg = errors.New("block does not extend the indexed chain")
+
+// zeroAddress is the mint/burn address of LRC-20 Transfer events
+const zeroAddress = "0x0000000000000000000000000000000000000000"
+
+// Indexer struct: Main indexer service
+type Indexer struct {
+	config           *config.IndexerConfig
+	blockchainClient *blockchain.Client
+	blockRepo        *repository.BlockRepository
+	txRepo           *repository.TransactionRepository
+	tokenRepo        *repository.TokenRepository
+	eventRepo        *repository.EventRepository
+	statsRepo        *repository.StatsRepository
+	nftRepo          *repository.NFTRepository
+	balanceRepo      *repository.BalanceRepository
+	stakingRepo      *repository.StakingRepository
+	witnessRepo      *repository.WitnessRepository
+	governanceRepo   *repository.GovernanceRepository
+	exchangeRepo     *repository.ExchangeRepository
+	webhooks         *webhook.Notifier // nil when webhooks are disabled
+	alerts           *alert.Engine     // nil when alerts are disabled
+	
+	logger           *logrus.Logger
+	stopChan         chan struct{}
+	wg               sync.WaitGroup
+	currentBlock     int64
+	solidifiedBlock  int64 // accessed atomically
+	
+	// Indexer components
+	blockIndexer      *BlockIndexer
+	txIndexer         *TransactionIndexer
+	tokenIndexer      *TokenIndexer
+	nftIndexer        *NFTIndexer
+	balanceIndexer    *BalanceIndexer
+	stakingIndexer    *StakingIndexer
+	witnessIndexer    *WitnessIndexer
+	governanceIndexer *GovernanceIndexer
+	exchangeIndexer   *ExchangeIndexer
+	contractIndexer   *ContractIndexer
+	eventIndexer      *EventIndexer
+}
+
+// NewIndexer creates a new indexer instance
+func NewIndexer(
+	cfg *config.IndexerConfig,
+	client *blockchain.Client,
+	blockRepo *repository.BlockRepository,
+	txRepo *repository.TransactionRepository,
+	tokenRepo *repository.TokenRepository,
+	eventRepo *repository.EventRepository,
+	statsRepo *repository.StatsRepository,
+	nftRepo *repository.NFTRepository,
+	balanceRepo *repository.BalanceRepository,
+	stakingRepo *repository.StakingRepository,
+	witnessRepo *repository.WitnessRepository,
+	governanceRepo *repository.GovernanceRepository,
+	exchangeRepo *repository.ExchangeRepository,
+	webhooks *webhook.Notifier,
+	alerts *alert.Engine,
+) *Indexer {
+	idx := &Indexer{
+		config:           cfg,
+		blockchainClient: client,
+		blockRepo:        blockRepo,
+		txRepo:           txRepo,
+		tokenRepo:        tokenRepo,
+		eventRepo:        eventRepo,
+		statsRepo:        statsRepo,
+		nftRepo:          nftRepo,
+		balanceRepo:      balanceRepo,
+		stakingRepo:      stakingRepo,
+		witnessRepo:      witnessRepo,
+		governanceRepo:   governanceRepo,
+		exchangeRepo:     exchangeRepo,
+		webhooks:         webhooks,
+		alerts:           alerts,
+		logger:           logrus.New(),
+		stopChan:         make(chan struct{}),
+		currentBlock:     0,
+	}
+	
+	// Initialize indexers
+	idx.blockIndexer = NewBlockIndexer(idx)
+	idx.txIndexer = NewTransactionIndexer(idx)
+	idx.tokenIndexer = NewTokenIndexer(idx)
+	idx.nftIndexer = NewNFTIndexer(idx)
+	idx.balanceIndexer = NewBalanceIndexer(idx)
+	idx.stakingIndexer = NewStakingIndexer(idx)
+	idx.witnessIndexer = NewWitnessIndexer(idx)
+	idx.governanceIndexer = NewGovernanceIndexer(idx)
+	idx.exchangeIndexer = NewExchangeIndexer(idx)
+	idx.contractIndexer = NewContractIndexer(idx)
+	idx.eventIndexer = NewEventIndexer(idx)
+	
+	return idx
+}
+
+// Start begins the indexing process
+func (i *Indexer) Start() error {
+	i.logger.Info("Starting blockchain indexer")
+	
+	// Get last indexed block
+	lastBlock, err := i.blockRepo.GetLastIndexedBlock()
+	if err == nil {
+		i.currentBlock = lastBlock
+	} else {
+		i.currentBlock = i.config.StartBlock
+	}
+
+	// Start workers
+	for w := 0; w < i.config.MaxWorkers; w++ {
+		i.wg.Add(1)
+		go i.worker(w)
+	}
+
+	// Start sync ticker
+	ticker := time.NewTicker(i.config.SyncInterval)
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				i.sync()
+			case <-i.stopChan:
+				ticker.Stop()
+				return
+			}
+		}
+	}()
+
+	// Periodically refresh the total supply of discovered LRC-20 tokens
+	if i.config.TokenSupplyRefresh > 0 {
+		supplyTicker := time.NewTicker(i.config.TokenSupplyRefresh)
+		go func() {
+			for {
+				select {
+				case <-supplyTicker.C:
+					if err := i.tokenIndexer.RefreshTotalSupplies(context.Background()); err != nil {
+						i.logger.WithError(err).Error("Failed to refresh token total supplies")
+					}
+				case <-i.stopChan:
+					supplyTicker.Stop()
+					return
+				}
+			}
+		}()
+	}
+
+	// Periodically compare a sample of holder balances with balanceOf, then
+	// recount the holders of the tokens from the corrected balances
+	if i.config.HolderReconcileInterval > 0 {
+		reconcileTicker := time.NewTicker(i.config.HolderReconcileInterval)
+		go func() {
+			for {
+				select {
+				case <-reconcileTicker.C:
+					if err := i.tokenIndexer.ReconcileHolders(context.Background(), i.config.HolderReconcileSample); err != nil {
+						i.logger.WithError(err).Error("Failed to reconcile token holder balances")
+					}
+					if err := i.tokenIndexer.UpdateTokenHolderCounts(context.Background()); err != nil {
+						i.logger.WithError(err).Error("Failed to update token holder counts")
+					}
+				case <-i.stopChan:
+					reconcileTicker.Stop()
+					return
+				}
+			}
+		}()
+	}
+
+	return nil
+}
+
+// Stop halts the indexing process
+func (i *Indexer) Stop() error {
+	i.logger.Info("Stopping blockchain indexer")
+	close(i.stopChan)
+	i.wg.Wait()
+	return nil
+}
+
+func (i *Indexer) worker(id int) {
+	defer i.wg.Done()
+	i.logger.WithField("worker", id).Info("Indexer worker started")
+
+	for {
+		select {
+		case <-i.stopChan:
+			i.logger.WithField("worker", id).Info("Indexer worker stopped")
+			return
+		default:
+			// Workers pick up tasks from a queue
+			time.Sleep(100 * time.Millisecond)
+		}
+	}
+}
+
+func (i *Indexer) sync() {
+	ctx := context.Background()
+
+	// Get latest block
+	nowBlock, err := i.blockchainClient.GetNowBlock(ctx, &lindapb.EmptyMessage{})
+	if err != nil {
+		i.logger.WithError(err).Error("Failed to get now block")
+		return
+	}
+
+	latestBlock := nowBlock.BlockHeader.RawData.Number
+
+	// Blocks above the solidified head are indexed as unconfirmed
+	solidBlock, err := i.blockchainClient.GetNowBlockSolidity(ctx, &lindapb.EmptyMessage{})
+	if err != nil {
+		i.logger.WithError(err).Error("Failed to get solidified block")
+		return
+	}
+	atomic.StoreInt64(&i.solidifiedBlock, solidBlock.BlockHeader.RawData.Number)
+
+	i.logger.WithFields(logrus.Fields{
+		"current":    i.currentBlock,
+		"latest":     latestBlock,
+		"solidified": i.solidified(),
+	}).Info("Syncing blocks")
+
+	// Drop unconfirmed blocks that left the canonical chain before extending it
+	if err := i.reconcile(ctx); err != nil {
+		i.logger.WithError(err).Error("Failed to reconcile unconfirmed blocks")
+		return
+	}
+
+	// Sync blocks in batches
+	for i.currentBlock < latestBlock {
+		select {
+		case <-i.stopChan:
+			return
+		default:
+			endBlock := i.currentBlock + int64(i.config.BlockBatchSize)
+			if endBlock > latestBlock {
+				endBlock = latestBlock
+			}
+
+			if err := i.syncBlockRange(ctx, i.currentBlock+1, endBlock); err != nil {
+				if errors.Is(err, errReorg) {
+					// Roll back and pick the canonical chain up on the next sync
+					if err := i.reconcile(ctx); err != nil {
+						i.logger.WithError(err).Error("Failed to reconcile unconfirmed blocks")
+					}
+					return
+				}
+				i.logger.WithError(err).Error("Failed to sync block range")
+				time.Sleep(5 * time.Second)
+				break
+			}
+		}
+	}
+
+	// Promote blocks the solidified head has passed
+	if err := i.promote(i.solidified()); err != nil {
+		i.logger.WithError(err).Error("Failed to confirm solidified blocks")
+	}
+}
+
+func (i *Indexer) syncBlockRange(ctx context.Context, start, end int64) error {
+	i.logger.WithFields(logrus.Fields{
+		"start": start,
+		"end":   end,
+	}).Info("Syncing block range")
+
+	// Advance per block so a failure does not replay the blocks already indexed
+	for blockNum := start; blockNum <= end; blockNum++ {
+		if err := i.syncBlock(ctx, blockNum); err != nil {
+			return err
+		}
+		i.currentBlock = blockNum
+	}
+
+	return nil
+}
+
+// syncBlock fetches and indexes a single block
+func (i *Indexer) syncBlock(ctx context.Context, blockNum int64) error {
+	// Head blocks come from the full node and are promoted once solidified
+	block, err := i.blockchainClient.GetBlockByNum(ctx, &lindapb.NumberMessage{
+		Num: blockNum,
+	})
+	if err != nil {
+		return err
+	}
+
+	// A block must extend the unconfirmed block indexed below it
+	if blockNum > 0 {
+		if parent, err := i.blockRepo.GetByNumber(blockNum - 1); err == nil && parent.Unconfirmed &&
+			parent.Hash != hex.EncodeToString(block.BlockHeader.RawData.ParentHash) {
+			return errReorg
+		}
+	}
+
+	// Index block
+	if err := i.blockIndexer.IndexBlock(block); err != nil {
+		return err
+	}
+
+	// Get block timestamp
+	blockTimestamp := block.BlockHeader.RawData.Timestamp
+
+	// Index transactions
+	i.exchangeIndexer.Reset()
+	i.contractIndexer.Reset()
+	for _, tx := range block.Transactions {
+		if err := i.txIndexer.IndexTransaction(ctx, tx, blockNum, blockTimestamp); err != nil {
+			i.logger.WithError(err).WithField("tx", string(tx.TxID)).Error("Failed to index transaction")
+		}
+	}
+
+	// Get transaction infos
+	var txInfos *lindapb.TransactionInfoList
+	if i.unconfirmed(blockNum) {
+		txInfos, err = i.blockchainClient.GetTransactionInfoByBlockNum(ctx, &lindapb.NumberMessage{
+			Num: blockNum,
+		})
+	} else {
+		txInfos, err = i.blockchainClient.GetTransactionInfoByBlockNumSolidity(ctx, &lindapb.NumberMessage{
+			Num: blockNum,
+		})
+	}
+	if err == nil {
+		logIndex := 0
+		for txIndex, info := range txInfos.TransactionInfo {
+			if err := i.txIndexer.IndexTransactionInfo(info); err != nil {
+				i.logger.WithError(err).Error("Failed to index transaction info")
+			}
+			if err := i.balanceIndexer.IndexTransactionInfo(ctx, info); err != nil {
+				i.logger.WithError(err).Error("Failed to index balance changes")
+			}
+			if err := i.stakingIndexer.IndexTransactionInfo(info); err != nil {
+				i.logger.WithError(err).Error("Failed to index reward withdrawal")
+			}
+			if err := i.exchangeIndexer.IndexTransactionInfo(ctx, info); err != nil {
+				i.logger.WithError(err).Error("Failed to index exchange activity")
+			}
+			if err := i.contractIndexer.IndexTransactionInfo(info); err != nil {
+				i.logger.WithError(err).Error("Failed to index contract call")
+			}
+
+			// Register LRC-10 assets when they are issued
+			if info.AssetIssueID != "" {
+				if err := i.tokenIndexer.IndexAssetIssue(ctx, info.AssetIssueID, hex.EncodeToString(info.Id), blockNum, blockTimestamp); err != nil {
+					i.logger.WithError(err).WithField("asset", info.AssetIssueID).Error("Failed to index asset issue")
+				}
+			}
+
+			// Discover contracts, LRC-20 tokens and NFT collections when a contract is deployed
+			if len(info.ContractAddress) > 0 && txIndex < len(block.Transactions) &&
+				isContractCreation(block.Transactions[txIndex]) {
+				contractAddr := utils.MustHexToBase58(hex.EncodeToString(info.ContractAddress))
+				if err := i.tokenIndexer.IndexContract(ctx, contractAddr, blockNum); err != nil {
+					i.logger.WithError(err).WithField("contract", contractAddr).Warn("Failed to index contract")
+				}
+				token, err := i.tokenIndexer.DetectLRC20Token(ctx, contractAddr)
+				if err != nil {
+					i.logger.WithError(err).WithField("contract", contractAddr).Warn("Failed to discover token metadata")
+				} else if token == nil {
+					if _, err := i.nftIndexer.DetectCollection(ctx, contractAddr, "", blockNum); err != nil {
+						i.logger.WithError(err).WithField("contract", contractAddr).Warn("Failed to detect NFT collection")
+					}
+				}
+			}
+			
+			// Index events from transaction info
+			if err := i.eventIndexer.IndexEvents(ctx, nil, info, block, txIndex, logIndex); err != nil {
+				i.logger.WithError(err).Error("Failed to index events")
+			}
+			logIndex += len(info.Log)
+		}
+	}
+
+	return nil
+}
+
+// isContractCreation reports whether a transaction deploys a smart contract
+func isContractCreation(tx *lindapb.Transaction) bool {
+	return tx.RawData != nil && len(tx.RawData.Contract) > 0 &&
+		int(tx.RawData.Contract[0].Type) == models.ContractTypeCreateSmartContract
+}
+
+// solidified returns the last known solidified block number
+func (i *Indexer) solidified() int64 {
+	return atomic.LoadInt64(&i.solidifiedBlock)
+}
+
+// unconfirmed reports whether a block is above the solidified head
+func (i *Indexer) unconfirmed(blockNum int64) bool {
+	return blockNum > i.solidified()
+}
+
+// reconcile compares the indexed unconfirmed blocks with the node's chain
+// and rolls back from the first one that is no longer canonical
+func (i *Indexer) reconcile(ctx context.Context) error {
+	blocks, err := i.blockRepo.GetUnconfirmedBlocks()
+	if err != nil {
+		return err
+	}
+
+	for _, indexed := range blocks {
+		block, err := i.blockchainClient.GetBlockByNum(ctx, &lindapb.NumberMessage{
+			Num: indexed.Number,
+		})
+		if err != nil {
+			return err
+		}
+		if hex.EncodeToString(block.BlockID) != indexed.Hash {
+			return i.rollback(indexed.Number)
+		}
+	}
+
+	return nil
+}
+
+// rollback removes everything indexed at and above a height so it can be
+// re-indexed from the canonical chain
+func (i *Indexer) rollback(blockNum int64) error {
+	i.logger.WithFields(logrus.Fields{
+		"from":    blockNum,
+		"current": i.currentBlock,
+	}).Warn("Chain reorganization detected, rolling back unconfirmed blocks")
+
+	// Everything is removed in one transaction, so an interrupted rollback
+	// leaves the indexed chain as it was and is detected again on the next sync
+	err := i.blockRepo.Transaction(func(tx *gorm.DB) error {
+		if i.webhooks != nil {
+			if err := i.webhooks.Retract(tx, blockNum); err != nil {
+				return err
+			}
+		}
+		tokenRepo := repository.NewTokenRepository(tx)
+		if err := tokenRepo.RevertTransfersFromBlock(blockNum, eventAddress(zeroAddress)); err != nil {
+			return err
+		}
+		if err := repository.NewNFTRepository(tx).RevertTransfersFromBlock(blockNum, eventAddress(zeroAddress)); err != nil {
+			return err
+		}
+		if err := tokenRepo.RevertAllowancesFromBlock(blockNum); err != nil {
+			return err
+		}
+		if err := tokenRepo.DeleteContractsFromBlock(blockNum); err != nil {
+			return err
+		}
+		if err := tokenRepo.DeleteLRC10TokensFromBlock(blockNum); err != nil {
+			return err
+		}
+		if err := repository.NewBalanceRepository(tx).DeleteFromBlock(blockNum); err != nil {
+			return err
+		}
+		if err := repository.NewStakingRepository(tx).DeleteFromBlock(blockNum); err != nil {
+			return err
+		}
+		if err := repository.NewWitnessRepository(tx).DeleteFromBlock(blockNum); err != nil {
+			return err
+		}
+		if err := repository.NewGovernanceRepository(tx).DeleteFromBlock(blockNum); err != nil {
+			return err
+		}
+		if err := repository.NewExchangeRepository(tx).DeleteFromBlock(blockNum); err != nil {
+			return err
+		}
+		if err := repository.NewStatsRepository(tx).DeleteContractTriggersFromBlock(blockNum); err != nil {
+			return err
+		}
+		if err := repository.NewEventRepository(tx).DeleteFromBlock(blockNum); err != nil {
+			return err
+		}
+		if err := repository.NewTransactionRepository(tx).DeleteFromBlock(blockNum); err != nil {
+			return err
+		}
+		return repository.NewBlockRepository(tx).DeleteFromBlock(blockNum)
+	})
+	if err != nil {
+		return err
+	}
+	i.tokenIndexer.ResetCache()
+	i.nftIndexer.ResetCache()
+
+	if i.currentBlock >= blockNum {
+		i.currentBlock = blockNum - 1
+	}
+	return nil
+}
+
+// promote marks blocks, transactions and events up to the solidified height as confirmed
+func (i *Indexer) promote(solidified int64) error {
+	if err := i.eventRepo.ConfirmUpTo(solidified); err != nil {
+		return err
+	}
+	if err := i.txRepo.ConfirmUpTo(solidified); err != nil {
+		return err
+	}
+	return i.blockRepo.ConfirmUpTo(solidified)
 }
\ No newline at end of file
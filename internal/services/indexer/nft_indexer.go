@@ -0,0 +1,275 @@
+// internal/services/indexer/nft_indexer.go
+package indexer
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/lru"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
+	"github.com/lindaprotocol/grpc-api-gateway/pkg/lindapb"
+	"github.com/lindaprotocol/grpc-api-gateway/pkg/utils"
+)
+
+// Interface IDs queried through supportsInterface(bytes4)
+const (
+	interfaceIDLRC165  = "01ffc9a7"
+	interfaceIDInvalid = "ffffffff"
+	interfaceIDLRC721  = "80ac58cd"
+	interfaceIDLRC1155 = "d9b67a26"
+)
+
+// NFTIndexer struct: Indexer for LRC-721 and LRC-1155 transfers
+type NFTIndexer struct {
+	indexer *Indexer
+
+	// Detected collections by base58 contract address, nil for contracts
+	// that are not NFT collections
+	collections *lru.Cache[string, *models.NFTCollection]
+}
+
+// NewNFTIndexer creates a new NFT indexer
+func NewNFTIndexer(indexer *Indexer) *NFTIndexer {
+	return &NFTIndexer{
+		indexer:     indexer,
+		collections: lru.NewCache[string, *models.NFTCollection](contractCacheSize),
+	}
+}
+
+// IsNFTTransfer reports whether an event moves LRC-721 or LRC-1155 tokens
+func (ni *NFTIndexer) IsNFTTransfer(event *models.EventResponse) bool {
+	switch event.EventName {
+	case "Transfer":
+		_, ok := event.Result["tokenId"]
+		return ok
+	case "TransferSingle", "TransferBatch":
+		return true
+	}
+	return false
+}
+
+// IndexTransfer indexes an LRC-721 Transfer or an LRC-1155 TransferSingle/TransferBatch event
+func (ni *NFTIndexer) IndexTransfer(ctx context.Context, event *models.EventResponse) error {
+	from, ok := event.Result["from"].(string)
+	if !ok {
+		return nil
+	}
+	to, ok := event.Result["to"].(string)
+	if !ok {
+		return nil
+	}
+	operator, _ := event.Result["operator"].(string)
+
+	// Token ids and the amount moved of each
+	var standard string
+	var ids, values []string
+	switch event.EventName {
+	case "Transfer":
+		standard = models.NFTStandardLRC721
+		if id, ok := event.Result["tokenId"].(string); ok {
+			ids, values = []string{id}, []string{"1"}
+		}
+	case "TransferSingle":
+		standard = models.NFTStandardLRC1155
+		id, idOK := event.Result["id"].(string)
+		value, valueOK := event.Result["value"].(string)
+		if idOK && valueOK {
+			ids, values = []string{id}, []string{value}
+		}
+	case "TransferBatch":
+		standard = models.NFTStandardLRC1155
+		ids, _ = event.Result["ids"].([]string)
+		values, _ = event.Result["values"].([]string)
+	}
+	if len(ids) == 0 || len(ids) != len(values) {
+		return nil
+	}
+
+	collection, err := ni.DetectCollection(ctx, event.ContractAddress, standard, event.BlockNumber)
+	if err != nil {
+		return err
+	}
+	if collection == nil {
+		return nil
+	}
+
+	for n, id := range ids {
+		if err := ni.ensureToken(ctx, collection, id, event.BlockNumber); err != nil {
+			ni.indexer.logger.WithError(err).WithField("contract", collection.Contract).Warn("Failed to save NFT token")
+		}
+
+		transfer := &models.NFTTransfer{
+			TransactionID:  event.TransactionID,
+			BlockNumber:    event.BlockNumber,
+			BlockTimestamp: event.BlockTimestamp,
+			LogIndex:       event.LogIndex,
+			Contract:       collection.Contract,
+			Standard:       collection.Standard,
+			TokenID:        id,
+			From:           eventAddress(from),
+			To:             eventAddress(to),
+			Value:          values[n],
+		}
+		if operator != "" {
+			transfer.Operator = eventAddress(operator)
+		}
+		if err := ni.indexer.nftRepo.ApplyTransfer(transfer, eventAddress(zeroAddress)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DetectCollection returns the collection of a contract, detecting its standard
+// through supportsInterface the first time it is seen at a height. Contracts without
+// supportsInterface fall back to the standard implied by their events, pass an
+// empty hint to require it. It returns nil for contracts that are not NFT collections.
+// Node errors are returned and not cached, so the contract is probed again.
+func (ni *NFTIndexer) DetectCollection(ctx context.Context, contractAddr, hint string, blockNum int64) (*models.NFTCollection, error) {
+	contract := utils.MustHexToBase58(contractAddr)
+
+	if collection, seen := ni.collections.Get(contract); seen {
+		return collection, nil
+	}
+
+	if collection, err := ni.indexer.nftRepo.GetCollection(contract); err == nil {
+		ni.collections.Add(contract, collection)
+		return collection, nil
+	}
+
+	address, err := contractAddressBytes(contract)
+	if err != nil {
+		return nil, err
+	}
+
+	standard, err := ni.detectStandard(ctx, address, hint)
+	if err != nil {
+		return nil, err
+	}
+	if standard == "" {
+		ni.collections.Add(contract, nil)
+		return nil, nil
+	}
+
+	collection := &models.NFTCollection{
+		Contract:    contract,
+		Standard:    standard,
+		BlockNumber: blockNum,
+	}
+	tokens := ni.indexer.tokenIndexer
+	if data, err := tokens.callConstant(ctx, address, "name()", ""); err == nil {
+		collection.Name = truncateRunes(decodeABIString(data), 100)
+	}
+	if data, err := tokens.callConstant(ctx, address, "symbol()", ""); err == nil {
+		collection.Symbol = truncateRunes(decodeABIString(data), 20)
+	}
+	if info, err := ni.indexer.blockchainClient.GetContract(ctx, &lindapb.BytesMessage{
+		Value: address,
+	}); err == nil && len(info.OriginAddress) > 0 {
+		collection.Owner = utils.MustHexToBase58(hex.EncodeToString(info.OriginAddress))
+	}
+
+	if err := ni.indexer.nftRepo.SaveCollection(collection); err != nil {
+		return nil, err
+	}
+	ni.collections.Add(contract, collection)
+	return collection, nil
+}
+
+// detectStandard queries supportsInterface for the LRC-721 and LRC-1155
+// interface IDs, returning the hint for contracts without LRC-165 support
+func (ni *NFTIndexer) detectStandard(ctx context.Context, address []byte, hint string) (string, error) {
+	lrc165, err := ni.supportsInterface(ctx, address, interfaceIDLRC165)
+	if err != nil {
+		return "", err
+	}
+	if lrc165 {
+		// A contract answering true for 0xffffffff does not implement LRC-165
+		lrc165, err = ni.supportsInterface(ctx, address, interfaceIDInvalid)
+		if err != nil {
+			return "", err
+		}
+		lrc165 = !lrc165
+	}
+	if !lrc165 {
+		return hint, nil
+	}
+
+	for _, candidate := range []struct{ id, standard string }{
+		{interfaceIDLRC721, models.NFTStandardLRC721},
+		{interfaceIDLRC1155, models.NFTStandardLRC1155},
+	} {
+		supported, err := ni.supportsInterface(ctx, address, candidate.id)
+		if err != nil {
+			return "", err
+		}
+		if supported {
+			return candidate.standard, nil
+		}
+	}
+	return "", nil
+}
+
+// ResetCache drops the detected collections, after a rollback removed some of them
+func (ni *NFTIndexer) ResetCache() {
+	ni.collections.Purge()
+}
+
+// ensureToken saves a token id the first time it is seen, with the metadata
+// URI returned by tokenURI (LRC-721) or uri (LRC-1155)
+func (ni *NFTIndexer) ensureToken(ctx context.Context, collection *models.NFTCollection, tokenID string, blockNum int64) error {
+	known, err := ni.indexer.nftRepo.HasToken(collection.Contract, tokenID)
+	if err != nil || known {
+		return err
+	}
+
+	token := &models.NFTToken{
+		Contract:    collection.Contract,
+		TokenID:     tokenID,
+		BlockNumber: blockNum,
+	}
+	if uri, err := ni.fetchTokenURI(ctx, collection, tokenID); err == nil {
+		token.URI = uri
+	}
+	return ni.indexer.nftRepo.CreateToken(token)
+}
+
+// fetchTokenURI reads the metadata URI of a token id
+func (ni *NFTIndexer) fetchTokenURI(ctx context.Context, collection *models.NFTCollection, tokenID string) (string, error) {
+	id, ok := new(big.Int).SetString(tokenID, 10)
+	if !ok {
+		return "", fmt.Errorf("invalid token id %s", tokenID)
+	}
+	address, err := contractAddressBytes(collection.Contract)
+	if err != nil {
+		return "", err
+	}
+
+	selector := "tokenURI(uint256)"
+	if collection.Standard == models.NFTStandardLRC1155 {
+		selector = "uri(uint256)"
+	}
+	data, err := ni.indexer.tokenIndexer.callConstant(ctx, address, selector, fmt.Sprintf("%064x", id))
+	if err != nil {
+		return "", err
+	}
+	return decodeABIString(data), nil
+}
+
+// supportsInterface calls supportsInterface(bytes4). A reverted call counts as
+// unsupported, node errors are returned.
+func (ni *NFTIndexer) supportsInterface(ctx context.Context, address []byte, interfaceID string) (bool, error) {
+	data, err := ni.indexer.tokenIndexer.callConstant(ctx, address, "supportsInterface(bytes4)", interfaceID+strings.Repeat("0", 56))
+	if err != nil {
+		if errors.Is(err, errCallReverted) {
+			return false, nil
+		}
+		return false, err
+	}
+	return new(big.Int).SetBytes(data[:32]).Sign() != 0, nil
+}
@@ -0,0 +1,135 @@
+// internal/services/indexer/staking_indexer.go
+package indexer
+
+import (
+	"encoding/hex"
+	"time"
+
+	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
+	"github.com/lindaprotocol/grpc-api-gateway/pkg/lindapb"
+	"google.golang.org/protobuf/proto"
+)
+
+// defaultDelegationLock is the lock period of locked delegations that do not set one
+const defaultDelegationLock = 3 * 24 * time.Hour
+
+// StakingIndexer struct: Indexer for staking, delegation, voting and reward withdrawal actions
+type StakingIndexer struct {
+	indexer *Indexer
+}
+
+// NewStakingIndexer creates a new staking indexer
+func NewStakingIndexer(indexer *Indexer) *StakingIndexer {
+	return &StakingIndexer{
+		indexer: indexer,
+	}
+}
+
+// IndexTransaction records the staking action of a successful transaction
+// from its decoded contract
+func (si *StakingIndexer) IndexTransaction(tx *models.Transaction, contract proto.Message) error {
+	repo := si.indexer.stakingRepo
+	txID := hex.EncodeToString([]byte(tx.Hash))
+
+	switch c := contract.(type) {
+	case *lindapb.FreezeBalanceV2Contract:
+		return repo.SaveStakeAction(si.stakeAction(tx, txID, models.StakeActionFreeze, c.Resource, c.FrozenBalance))
+
+	case *lindapb.UnfreezeBalanceV2Contract:
+		return repo.SaveStakeAction(si.stakeAction(tx, txID, models.StakeActionUnfreeze, c.Resource, c.UnfreezeBalance))
+
+	case *lindapb.DelegateResourceContract:
+		action := si.delegationAction(tx, txID, models.StakeActionDelegate, c.Resource, c.Balance, c.ReceiverAddress)
+		if c.Lock {
+			period := time.Duration(c.LockPeriod) * models.BlockInterval
+			if period <= 0 {
+				period = defaultDelegationLock
+			}
+			action.Lock = true
+			action.ExpireAt = tx.BlockTimestamp + period.Milliseconds()
+		}
+		return repo.SaveDelegationAction(action)
+
+	case *lindapb.UnDelegateResourceContract:
+		return repo.SaveDelegationAction(si.delegationAction(tx, txID, models.StakeActionUndelegate, c.Resource, c.Balance, c.ReceiverAddress))
+
+	case *lindapb.VoteWitnessContract:
+		votes := make([]*models.VoteAction, 0, len(c.Votes))
+		for _, vote := range c.Votes {
+			votes = append(votes, &models.VoteAction{
+				TransactionID:  txID,
+				BlockNumber:    tx.BlockNumber,
+				BlockTimestamp: tx.BlockTimestamp,
+				Voter:          tx.FromAddress,
+				Witness:        addressBase58(vote.VoteAddress),
+				Votes:          vote.VoteCount,
+				CreatedAt:      time.Now(),
+			})
+		}
+		return repo.SaveVoteActions(votes)
+	}
+
+	return nil
+}
+
+func (si *StakingIndexer) stakeAction(tx *models.Transaction, txID, action string, resource lindapb.ResourceCode, amount int64) *models.StakeAction {
+	return &models.StakeAction{
+		TransactionID:  txID,
+		BlockNumber:    tx.BlockNumber,
+		BlockTimestamp: tx.BlockTimestamp,
+		Owner:          tx.FromAddress,
+		Action:         action,
+		Resource:       stakeResource(resource),
+		Amount:         amount,
+		CreatedAt:      time.Now(),
+	}
+}
+
+func (si *StakingIndexer) delegationAction(tx *models.Transaction, txID, action string, resource lindapb.ResourceCode, amount int64, receiver []byte) *models.DelegationAction {
+	return &models.DelegationAction{
+		TransactionID:  txID,
+		BlockNumber:    tx.BlockNumber,
+		BlockTimestamp: tx.BlockTimestamp,
+		Owner:          tx.FromAddress,
+		Receiver:       addressBase58(receiver),
+		Action:         action,
+		Resource:       stakeResource(resource),
+		Amount:         amount,
+		CreatedAt:      time.Now(),
+	}
+}
+
+// IndexTransactionInfo records the reward paid out by a WithdrawBalance
+// transaction, which is only known from its receipt
+func (si *StakingIndexer) IndexTransactionInfo(info *lindapb.TransactionInfo) error {
+	if info.WithdrawAmount <= 0 {
+		return nil
+	}
+	tx, err := si.indexer.txRepo.GetByHash(string(info.Id))
+	if err != nil {
+		return err
+	}
+	if tx.ContractType != models.ContractTypeWithdrawBalance {
+		return nil
+	}
+
+	return si.indexer.stakingRepo.SaveRewardWithdrawal(&models.RewardWithdrawal{
+		TransactionID:  hex.EncodeToString(info.Id),
+		BlockNumber:    tx.BlockNumber,
+		BlockTimestamp: tx.BlockTimestamp,
+		Owner:          tx.FromAddress,
+		Amount:         info.WithdrawAmount,
+		CreatedAt:      time.Now(),
+	})
+}
+
+// stakeResource returns the name of the resource of a staking contract
+func stakeResource(resource lindapb.ResourceCode) string {
+	switch int(resource) {
+	case models.ResourceEnergy:
+		return "ENERGY"
+	case models.ResourceLindaPower:
+		return "LINDA_POWER"
+	}
+	return "BANDWIDTH"
+}
@@ -1,201 +1,648 @@
-// internal/services/indexer/token_indexer.go
-package indexer
-
-import (
-	"context"
-	"math/big"
-	"time"
-
-	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
-	"github.com/lindaprotocol/grpc-api-gateway/pkg/lindapb"
-	"github.com/lindaprotocol/grpc-api-gateway/pkg/utils"
-)
-
-// TokenIndexer struct: Indexer for token operations
-type TokenIndexer struct {
-	indexer *Indexer
-}
-
-// NewTokenIndexer creates a new token indexer
-func NewTokenIndexer(indexer *Indexer) *TokenIndexer {
-	return &TokenIndexer{
-		indexer: indexer,
-	}
-}
-
-// IndexLRC20Token indexes or updates an LRC20 token
-func (ti *TokenIndexer) IndexLRC20Token(ctx context.Context, contractAddr string) error {
-	// Get contract info
-	contract, err := ti.indexer.blockchainClient.GetContract(ctx, &lindapb.BytesMessage{
-		Value: []byte(contractAddr),
-	})
-	if err != nil {
-		return err
-	}
-
-	token := &models.LRC20TokenInfo{
-		Contract:    utils.MustHexToBase58(contractAddr),
-		Name:        "Unknown",
-		Symbol:      "UNKNOWN",
-		Decimals:    18,
-		TotalSupply: "0",
-		Owner:       utils.MustHexToBase58(string(contract.OriginAddress)),
-		IssueTime:   time.Now().Unix(),
-		Holders:     0,
-		Transfers:   0,
-	}
-
-	return ti.indexer.tokenRepo.SaveLRC20Token(token)
-}
-
-// IndexTokenTransfer indexes a token transfer event
-func (ti *TokenIndexer) IndexTokenTransfer(ctx context.Context, event *models.EventResponse) error {
-	// Parse transfer event
-	if event.EventName != "Transfer" {
-		return nil
-	}
-
-	from, ok := event.Result["from"].(string)
-	if !ok {
-		return nil
-	}
-	to, ok := event.Result["to"].(string)
-	if !ok {
-		return nil
-	}
-	value, ok := event.Result["value"].(string)
-	if !ok {
-		return nil
-	}
-
-	// Update token holders
-	if err := ti.updateTokenHolder(event.ContractAddress, from, to, value); err != nil {
-		ti.indexer.logger.WithError(err).Error("Failed to update token holders")
-		return err
-	}
-
-	// Save transfer to repository
-	transfer := &models.TokenTransferResponse{
-		TransactionID:  event.TransactionID,
-		BlockNumber:    event.BlockNumber,
-		BlockTimestamp: event.BlockTimestamp,
-		From:           utils.MustHexToBase58(from),
-		To:             utils.MustHexToBase58(to),
-		Value:          value,
-		TokenAddress:   utils.MustHexToBase58(event.ContractAddress),
-		TokenSymbol:    "",
-		TokenDecimals:  18,
-	}
-	
-	// Note: You'll need to implement SaveTokenTransfer in tokenRepo
-	return ti.indexer.tokenRepo.SaveTokenTransfer(transfer)
-}
-
-// updateTokenHolder updates token holder balances
-func (ti *TokenIndexer) updateTokenHolder(contractAddr, from, to, value string) error {
-	valueBig, ok := new(big.Int).SetString(value, 10)
-	if !ok {
-		return nil
-	}
-
-	// Decrease from balance
-	if from != "0x0000000000000000000000000000000000000000" {
-		fromBase58 := utils.MustHexToBase58(from)
-		if err := ti.indexer.tokenRepo.UpdateHolderBalance(contractAddr, fromBase58, new(big.Int).Neg(valueBig)); err != nil {
-			return err
-		}
-	}
-
-	// Increase to balance
-	if to != "0x0000000000000000000000000000000000000000" {
-		toBase58 := utils.MustHexToBase58(to)
-		if err := ti.indexer.tokenRepo.UpdateHolderBalance(contractAddr, toBase58, valueBig); err != nil {
-			return err
-		}
-	}
-
-	return nil
-}
-
-// IndexLRC10Token indexes a LRC10 token
-func (ti *TokenIndexer) IndexLRC10Token(ctx context.Context, tokenID string) error {
-    asset, err := ti.indexer.blockchainClient.GetAssetIssueById(ctx, &lindapb.BytesMessage{
-        Value: []byte(tokenID),
-    })
-    if err != nil {
-        return err
-    }
-
-    // Convert to token model
-    token := &models.TokenInfo{
-        ID:          tokenID, // Use the tokenID parameter, not asset.Id
-        Name:        string(asset.Name),
-        Symbol:      string(asset.Abbr),
-        TotalSupply: asset.TotalSupply,
-        Owner:       utils.MustHexToBase58(string(asset.OwnerAddress)),
-        Decimals:    int(asset.Precision),
-        StartTime:   asset.StartTime,
-        EndTime:     asset.EndTime,
-        URL:         string(asset.Url),
-        Description: string(asset.Description),
-    }
-
-    return ti.indexer.tokenRepo.SaveLRC10Token(token)
-}
-
-// UpdateTokenHolderCounts updates holder counts for all tokens
-func (ti *TokenIndexer) UpdateTokenHolderCounts(ctx context.Context) error {
-	tokens, _, err := ti.indexer.tokenRepo.GetLRC20Tokens(0, 1000, "")
-	if err != nil {
-		return err
-	}
-
-	for _, token := range tokens {
-		count, err := ti.indexer.tokenRepo.GetHolderCount(token.Contract)
-		if err != nil {
-			continue
-		}
-		if err := ti.indexer.tokenRepo.UpdateHolderCount(token.Contract, count); err != nil {
-			ti.indexer.logger.WithError(err).WithField("token", token.Contract).Error("Failed to update holder count")
-		}
-	}
-
-	return nil
-}
-
-// CalculateTokenPercentages calculates and updates holder percentages
-func (ti *TokenIndexer) CalculateTokenPercentages(ctx context.Context, contractAddr string) error {
-	holders, _, err := ti.indexer.tokenRepo.GetTokenHolders(contractAddr, 0, 1000, "-balance")
-	if err != nil {
-		return err
-	}
-
-	token, err := ti.indexer.tokenRepo.GetLRC20TokenByContract(contractAddr)
-	if err != nil {
-		return err
-	}
-
-	totalSupply, ok := new(big.Int).SetString(token.TotalSupply, 10)
-	if !ok || totalSupply.Sign() == 0 {
-		return nil
-	}
-
-	for _, holder := range holders {
-		balance, ok := new(big.Int).SetString(holder.Balance, 10)
-		if !ok {
-			continue
-		}
-		percentage := new(big.Float).Quo(
-			new(big.Float).SetInt(balance),
-			new(big.Float).SetInt(totalSupply),
-		)
-		percentageFloat, _ := percentage.Float64()
-		holder.Percentage = percentageFloat * 100
-
-		if err := ti.indexer.tokenRepo.UpdateHolderPercentage(contractAddr, holder.Address, holder.Percentage); err != nil {
-			ti.indexer.logger.WithError(err).Error("Failed to update holder percentage")
-		}
-	}
-
-	return nil
+// internal/services/indexer/token_indexer.go
+package indexer
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/ethereum/go-ethereum/common/lru"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
+	"github.com/lindaprotocol/grpc-api-gateway/pkg/lindapb"
+	"github.com/lindaprotocol/grpc-api-gateway/pkg/utils"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	// errNotLRC20 is returned when a contract does not answer the LRC-20 constant calls
+	errNotLRC20 = errors.New("contract is not an LRC-20 token")
+	// errCallReverted is returned when a constant call reverts or returns no
+	// data, as opposed to failing to reach the node
+	errCallReverted = errors.New("constant call reverted")
+)
+
+// contractCacheSize bounds the number of contracts and assets whose metadata is cached
+const contractCacheSize = 10000
+
+// TokenIndexer struct: Indexer for token operations
+type TokenIndexer struct {
+	indexer *Indexer
+
+	// Discovered LRC-20 metadata by base58 contract address, nil for
+	// contracts that are not LRC-20 tokens, and LRC-10 metadata by token ID
+	tokens *lru.Cache[string, *models.LRC20TokenInfo]
+	assets *lru.Cache[string, *models.TokenInfo]
+}
+
+// NewTokenIndexer creates a new token indexer
+func NewTokenIndexer(indexer *Indexer) *TokenIndexer {
+	return &TokenIndexer{
+		indexer: indexer,
+		tokens:  lru.NewCache[string, *models.LRC20TokenInfo](contractCacheSize),
+		assets:  lru.NewCache[string, *models.TokenInfo](contractCacheSize),
+	}
+}
+
+// IndexLRC20Token reads the metadata of an LRC20 token from the contract and saves it
+func (ti *TokenIndexer) IndexLRC20Token(ctx context.Context, contractAddr string) error {
+	token, err := ti.fetchLRC20Token(ctx, contractAddr)
+	if err != nil {
+		return err
+	}
+
+	if err := ti.indexer.tokenRepo.SaveLRC20Token(token); err != nil {
+		return err
+	}
+
+	ti.tokens.Add(token.Contract, token)
+	return nil
+}
+
+// DetectLRC20Token returns the metadata of a contract, discovering and saving
+// it the first time the contract is seen. It returns nil for contracts that
+// are not LRC-20 tokens. Node errors are returned and not cached, so the
+// contract is probed again the next time it is seen.
+func (ti *TokenIndexer) DetectLRC20Token(ctx context.Context, contractAddr string) (*models.LRC20TokenInfo, error) {
+	contract := utils.MustHexToBase58(contractAddr)
+
+	if token, seen := ti.tokens.Get(contract); seen {
+		return token, nil
+	}
+
+	if token, err := ti.indexer.tokenRepo.GetLRC20TokenByContract(contract); err == nil {
+		ti.tokens.Add(contract, token)
+		return token, nil
+	}
+
+	if err := ti.IndexLRC20Token(ctx, contract); err != nil {
+		if errors.Is(err, errNotLRC20) {
+			ti.tokens.Add(contract, nil)
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	token, _ := ti.tokens.Get(contract)
+	return token, nil
+}
+
+// IndexContract saves the name and owner of a contract deployed at a height so it can be searched
+func (ti *TokenIndexer) IndexContract(ctx context.Context, contractAddr string, blockNum int64) error {
+	address, err := contractAddressBytes(contractAddr)
+	if err != nil {
+		return err
+	}
+	contract, err := ti.indexer.blockchainClient.GetContract(ctx, &lindapb.BytesMessage{Value: address})
+	if err != nil {
+		return err
+	}
+
+	return ti.indexer.tokenRepo.SaveContract(&models.Contract{
+		Address:     utils.MustHexToBase58(contractAddr),
+		Name:        truncateRunes(cleanTokenText([]byte(contract.Name)), 100),
+		Owner:       utils.MustHexToBase58(hex.EncodeToString(contract.OriginAddress)),
+		BlockNumber: blockNum,
+		CreatedAt:   time.Now(),
+	})
+}
+
+// RefreshTotalSupplies re-reads totalSupply() of the known LRC20 tokens
+func (ti *TokenIndexer) RefreshTotalSupplies(ctx context.Context) error {
+	return ti.forEachLRC20Token(func(token *models.LRC20TokenInfo) {
+		address, err := contractAddressBytes(token.Contract)
+		if err != nil {
+			return
+		}
+		data, err := ti.callConstant(ctx, address, "totalSupply()", "")
+		if err != nil {
+			ti.indexer.logger.WithError(err).WithField("token", token.Contract).Warn("Failed to read total supply")
+			return
+		}
+
+		totalSupply := new(big.Int).SetBytes(data[:32]).String()
+		if totalSupply == token.TotalSupply {
+			return
+		}
+		if err := ti.indexer.tokenRepo.UpdateLRC20TotalSupply(token.Contract, totalSupply); err != nil {
+			ti.indexer.logger.WithError(err).WithField("token", token.Contract).Error("Failed to update total supply")
+			return
+		}
+
+		// Replace rather than modify the cached metadata, which may be in use
+		if cached, ok := ti.tokens.Peek(token.Contract); ok && cached != nil {
+			updated := *cached
+			updated.TotalSupply = totalSupply
+			ti.tokens.Add(token.Contract, &updated)
+		}
+	})
+}
+
+// lrc20TokenPageSize is the number of tokens read per page when walking all LRC20 tokens
+const lrc20TokenPageSize = 500
+
+// forEachLRC20Token calls fn for every indexed LRC20 token, reading them a page at a time
+func (ti *TokenIndexer) forEachLRC20Token(fn func(token *models.LRC20TokenInfo)) error {
+	for offset := 0; ; offset += lrc20TokenPageSize {
+		tokens, _, err := ti.indexer.tokenRepo.GetLRC20Tokens(offset, lrc20TokenPageSize, "id")
+		if err != nil {
+			return err
+		}
+		for _, token := range tokens {
+			fn(token)
+		}
+		if len(tokens) < lrc20TokenPageSize {
+			return nil
+		}
+	}
+}
+
+// fetchLRC20Token reads name(), symbol(), decimals() and totalSupply() from a
+// contract. decimals() and totalSupply() are required, name() and symbol()
+// are optional in the standard. A contract is only reported as not being an
+// LRC-20 token when a required call reverts or returns nothing.
+func (ti *TokenIndexer) fetchLRC20Token(ctx context.Context, contractAddr string) (*models.LRC20TokenInfo, error) {
+	address, err := contractAddressBytes(contractAddr)
+	if err != nil {
+		return nil, err
+	}
+	contract := utils.MustHexToBase58(hex.EncodeToString(address))
+
+	decimals, err := ti.callConstant(ctx, address, "decimals()", "")
+	if err != nil {
+		return nil, notLRC20(err)
+	}
+	totalSupply, err := ti.callConstant(ctx, address, "totalSupply()", "")
+	if err != nil {
+		return nil, notLRC20(err)
+	}
+
+	token := &models.LRC20TokenInfo{
+		Contract:    contract,
+		Decimals:    int32(new(big.Int).SetBytes(decimals[:32]).Uint64() & 0xff),
+		TotalSupply: new(big.Int).SetBytes(totalSupply[:32]).String(),
+		IssueTime:   time.Now().Unix(),
+	}
+	if data, err := ti.callConstant(ctx, address, "name()", ""); err == nil {
+		token.Name = truncateRunes(decodeABIString(data), 100)
+	}
+	if data, err := ti.callConstant(ctx, address, "symbol()", ""); err == nil {
+		token.Symbol = truncateRunes(decodeABIString(data), 20)
+	}
+
+	if info, err := ti.indexer.blockchainClient.GetContract(ctx, &lindapb.BytesMessage{
+		Value: address,
+	}); err == nil && len(info.OriginAddress) > 0 {
+		token.Owner = utils.MustHexToBase58(hex.EncodeToString(info.OriginAddress))
+	}
+
+	// Keep the counters and issue time of a token that is re-indexed
+	if existing, err := ti.indexer.tokenRepo.GetLRC20TokenByContract(contract); err == nil {
+		token.ID = existing.ID
+		token.IssueTime = existing.IssueTime
+		token.Holders = existing.Holders
+		token.Transfers = existing.Transfers
+		token.CreatedAt = existing.CreatedAt
+		if token.Owner == "" {
+			token.Owner = existing.Owner
+		}
+	}
+
+	return token, nil
+}
+
+// notLRC20 marks a reverted required call as errNotLRC20 and passes node errors through
+func notLRC20(err error) error {
+	if errors.Is(err, errCallReverted) {
+		return fmt.Errorf("%w: %v", errNotLRC20, err)
+	}
+	return err
+}
+
+// callConstant calls a view function with hex ABI encoded arguments and returns the ABI encoded result.
+// Reverts and empty results are reported as errCallReverted.
+func (ti *TokenIndexer) callConstant(ctx context.Context, address []byte, selector, parameter string) ([]byte, error) {
+	result, err := ti.indexer.blockchainClient.TriggerConstantContract(ctx, &lindapb.TriggerSmartContractReq{
+		OwnerAddress:     address,
+		ContractAddress:  address,
+		FunctionSelector: selector,
+		Parameter:        parameter,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.Result != nil && !result.Result.Result {
+		return nil, fmt.Errorf("%w: %s failed: %s", errCallReverted, selector, string(result.Result.Message))
+	}
+	if len(result.ConstantResult) == 0 || len(result.ConstantResult[0]) < 32 {
+		return nil, fmt.Errorf("%w: %s returned no data", errCallReverted, selector)
+	}
+	return result.ConstantResult[0], nil
+}
+
+// contractAddressBytes converts a base58 or hex contract address to its 21 byte form
+func contractAddressBytes(contractAddr string) ([]byte, error) {
+	hexAddr := strings.TrimPrefix(contractAddr, "0x")
+	if !utils.IsValidHexAddress(hexAddr) {
+		if len(hexAddr) == 40 {
+			hexAddr = "30" + hexAddr
+		} else {
+			converted, err := utils.Base58ToHex(contractAddr)
+			if err != nil {
+				return nil, err
+			}
+			hexAddr = converted
+		}
+	}
+	return hex.DecodeString(hexAddr)
+}
+
+// decodeABIString decodes a string return value. Legacy tokens return a
+// zero padded bytes32 instead of a dynamic string.
+func decodeABIString(data []byte) string {
+	if len(data) >= 64 {
+		offset := new(big.Int).SetBytes(data[:32])
+		if offset.IsUint64() && offset.Uint64()+32 <= uint64(len(data)) {
+			start := offset.Uint64() + 32
+			length := new(big.Int).SetBytes(data[start-32 : start])
+			if length.IsUint64() && length.Uint64() <= uint64(len(data))-start {
+				return cleanTokenText(data[start : start+length.Uint64()])
+			}
+		}
+	}
+	return cleanTokenText(bytes.TrimRight(data[:32], "\x00"))
+}
+
+// cleanTokenText drops invalid UTF-8, NUL bytes and surrounding spaces
+func cleanTokenText(data []byte) string {
+	text := strings.ToValidUTF8(string(data), "")
+	text = strings.ReplaceAll(text, "\x00", "")
+	return strings.TrimSpace(text)
+}
+
+// truncateRunes shortens a string to at most n bytes without splitting a rune
+func truncateRunes(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
+
+// IndexTokenTransfer indexes a token transfer event
+func (ti *TokenIndexer) IndexTokenTransfer(ctx context.Context, event *models.EventResponse) error {
+	// Parse transfer event
+	if event.EventName != "Transfer" {
+		return nil
+	}
+
+	from, ok := event.Result["from"].(string)
+	if !ok {
+		return nil
+	}
+	to, ok := event.Result["to"].(string)
+	if !ok {
+		return nil
+	}
+	value, ok := event.Result["value"].(string)
+	if !ok {
+		return nil
+	}
+
+	// Discover the token the first time one of its transfers is seen
+	token, err := ti.DetectLRC20Token(ctx, event.ContractAddress)
+	if err != nil {
+		ti.indexer.logger.WithError(err).WithField("contract", event.ContractAddress).Warn("Failed to discover token metadata")
+	}
+
+	transfer := &models.TokenTransferResponse{
+		TransactionID:  event.TransactionID,
+		BlockNumber:    event.BlockNumber,
+		BlockTimestamp: event.BlockTimestamp,
+		From:           eventAddress(from),
+		To:             eventAddress(to),
+		Value:          value,
+		TokenAddress:   event.ContractAddress,
+		LogIndex:       event.LogIndex,
+	}
+	if token != nil {
+		transfer.TokenSymbol = token.Symbol
+		transfer.TokenDecimals = token.Decimals
+	}
+
+	// Save the transfer and move the holder balances together
+	if err := ti.indexer.tokenRepo.ApplyTokenTransfer(transfer, eventAddress(zeroAddress)); err != nil {
+		ti.indexer.logger.WithError(err).Error("Failed to update token holders")
+		return err
+	}
+
+	// Evaluate watchlist alert rules
+	if ti.indexer.alerts != nil {
+		ti.indexer.alerts.CheckTokenTransfer(ctx, transfer)
+	}
+
+	return nil
+}
+
+// IndexApproval sets the allowance recorded by an LRC20 Approval event
+func (ti *TokenIndexer) IndexApproval(ctx context.Context, event *models.EventResponse) error {
+	owner, ok := event.Result["owner"].(string)
+	if !ok {
+		return nil
+	}
+	spender, ok := event.Result["spender"].(string)
+	if !ok {
+		return nil
+	}
+	// LRC-721 approvals carry an indexed token id and no value
+	value, ok := event.Result["value"].(string)
+	if !ok {
+		return nil
+	}
+
+	return ti.indexer.tokenRepo.SaveAllowance(&models.TokenAllowance{
+		Owner:          eventAddress(owner),
+		Spender:        eventAddress(spender),
+		Contract:       event.ContractAddress,
+		Allowance:      value,
+		TransactionID:  event.TransactionID,
+		BlockNumber:    event.BlockNumber,
+		BlockTimestamp: event.BlockTimestamp,
+	})
+}
+
+// IndexTransferFrom decreases the allowance spent by an LRC20 transfer that
+// was not sent by its owner. spenders are the possible callers of
+// transferFrom: the transaction sender and the contracts it called.
+func (ti *TokenIndexer) IndexTransferFrom(ctx context.Context, event *models.EventResponse, spenders []string) error {
+	from, ok := event.Result["from"].(string)
+	if !ok || from == zeroAddress {
+		return nil
+	}
+	value, ok := event.Result["value"].(string)
+	if !ok {
+		return nil
+	}
+	valueBig, ok := new(big.Int).SetString(value, 10)
+	if !ok || valueBig.Sign() == 0 {
+		return nil
+	}
+
+	owner := eventAddress(from)
+	candidates := make([]string, 0, len(spenders))
+	for _, spender := range spenders {
+		if spender != owner {
+			candidates = append(candidates, spender)
+		}
+	}
+
+	return ti.indexer.tokenRepo.SpendAllowance(event.ContractAddress, owner, candidates, valueBig,
+		event.TransactionID, event.BlockNumber, event.BlockTimestamp)
+}
+
+// ReconcileHolders compares a random sample of indexed LRC20 holder balances
+// with balanceOf and logs the drift. Holders that moved tokens after the
+// indexed height also show up until the indexer catches up.
+func (ti *TokenIndexer) ReconcileHolders(ctx context.Context, sample int) error {
+	if sample <= 0 {
+		sample = 100
+	}
+	holders, err := ti.indexer.tokenRepo.GetHolderSample(sample)
+	if err != nil {
+		return err
+	}
+
+	drifted := 0
+	for _, holder := range holders {
+		contract, err := contractAddressBytes(holder.ContractAddress)
+		if err != nil {
+			continue
+		}
+		account, err := contractAddressBytes(holder.Address)
+		if err != nil {
+			continue
+		}
+
+		data, err := ti.callConstant(ctx, contract, "balanceOf(address)", strings.Repeat("0", 24)+hex.EncodeToString(account[1:]))
+		if err != nil {
+			ti.indexer.logger.WithError(err).WithField("token", holder.ContractAddress).Warn("Failed to read holder balance")
+			continue
+		}
+
+		onChain := new(big.Int).SetBytes(data[:32])
+		indexed, ok := new(big.Int).SetString(holder.Balance, 10)
+		if !ok || indexed.Cmp(onChain) != 0 {
+			drifted++
+			ti.indexer.logger.WithFields(logrus.Fields{
+				"token":    holder.ContractAddress,
+				"address":  holder.Address,
+				"indexed":  holder.Balance,
+				"on_chain": onChain.String(),
+			}).Warn("Token holder balance drift")
+		}
+	}
+
+	ti.indexer.logger.WithFields(logrus.Fields{
+		"checked": len(holders),
+		"drifted": drifted,
+	}).Info("Reconciled token holder balances")
+	return nil
+}
+
+// IndexLRC10Token indexes a LRC10 token
+func (ti *TokenIndexer) IndexLRC10Token(ctx context.Context, tokenID string) error {
+	_, err := ti.indexLRC10Token(ctx, tokenID, 0)
+	return err
+}
+
+// indexLRC10Token saves the metadata of an LRC10 token, blockNum is the block
+// that issued it or 0 when it was issued before the indexed range
+func (ti *TokenIndexer) indexLRC10Token(ctx context.Context, tokenID string, blockNum int64) (*models.TokenInfo, error) {
+	asset, err := ti.indexer.blockchainClient.GetAssetIssueById(ctx, &lindapb.BytesMessage{
+		Value: []byte(tokenID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert to token model
+	token := &models.TokenInfo{
+		ID:          tokenID, // Use the tokenID parameter, not asset.Id
+		Name:        string(asset.Name),
+		Symbol:      string(asset.Abbr),
+		TotalSupply: asset.TotalSupply,
+		Owner:       utils.MustHexToBase58(hex.EncodeToString(asset.OwnerAddress)),
+		Decimals:    int(asset.Precision),
+		LindNum:     asset.LindNum,
+		Num:         asset.Num,
+		StartTime:   asset.StartTime,
+		EndTime:     asset.EndTime,
+		URL:         string(asset.Url),
+		Description: string(asset.Description),
+		BlockNumber: blockNum,
+	}
+
+	// Keep the counters of an asset that is re-indexed
+	if existing, err := ti.indexer.tokenRepo.GetLRC10Token(tokenID); err == nil {
+		token.Holders = existing.Holders
+		token.Transfers = existing.Transfers
+		token.CreatedAt = existing.CreatedAt
+		if blockNum == 0 {
+			token.BlockNumber = existing.BlockNumber
+		}
+	}
+
+	if err := ti.indexer.tokenRepo.SaveLRC10Token(token); err != nil {
+		return nil, err
+	}
+
+	ti.assets.Add(tokenID, token)
+	return token, nil
+}
+
+// ResetCache drops the cached token metadata, after a rollback removed some of it
+func (ti *TokenIndexer) ResetCache() {
+	ti.tokens.Purge()
+	ti.assets.Purge()
+}
+
+// lrc10Token returns the metadata of an LRC10 token, indexing it the first time it is seen
+func (ti *TokenIndexer) lrc10Token(ctx context.Context, tokenID string) (*models.TokenInfo, error) {
+	if token, ok := ti.assets.Get(tokenID); ok {
+		return token, nil
+	}
+
+	if token, err := ti.indexer.tokenRepo.GetLRC10Token(tokenID); err == nil {
+		ti.assets.Add(tokenID, token)
+		return token, nil
+	}
+
+	return ti.indexLRC10Token(ctx, tokenID, 0)
+}
+
+// IndexAssetIssue registers an asset created by an AssetIssueContract and
+// credits its total supply to the issuer
+func (ti *TokenIndexer) IndexAssetIssue(ctx context.Context, tokenID, txID string, blockNum, blockTimestamp int64) error {
+	token, err := ti.indexLRC10Token(ctx, tokenID, blockNum)
+	if err != nil {
+		return err
+	}
+
+	return ti.indexer.tokenRepo.ApplyAssetTransfer(&models.TokenTransferResponse{
+		TransactionID:  txID,
+		BlockNumber:    blockNum,
+		BlockTimestamp: blockTimestamp,
+		From:           eventAddress(zeroAddress),
+		To:             token.Owner,
+		Value:          strconv.FormatInt(token.TotalSupply, 10),
+		TokenAddress:   token.ID,
+		TokenSymbol:    token.Symbol,
+		TokenDecimals:  int32(token.Decimals),
+		LogIndex:       models.NoLogIndex,
+	}, eventAddress(zeroAddress))
+}
+
+// IndexAssetTransfer records the movement of an LRC10 token, from a
+// TransferAssetContract or the call_token_value of a smart contract call
+func (ti *TokenIndexer) IndexAssetTransfer(ctx context.Context, tx *models.Transaction, tokenID, from, to string, amount int64) error {
+	if amount <= 0 || tokenID == "" || tokenID == "0" {
+		return nil
+	}
+
+	token, err := ti.lrc10Token(ctx, tokenID)
+	if err != nil {
+		return err
+	}
+
+	transfer := &models.TokenTransferResponse{
+		TransactionID:  tx.Hash,
+		BlockNumber:    tx.BlockNumber,
+		BlockTimestamp: tx.BlockTimestamp,
+		From:           from,
+		To:             to,
+		Value:          strconv.FormatInt(amount, 10),
+		TokenAddress:   token.ID,
+		TokenSymbol:    token.Symbol,
+		TokenDecimals:  int32(token.Decimals),
+		LogIndex:       models.NoLogIndex,
+	}
+	return ti.indexer.tokenRepo.ApplyAssetTransfer(transfer, eventAddress(zeroAddress))
+}
+
+// IndexAssetParticipation records the tokens an issuer sends to a buyer of a
+// ParticipateAssetIssueContract, paid amount LIND buys amount*num/lind_num tokens
+func (ti *TokenIndexer) IndexAssetParticipation(ctx context.Context, tx *models.Transaction, tokenID, buyer, issuer string, amount int64) error {
+	token, err := ti.lrc10Token(ctx, tokenID)
+	if err != nil {
+		return err
+	}
+	if token.LindNum <= 0 {
+		return fmt.Errorf("asset %s has no exchange rate", tokenID)
+	}
+
+	exchanged := new(big.Int).Mul(big.NewInt(amount), big.NewInt(int64(token.Num)))
+	exchanged.Quo(exchanged, big.NewInt(int64(token.LindNum)))
+	if !exchanged.IsInt64() {
+		return fmt.Errorf("participation amount overflows")
+	}
+
+	return ti.IndexAssetTransfer(ctx, tx, tokenID, issuer, buyer, exchanged.Int64())
+}
+
+// UpdateTokenHolderCounts updates holder counts for all tokens
+func (ti *TokenIndexer) UpdateTokenHolderCounts(ctx context.Context) error {
+	return ti.forEachLRC20Token(func(token *models.LRC20TokenInfo) {
+		count, err := ti.indexer.tokenRepo.GetHolderCount(token.Contract)
+		if err != nil {
+			return
+		}
+		if err := ti.indexer.tokenRepo.UpdateHolderCount(token.Contract, count); err != nil {
+			ti.indexer.logger.WithError(err).WithField("token", token.Contract).Error("Failed to update holder count")
+		}
+	})
+}
+
+// CalculateTokenPercentages calculates and updates holder percentages
+func (ti *TokenIndexer) CalculateTokenPercentages(ctx context.Context, contractAddr string) error {
+	holders, _, err := ti.indexer.tokenRepo.GetTokenHolders(contractAddr, 0, 1000, "-balance")
+	if err != nil {
+		return err
+	}
+
+	token, err := ti.indexer.tokenRepo.GetLRC20TokenByContract(contractAddr)
+	if err != nil {
+		return err
+	}
+
+	totalSupply, ok := new(big.Int).SetString(token.TotalSupply, 10)
+	if !ok || totalSupply.Sign() == 0 {
+		return nil
+	}
+
+	for _, holder := range holders {
+		balance, ok := new(big.Int).SetString(holder.Balance, 10)
+		if !ok {
+			continue
+		}
+		percentage := new(big.Float).Quo(
+			new(big.Float).SetInt(balance),
+			new(big.Float).SetInt(totalSupply),
+		)
+		percentageFloat, _ := percentage.Float64()
+		holder.Percentage = percentageFloat * 100
+
+		if err := ti.indexer.tokenRepo.UpdateHolderPercentage(contractAddr, holder.Address, holder.Percentage); err != nil {
+			ti.indexer.logger.WithError(err).Error("Failed to update holder percentage")
+		}
+	}
+
+	return nil
 }
\ No newline at end of file
@@ -1,167 +1,276 @@
-// internal/services/indexer/transaction_indexer.go
-package indexer
-
-import (
-	"context"
-	"encoding/json"
-	"strconv"
-	"time"
-
-	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
-	"github.com/lindaprotocol/grpc-api-gateway/pkg/lindapb"
-	"github.com/lindaprotocol/grpc-api-gateway/pkg/utils"
-)
-
-// TransactionIndexer struct: Indexer for transaction operations
-type TransactionIndexer struct {
-	indexer *Indexer
-}
-
-// NewTransactionIndexer creates a new transaction indexer
-func NewTransactionIndexer(indexer *Indexer) *TransactionIndexer {
-	return &TransactionIndexer{
-		indexer: indexer,
-	}
-}
-
-// IndexTransaction indexes a single transaction
-func (ti *TransactionIndexer) IndexTransaction(ctx context.Context, tx *lindapb.Transaction, blockNum int64, blockTimestamp int64) error {
-	// Convert signature to JSON
-	sigJSON, err := json.Marshal(tx.Signature)
-	if err != nil {
-		return err
-	}
-
-	txModel := &models.Transaction{
-		Hash:           string(tx.TxID),
-		BlockNumber:    blockNum,
-		BlockTimestamp: blockTimestamp,
-		Signature:      models.JSON(sigJSON),
-		CreatedAt:      time.Now(),
-	}
-
-	// Parse contract data
-	if tx.RawData != nil && len(tx.RawData.Contract) > 0 {
-		contract := tx.RawData.Contract[0]
-		txModel.ContractType = int(contract.Type)
-
-		// Parse parameter based on contract type
-		if contract.Parameter != nil {
-			var param map[string]interface{}
-			if err := json.Unmarshal(contract.Parameter.Value, &param); err == nil {
-				if owner, ok := param["owner_address"]; ok {
-					if ownerBytes, ok := owner.([]byte); ok {
-						txModel.FromAddress = utils.MustHexToBase58(string(ownerBytes))
-					} else if ownerStr, ok := owner.(string); ok {
-						txModel.FromAddress = utils.MustHexToBase58(ownerStr)
-					}
-				}
-				if to, ok := param["to_address"]; ok {
-					if toBytes, ok := to.([]byte); ok {
-						txModel.ToAddress = utils.MustHexToBase58(string(toBytes))
-					} else if toStr, ok := to.(string); ok {
-						txModel.ToAddress = utils.MustHexToBase58(toStr)
-					}
-				}
-				if amount, ok := param["amount"]; ok {
-					if amt, ok := amount.(float64); ok {
-						txModel.Amount = int64(amt)
-					}
-				}
-				if contractAddr, ok := param["contract_address"]; ok {
-					if addr, ok := contractAddr.(string); ok {
-						txModel.ContractAddress = utils.MustHexToBase58(addr)
-					}
-				}
-			}
-		}
-	}
-
-	return ti.indexer.txRepo.SaveTransaction(txModel)
-}
-
-// IndexTransactionInfo indexes transaction info data
-func (ti *TransactionIndexer) IndexTransactionInfo(info *lindapb.TransactionInfo) error {
-	// This would update transaction with info data
-	// Implementation depends on your repository
-	return nil
-}
-
-// ExtractInternalTransactions extracts internal transactions from transaction info
-func (ti *TransactionIndexer) ExtractInternalTransactions(info *lindapb.TransactionInfo) []*models.InternalTransaction {
-	if info == nil || len(info.InternalTransactions) == 0 {
-		return nil
-	}
-
-	internalTxs := make([]*models.InternalTransaction, 0, len(info.InternalTransactions))
-	for _, itx := range info.InternalTransactions {
-		// Extract call value info from the internal transaction
-		callValueInfo := make([]models.CallValueInfo, 0)
-		
-		// Parse the data field if it exists
-		if itx.Data != nil && itx.Data.Extra != nil {
-			// Since the values are already strings, we can access them directly
-			if callValueStr, ok := itx.Data.Extra["callValue"]; ok {
-				// Parse the string value to int64
-				if callValue, err := strconv.ParseInt(callValueStr, 10, 64); err == nil {
-					info := models.CallValueInfo{
-						CallValue: callValue,
-					}
-					// Check for tokenId
-					if tokenIDStr, hasToken := itx.Data.Extra["tokenId"]; hasToken {
-						info.TokenID = tokenIDStr
-					}
-					callValueInfo = append(callValueInfo, info)
-				}
-			}
-		}
-
-		// Handle extra field as JSON
-		var extraJSON models.JSON
-		if itx.Data != nil && itx.Data.Extra != nil {
-			extraBytes, err := json.Marshal(itx.Data.Extra)
-			if err == nil {
-				extraJSON = models.JSON(extraBytes)
-			}
-		}
-
-		internalTxs = append(internalTxs, &models.InternalTransaction{
-			Hash:              string(itx.InternalTxId),
-			CallerAddress:     utils.MustHexToBase58(string(itx.FromAddress)),
-			TransferToAddress: utils.MustHexToBase58(string(itx.ToAddress)),
-			CallValueInfo:     callValueInfo,
-			Note:              itx.Data.Note,
-			Rejected:          itx.Data.Rejected,
-			Extra:             extraJSON,
-			BlockTimestamp:    info.BlockTimeStamp,
-			TransactionID:     string(info.Id),
-			CreatedAt:         time.Now(),
-		})
-	}
-	return internalTxs
-}
-
-// IndexTransactionsBatch indexes a batch of transactions
-func (ti *TransactionIndexer) IndexTransactionsBatch(ctx context.Context, txs []*lindapb.Transaction, blockNum int64, blockTimestamp int64) error {
-	for _, tx := range txs {
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		default:
-			if err := ti.IndexTransaction(ctx, tx, blockNum, blockTimestamp); err != nil {
-				ti.indexer.logger.WithError(err).WithField("tx", string(tx.TxID)).Error("Failed to index transaction")
-			}
-		}
-	}
-	return nil
-}
-
-// GetTransactionByHash retrieves a transaction by hash
-func (ti *TransactionIndexer) GetTransactionByHash(hash string) (*models.Transaction, error) {
-	return ti.indexer.txRepo.GetByHash(hash)
-}
-
-// GetTransactionsByAddress retrieves transactions for an address
-func (ti *TransactionIndexer) GetTransactionsByAddress(address string, offset, limit int) ([]*models.Transaction, int64, error) {
-	return ti.indexer.txRepo.GetTransactionsByAddress(address, offset, limit, "-timestamp")
+// internal/services/indexer/transaction_indexer.go
+package indexer
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
+	"github.com/lindaprotocol/grpc-api-gateway/pkg/lindapb"
+	"github.com/lindaprotocol/grpc-api-gateway/pkg/utils"
+	"google.golang.org/protobuf/proto"
+)
+
+// TransactionIndexer struct: Indexer for transaction operations
+type TransactionIndexer struct {
+	indexer *Indexer
+}
+
+// NewTransactionIndexer creates a new transaction indexer
+func NewTransactionIndexer(indexer *Indexer) *TransactionIndexer {
+	return &TransactionIndexer{
+		indexer: indexer,
+	}
+}
+
+// IndexTransaction indexes a single transaction
+func (ti *TransactionIndexer) IndexTransaction(ctx context.Context, tx *lindapb.Transaction, blockNum int64, blockTimestamp int64) error {
+	// Convert signature to JSON
+	sigJSON, err := json.Marshal(tx.Signature)
+	if err != nil {
+		return err
+	}
+
+	txModel := &models.Transaction{
+		Hash:           string(tx.TxID),
+		BlockNumber:    blockNum,
+		BlockTimestamp: blockTimestamp,
+		Signature:      models.JSON(sigJSON),
+		Unconfirmed:    ti.indexer.unconfirmed(blockNum),
+		CreatedAt:      time.Now(),
+	}
+
+	// LRC-10 token ID of a TransferAssetContract and the token sent with a smart contract call
+	assetName := ""
+	callTokenID := ""
+	var callTokenValue int64
+
+	// LIND sent with a smart contract call and staked by a freeze
+	var callValue, frozenBalance int64
+
+	// Typed contract parameter, nil for contract types that are not read
+	var contract proto.Message
+
+	// Smart contract call, decoded apart as it has no generated message
+	var trigger *triggerSmartContract
+
+	if tx.RawData != nil && len(tx.RawData.Contract) > 0 {
+		raw := tx.RawData.Contract[0]
+		txModel.ContractType = int(raw.Type)
+
+		if raw.Type == lindapb.Transaction_Contract_TriggerSmartContract {
+			trigger, err = decodeTriggerSmartContract(raw)
+		} else {
+			contract, err = decodeContract(raw)
+		}
+		if err != nil {
+			ti.indexer.logger.WithError(err).WithField("tx", txModel.Hash).Warn("Failed to decode contract parameter")
+		}
+		txModel.FromAddress = contractOwner(contract)
+	}
+
+	if trigger != nil {
+		txModel.FromAddress = addressBase58(trigger.OwnerAddress)
+		txModel.ContractAddress = addressBase58(trigger.ContractAddress)
+		callValue = trigger.CallValue
+		callTokenValue = trigger.CallTokenValue
+		callTokenID = formatTokenID(trigger.TokenID)
+	}
+
+	switch c := contract.(type) {
+	case *lindapb.TransferContract:
+		txModel.ToAddress = addressBase58(c.ToAddress)
+		txModel.Amount = c.Amount
+	case *lindapb.TransferAssetContract:
+		txModel.ToAddress = addressBase58(c.ToAddress)
+		txModel.Amount = c.Amount
+		assetName = string(c.AssetName)
+	case *lindapb.ParticipateAssetIssueContract:
+		txModel.ToAddress = addressBase58(c.ToAddress)
+		txModel.Amount = c.Amount
+		assetName = string(c.AssetName)
+	case *lindapb.FreezeBalanceContract:
+		frozenBalance = c.FrozenBalance
+	case *lindapb.FreezeBalanceV2Contract:
+		frozenBalance = c.FrozenBalance
+	case *lindapb.DelegateResourceContract:
+		txModel.ToAddress = addressBase58(c.ReceiverAddress)
+		txModel.Amount = c.Balance
+	case *lindapb.UnDelegateResourceContract:
+		txModel.ToAddress = addressBase58(c.ReceiverAddress)
+		txModel.Amount = c.Balance
+	}
+
+	// The receipt overwrites the result later, webhooks and alerts are
+	// evaluated before it arrives
+	txModel.Result = transactionResult(tx)
+
+	if err := ti.indexer.txRepo.SaveTransaction(txModel); err != nil {
+		return err
+	}
+
+	// Record LIND and LRC-10 token movements of successful transactions
+	if txModel.Result == 0 {
+		if err := ti.indexer.balanceIndexer.IndexTransaction(ctx, txModel, callValue, frozenBalance); err != nil {
+			ti.indexer.logger.WithError(err).WithField("tx", txModel.Hash).Error("Failed to index balance changes")
+		}
+		if err := ti.indexAssetMovement(ctx, txModel, assetName, callTokenID, callTokenValue); err != nil {
+			ti.indexer.logger.WithError(err).WithField("tx", txModel.Hash).Error("Failed to index asset transfer")
+		}
+		if err := ti.indexer.stakingIndexer.IndexTransaction(txModel, contract); err != nil {
+			ti.indexer.logger.WithError(err).WithField("tx", txModel.Hash).Error("Failed to index staking action")
+		}
+		if err := ti.indexer.witnessIndexer.IndexTransaction(txModel, contract); err != nil {
+			ti.indexer.logger.WithError(err).WithField("tx", txModel.Hash).Error("Failed to index brokerage change")
+		}
+		if err := ti.indexer.governanceIndexer.IndexTransaction(ctx, txModel, contract); err != nil {
+			ti.indexer.logger.WithError(err).WithField("tx", txModel.Hash).Error("Failed to index proposal")
+		}
+		ti.indexer.exchangeIndexer.IndexTransaction(txModel, contract)
+
+		// Evaluate watchlist alert rules
+		if ti.indexer.alerts != nil {
+			ti.indexer.alerts.CheckTransaction(ctx, txModel, assetName)
+		}
+	}
+	ti.indexer.contractIndexer.IndexTransaction(txModel, trigger)
+
+	// Queue webhook deliveries for matching subscriptions
+	if ti.indexer.webhooks != nil {
+		if err := ti.indexer.webhooks.NotifyTransaction(txModel); err != nil {
+			ti.indexer.logger.WithError(err).Error("Failed to enqueue transaction webhooks")
+		}
+	}
+
+	return nil
+}
+
+// indexAssetMovement records the LRC-10 transfer carried by a transaction
+func (ti *TransactionIndexer) indexAssetMovement(ctx context.Context, tx *models.Transaction, assetName, callTokenID string, callTokenValue int64) error {
+	tokens := ti.indexer.tokenIndexer
+	switch tx.ContractType {
+	case models.ContractTypeTransferAsset:
+		return tokens.IndexAssetTransfer(ctx, tx, assetName, tx.FromAddress, tx.ToAddress, tx.Amount)
+	case models.ContractTypeParticipateAssetIssue:
+		return tokens.IndexAssetParticipation(ctx, tx, assetName, tx.FromAddress, tx.ToAddress, tx.Amount)
+	case models.ContractTypeTriggerSmartContract:
+		return tokens.IndexAssetTransfer(ctx, tx, callTokenID, tx.FromAddress, tx.ContractAddress, callTokenValue)
+	}
+	return nil
+}
+
+// transactionResult returns the result of a transaction as stored in its
+// receipt, 0 for success and 1 for failure, from the result the block carries.
+// Smart contract transactions keep a SUCCESS code when they revert or run out
+// of energy, they only succeed with a SUCCESS contract result.
+func transactionResult(tx *lindapb.Transaction) int {
+	smartContract := false
+	if tx.RawData != nil && len(tx.RawData.Contract) > 0 {
+		switch tx.RawData.Contract[0].Type {
+		case lindapb.Transaction_Contract_CreateSmartContract, lindapb.Transaction_Contract_TriggerSmartContract:
+			smartContract = true
+		}
+	}
+
+	if len(tx.Ret) == 0 {
+		if smartContract {
+			return 1
+		}
+		return 0
+	}
+	ret := tx.Ret[0]
+	if ret.Ret == lindapb.Transaction_Result_FAILED {
+		return 1
+	}
+	if smartContract && ret.ContractRet != lindapb.ContractResult_SUCCESS {
+		return 1
+	}
+	return 0
+}
+
+// IndexTransactionInfo records the fee, resource usage and result of a
+// transaction from its receipt
+func (ti *TransactionIndexer) IndexTransactionInfo(info *lindapb.TransactionInfo) error {
+	return ti.indexer.txRepo.UpdateTransactionWithInfo(info)
+}
+
+// ExtractInternalTransactions extracts internal transactions from transaction info
+func (ti *TransactionIndexer) ExtractInternalTransactions(info *lindapb.TransactionInfo) []*models.InternalTransaction {
+	if info == nil || len(info.InternalTransactions) == 0 {
+		return nil
+	}
+
+	internalTxs := make([]*models.InternalTransaction, 0, len(info.InternalTransactions))
+	for _, itx := range info.InternalTransactions {
+		// Extract call value info from the internal transaction
+		callValueInfo := make([]models.CallValueInfo, 0)
+		
+		// Parse the data field if it exists
+		if itx.Data != nil && itx.Data.Extra != nil {
+			// Since the values are already strings, we can access them directly
+			if callValueStr, ok := itx.Data.Extra["callValue"]; ok {
+				// Parse the string value to int64
+				if callValue, err := strconv.ParseInt(callValueStr, 10, 64); err == nil {
+					info := models.CallValueInfo{
+						CallValue: callValue,
+					}
+					// Check for tokenId
+					if tokenIDStr, hasToken := itx.Data.Extra["tokenId"]; hasToken {
+						info.TokenID = tokenIDStr
+					}
+					callValueInfo = append(callValueInfo, info)
+				}
+			}
+		}
+
+		// Handle extra field as JSON
+		var extraJSON models.JSON
+		if itx.Data != nil && itx.Data.Extra != nil {
+			extraBytes, err := json.Marshal(itx.Data.Extra)
+			if err == nil {
+				extraJSON = models.JSON(extraBytes)
+			}
+		}
+
+		internalTxs = append(internalTxs, &models.InternalTransaction{
+			Hash:              string(itx.InternalTxId),
+			CallerAddress:     utils.MustHexToBase58(string(itx.FromAddress)),
+			TransferToAddress: utils.MustHexToBase58(string(itx.ToAddress)),
+			CallValueInfo:     callValueInfo,
+			Note:              itx.Data.Note,
+			Rejected:          itx.Data.Rejected,
+			Extra:             extraJSON,
+			BlockTimestamp:    info.BlockTimeStamp,
+			TransactionID:     string(info.Id),
+			CreatedAt:         time.Now(),
+		})
+	}
+	return internalTxs
+}
+
+// IndexTransactionsBatch indexes a batch of transactions
+func (ti *TransactionIndexer) IndexTransactionsBatch(ctx context.Context, txs []*lindapb.Transaction, blockNum int64, blockTimestamp int64) error {
+	for _, tx := range txs {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+			if err := ti.IndexTransaction(ctx, tx, blockNum, blockTimestamp); err != nil {
+				ti.indexer.logger.WithError(err).WithField("tx", string(tx.TxID)).Error("Failed to index transaction")
+			}
+		}
+	}
+	return nil
+}
+
+// GetTransactionByHash retrieves a transaction by hash
+func (ti *TransactionIndexer) GetTransactionByHash(hash string) (*models.Transaction, error) {
+	return ti.indexer.txRepo.GetByHash(hash)
+}
+
+// GetTransactionsByAddress retrieves transactions for an address
+func (ti *TransactionIndexer) GetTransactionsByAddress(address string, offset, limit int) ([]*models.Transaction, int64, error) {
+	return ti.indexer.txRepo.GetTransactionsByAddress(address, offset, limit, "-timestamp")
 }
\ No newline at end of file
@@ -0,0 +1,158 @@
+// internal/services/indexer/witness_indexer.go
+package indexer
+
+import (
+	"context"
+	"encoding/hex"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
+	"github.com/lindaprotocol/grpc-api-gateway/pkg/lindapb"
+	"github.com/lindaprotocol/grpc-api-gateway/pkg/utils"
+	"google.golang.org/protobuf/proto"
+)
+
+// defaultMaintenanceInterval is the length of a maintenance cycle when the
+// node does not report getMaintenanceTimeInterval
+const defaultMaintenanceInterval = 6 * time.Hour
+
+// WitnessIndexer struct: Indexer for witness missed slots, vote snapshots and brokerage changes
+type WitnessIndexer struct {
+	indexer *Indexer
+
+	mu              sync.Mutex
+	schedule        []string // active witnesses in production order
+	cycleStart      int64
+	nextMaintenance int64
+}
+
+// NewWitnessIndexer creates a new witness indexer
+func NewWitnessIndexer(indexer *Indexer) *WitnessIndexer {
+	return &WitnessIndexer{
+		indexer: indexer,
+	}
+}
+
+// IndexBlock records the slots missed between a block and the block before it.
+// Slots are attributed from the witness schedule of the current maintenance
+// cycle, so blocks of earlier cycles and gaps that do not match the schedule
+// are skipped.
+func (wi *WitnessIndexer) IndexBlock(ctx context.Context, block *models.Block) error {
+	wi.mu.Lock()
+	defer wi.mu.Unlock()
+
+	if len(wi.schedule) == 0 || block.Timestamp >= wi.nextMaintenance {
+		if err := wi.refresh(ctx); err != nil {
+			return err
+		}
+	}
+	if block.Number == 0 || len(wi.schedule) == 0 || block.Timestamp < wi.cycleStart {
+		return nil
+	}
+
+	prev, err := wi.indexer.blockRepo.GetByNumber(block.Number - 1)
+	if err != nil || prev.Timestamp < wi.cycleStart {
+		return nil
+	}
+
+	gap := int((block.Timestamp-prev.Timestamp)/models.BlockInterval.Milliseconds()) - 1
+	if gap <= 0 {
+		return nil
+	}
+
+	prevIdx := wi.scheduleIndex(prev.WitnessAddress)
+	curIdx := wi.scheduleIndex(block.WitnessAddress)
+	if prevIdx < 0 || curIdx < 0 || (prevIdx+gap+1)%len(wi.schedule) != curIdx {
+		return nil
+	}
+
+	slots := make([]*models.WitnessMissedSlot, 0, gap)
+	for k := 1; k <= gap; k++ {
+		slots = append(slots, &models.WitnessMissedSlot{
+			BlockNumber:   block.Number,
+			Witness:       wi.schedule[(prevIdx+k)%len(wi.schedule)],
+			SlotTimestamp: prev.Timestamp + int64(k)*models.BlockInterval.Milliseconds(),
+			CreatedAt:     time.Now(),
+		})
+	}
+	return wi.indexer.witnessRepo.SaveMissedSlots(slots)
+}
+
+// IndexTransaction records the brokerage set by a successful UpdateBrokerage transaction
+func (wi *WitnessIndexer) IndexTransaction(tx *models.Transaction, contract proto.Message) error {
+	update, ok := contract.(*lindapb.UpdateBrokerageContract)
+	if !ok {
+		return nil
+	}
+
+	return wi.indexer.witnessRepo.SaveBrokerageChange(&models.WitnessBrokerageChange{
+		TransactionID:  hex.EncodeToString([]byte(tx.Hash)),
+		BlockNumber:    tx.BlockNumber,
+		BlockTimestamp: tx.BlockTimestamp,
+		Witness:        tx.FromAddress,
+		Brokerage:      int(update.Brokerage),
+		CreatedAt:      time.Now(),
+	})
+}
+
+// refresh loads the witness schedule of the current maintenance cycle and
+// snapshots the votes of every witness at its start
+func (wi *WitnessIndexer) refresh(ctx context.Context) error {
+	client := wi.indexer.blockchainClient
+
+	witnesses, err := client.ListWitnesses(ctx, &lindapb.EmptyMessage{})
+	if err != nil {
+		return err
+	}
+	next, err := client.GetNextMaintenanceTime(ctx, &lindapb.EmptyMessage{})
+	if err != nil {
+		return err
+	}
+
+	interval := defaultMaintenanceInterval.Milliseconds()
+	if params, err := client.GetChainParameters(ctx, &lindapb.EmptyMessage{}); err == nil {
+		for _, p := range params.ChainParameter {
+			if p.Key == "getMaintenanceTimeInterval" && p.Value > 0 {
+				interval = p.Value
+			}
+		}
+	}
+
+	// Witnesses produce in descending vote order
+	list := witnesses.Witnesses
+	sort.SliceStable(list, func(a, b int) bool {
+		return list[a].VoteCount > list[b].VoteCount
+	})
+
+	wi.nextMaintenance = next.Num
+	wi.cycleStart = next.Num - interval
+	wi.schedule = wi.schedule[:0]
+
+	snapshots := make([]*models.WitnessVoteSnapshot, 0, len(list))
+	for rank, w := range list {
+		address := utils.MustHexToBase58(hex.EncodeToString(w.Address))
+		if w.IsJobs {
+			wi.schedule = append(wi.schedule, address)
+		}
+		snapshots = append(snapshots, &models.WitnessVoteSnapshot{
+			CycleStart: wi.cycleStart,
+			Witness:    address,
+			VoteCount:  w.VoteCount,
+			Rank:       rank + 1,
+			CreatedAt:  time.Now(),
+		})
+	}
+	return wi.indexer.witnessRepo.SaveVoteSnapshots(snapshots)
+}
+
+// scheduleIndex returns the position of a witness in the schedule, -1 when it is not active
+func (wi *WitnessIndexer) scheduleIndex(address string) int {
+	for i, witness := range wi.schedule {
+		if witness == address {
+			return i
+		}
+	}
+	return -1
+}
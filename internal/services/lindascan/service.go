@@ -1,402 +1,435 @@
-// internal/services/lindascan/service.go
-package lindascan
-
-import (
-	"context"
-
-	"github.com/lindaprotocol/grpc-api-gateway/internal/services/blockchain"
-	"github.com/lindaprotocol/grpc-api-gateway/internal/services/storage/repository"
-	"github.com/lindaprotocol/grpc-api-gateway/pkg/lindapb"
-)
-
-// Service implements the LindascanServer interface
-type Service struct {
-	lindapb.UnimplementedLindascanServer
-	blockchainClient *blockchain.Client
-	accountRepo      *repository.AccountRepository
-	blockRepo        *repository.BlockRepository
-	txRepo           *repository.TransactionRepository
-	tokenRepo        *repository.TokenRepository
-	eventRepo        *repository.EventRepository
-	tagRepo          *repository.TagRepository
-	statsRepo        *repository.StatsRepository
-}
-
-// NewService creates a new Lindascan service
-func NewService(
-	client *blockchain.Client,
-	accountRepo *repository.AccountRepository,
-	blockRepo *repository.BlockRepository,
-	txRepo *repository.TransactionRepository,
-	tokenRepo *repository.TokenRepository,
-	eventRepo *repository.EventRepository,
-	tagRepo *repository.TagRepository,
-	statsRepo *repository.StatsRepository,
-) *Service {
-	return &Service{
-		blockchainClient: client,
-		accountRepo:      accountRepo,
-		blockRepo:        blockRepo,
-		txRepo:           txRepo,
-		tokenRepo:        tokenRepo,
-		eventRepo:        eventRepo,
-		tagRepo:          tagRepo,
-		statsRepo:        statsRepo,
-	}
-}
-
-// ==================== Lindascan Custom Methods ====================
-
-// GetHomepageBundle gets homepage bundle data
-func (s *Service) GetHomepageBundle(ctx context.Context, req *lindapb.EmptyMessage) (*lindapb.HomepageBundle, error) {
-	// Implementation would call the appropriate handler or service
-	return &lindapb.HomepageBundle{}, nil
-}
-
-// GetNodeMap gets node map data
-func (s *Service) GetNodeMap(ctx context.Context, req *lindapb.EmptyMessage) (*lindapb.NodeMapResponse, error) {
-	// Implementation would call the appropriate handler or service
-	return &lindapb.NodeMapResponse{}, nil
-}
-
-// GetTop10 gets top 10 data
-func (s *Service) GetTop10(ctx context.Context, req *lindapb.Top10Request) (*lindapb.Top10Response, error) {
-	// Implementation would call the appropriate handler or service
-	return &lindapb.Top10Response{}, nil
-}
-
-// ProxyRequest proxies a request to an external API
-func (s *Service) ProxyRequest(ctx context.Context, req *lindapb.ProxyRequestMessage) (*lindapb.ProxyResponse, error) {
-	// Implementation would call the appropriate handler or service
-	return &lindapb.ProxyResponse{}, nil
-}
-
-// GetTokens gets token list
-func (s *Service) GetTokens(ctx context.Context, req *lindapb.TokenListRequest) (*lindapb.TokenListResponse, error) {
-	// Implementation would call the appropriate handler or service
-	return &lindapb.TokenListResponse{}, nil
-}
-
-// GetTokenById gets token by ID
-func (s *Service) GetTokenById(ctx context.Context, req *lindapb.TokenByIdRequest) (*lindapb.TokenInfo, error) {
-	// Implementation would call the appropriate handler or service
-	return &lindapb.TokenInfo{}, nil
-}
-
-// GetLRC20Tokens gets LRC20 tokens
-func (s *Service) GetLRC20Tokens(ctx context.Context, req *lindapb.LRC20TokenRequest) (*lindapb.LRC20TokenListResponse, error) {
-	// Implementation would call the appropriate handler or service
-	return &lindapb.LRC20TokenListResponse{}, nil
-}
-
-// GetLRC20TokenByContract gets LRC20 token by contract address
-func (s *Service) GetLRC20TokenByContract(ctx context.Context, req *lindapb.LRC20TokenByContractRequest) (*lindapb.LRC20TokenInfo, error) {
-	// Implementation would call the appropriate handler or service
-	return &lindapb.LRC20TokenInfo{}, nil
-}
-
-// GetTokenHolders gets token holders
-func (s *Service) GetTokenHolders(ctx context.Context, req *lindapb.TokenHoldersRequest) (*lindapb.TokenHoldersResponse, error) {
-	// Implementation would call the appropriate handler or service
-	return &lindapb.TokenHoldersResponse{}, nil
-}
-
-// GetTokenTransfers gets token transfers
-func (s *Service) GetTokenTransfers(ctx context.Context, req *lindapb.TokenTransfersRequest) (*lindapb.TokenTransfersResponse, error) {
-	// Implementation would call the appropriate handler or service
-	return &lindapb.TokenTransfersResponse{}, nil
-}
-
-// GetTokensOverview gets tokens overview
-func (s *Service) GetTokensOverview(ctx context.Context, req *lindapb.TokensOverviewRequest) (*lindapb.TokensOverviewResponse, error) {
-	// Implementation would call the appropriate handler or service
-	return &lindapb.TokensOverviewResponse{}, nil
-}
-
-// GetTokenPrice gets token price
-func (s *Service) GetTokenPrice(ctx context.Context, req *lindapb.EmptyMessage) (*lindapb.TokenPriceResponse, error) {
-	// Implementation would call the appropriate handler or service
-	return &lindapb.TokenPriceResponse{}, nil
-}
-
-// GetParticipateAssetIssue gets participate asset issue data
-func (s *Service) GetParticipateAssetIssue(ctx context.Context, req *lindapb.ParticipateAssetIssueRequest) (*lindapb.ParticipateAssetIssueResponse, error) {
-	// Implementation would call the appropriate handler or service
-	return &lindapb.ParticipateAssetIssueResponse{}, nil
-}
-
-// GetWinkFund gets WINK fund data
-func (s *Service) GetWinkFund(ctx context.Context, req *lindapb.EmptyMessage) (*lindapb.WinkFundResponse, error) {
-	// Implementation would call the appropriate handler or service
-	return &lindapb.WinkFundResponse{}, nil
-}
-
-// GetWinkGraphic gets WINK graphic data
-func (s *Service) GetWinkGraphic(ctx context.Context, req *lindapb.EmptyMessage) (*lindapb.GraphicResponse, error) {
-	// Implementation would call the appropriate handler or service
-	return &lindapb.GraphicResponse{}, nil
-}
-
-// GetJSTFund gets JST fund data
-func (s *Service) GetJSTFund(ctx context.Context, req *lindapb.EmptyMessage) (*lindapb.JSTFundResponse, error) {
-	// Implementation would call the appropriate handler or service
-	return &lindapb.JSTFundResponse{}, nil
-}
-
-// GetJSTGraphic gets JST graphic data
-func (s *Service) GetJSTGraphic(ctx context.Context, req *lindapb.EmptyMessage) (*lindapb.GraphicResponse, error) {
-	// Implementation would call the appropriate handler or service
-	return &lindapb.GraphicResponse{}, nil
-}
-
-// GetBitTorrentGraphic gets BitTorrent graphic data
-func (s *Service) GetBitTorrentGraphic(ctx context.Context, req *lindapb.EmptyMessage) (*lindapb.GraphicResponse, error) {
-	// Implementation would call the appropriate handler or service
-	return &lindapb.GraphicResponse{}, nil
-}
-
-// GetTokenPositionDistribution gets token position distribution
-func (s *Service) GetTokenPositionDistribution(ctx context.Context, req *lindapb.TokenPositionRequest) (*lindapb.TokenPositionResponse, error) {
-	// Implementation would call the appropriate handler or service
-	return &lindapb.TokenPositionResponse{}, nil
-}
-
-// GetAssetTransfer gets asset transfers
-func (s *Service) GetAssetTransfer(ctx context.Context, req *lindapb.AssetTransferRequest) (*lindapb.AssetTransferResponse, error) {
-	// Implementation would call the appropriate handler or service
-	return &lindapb.AssetTransferResponse{}, nil
-}
-
-// GetAccountList gets account list
-func (s *Service) GetAccountList(ctx context.Context, req *lindapb.AccountListRequest) (*lindapb.AccountListResponse, error) {
-	// Implementation would call the appropriate handler or service
-	return &lindapb.AccountListResponse{}, nil
-}
-
-// GetAccountResource gets account resource - CORRECT SIGNATURE for Lindascan
-func (s *Service) GetAccountResource(ctx context.Context, req *lindapb.AccountResourceRequest) (*lindapb.AccountResourceResponse, error) {
-	// Implementation would call the appropriate handler or service
-	return &lindapb.AccountResourceResponse{}, nil
-}
-
-// GetAccountOverview gets account overview
-func (s *Service) GetAccountOverview(ctx context.Context, req *lindapb.AccountOverviewRequest) (*lindapb.AccountOverviewResponse, error) {
-	// Implementation would call the appropriate handler or service
-	return &lindapb.AccountOverviewResponse{}, nil
-}
-
-// GetAccountProposals gets account proposals
-func (s *Service) GetAccountProposals(ctx context.Context, req *lindapb.AccountProposalRequest) (*lindapb.AccountProposalResponse, error) {
-	// Implementation would call the appropriate handler or service
-	return &lindapb.AccountProposalResponse{}, nil
-}
-
-// GetTags gets tags
-func (s *Service) GetTags(ctx context.Context, req *lindapb.TagRequest) (*lindapb.TagListResponse, error) {
-	// Implementation would call the appropriate handler or service
-	return &lindapb.TagListResponse{}, nil
-}
-
-// InsertTag inserts a tag
-func (s *Service) InsertTag(ctx context.Context, req *lindapb.TagInsertRequest) (*lindapb.TagResponse, error) {
-	// Implementation would call the appropriate handler or service
-	return &lindapb.TagResponse{}, nil
-}
-
-// UpdateTag updates a tag
-func (s *Service) UpdateTag(ctx context.Context, req *lindapb.TagUpdateRequest) (*lindapb.TagResponse, error) {
-	// Implementation would call the appropriate handler or service
-	return &lindapb.TagResponse{}, nil
-}
-
-// DeleteTag deletes a tag
-func (s *Service) DeleteTag(ctx context.Context, req *lindapb.TagDeleteRequest) (*lindapb.TagResponse, error) {
-	// Implementation would call the appropriate handler or service
-	return &lindapb.TagResponse{}, nil
-}
-
-// RecommendTag recommends tags
-func (s *Service) RecommendTag(ctx context.Context, req *lindapb.TagRecommendRequest) (*lindapb.TagListResponse, error) {
-	// Implementation would call the appropriate handler or service
-	return &lindapb.TagListResponse{}, nil
-}
-
-// UploadLogo uploads a logo
-func (s *Service) UploadLogo(ctx context.Context, req *lindapb.UploadLogoRequest) (*lindapb.UploadLogoResponse, error) {
-	// Implementation would call the appropriate handler or service
-	return &lindapb.UploadLogoResponse{}, nil
-}
-
-// GetVoteInfo gets vote information
-func (s *Service) GetVoteInfo(ctx context.Context, req *lindapb.VoteInfoRequest) (*lindapb.VoteInfoResponse, error) {
-	// Implementation would call the appropriate handler or service
-	return &lindapb.VoteInfoResponse{}, nil
-}
-
-// GetChainParametersV2 gets chain parameters v2
-func (s *Service) GetChainParametersV2(ctx context.Context, req *lindapb.EmptyMessage) (*lindapb.ChainParametersV2, error) {
-	// Implementation would call the appropriate handler or service
-	return &lindapb.ChainParametersV2{}, nil
-}
-
-// GetBlocksV2 gets blocks v2
-func (s *Service) GetBlocksV2(ctx context.Context, req *lindapb.BlockListV2Request) (*lindapb.BlockListV2Response, error) {
-	// Implementation would call the appropriate handler or service
-	return &lindapb.BlockListV2Response{}, nil
-}
-
-// GetTransactionsV2 gets transactions v2
-func (s *Service) GetTransactionsV2(ctx context.Context, req *lindapb.TransactionListV2Request) (*lindapb.TransactionListV2Response, error) {
-	// Implementation would call the appropriate handler or service
-	return &lindapb.TransactionListV2Response{}, nil
-}
-
-// GetInternalTransactions gets internal transactions
-func (s *Service) GetInternalTransactions(ctx context.Context, req *lindapb.InternalTransactionRequest) (*lindapb.InternalTransactionResponse, error) {
-	// Implementation would call the appropriate handler or service
-	return &lindapb.InternalTransactionResponse{}, nil
-}
-
-// GetContractTransactions gets contract transactions
-func (s *Service) GetContractTransactions(ctx context.Context, req *lindapb.ContractTransactionRequest) (*lindapb.ContractTransactionResponse, error) {
-	// Implementation would call the appropriate handler or service
-	return &lindapb.ContractTransactionResponse{}, nil
-}
-
-// GetLRC10LRC20Transfers gets LRC10/LRC20 transfers
-func (s *Service) GetLRC10LRC20Transfers(ctx context.Context, req *lindapb.LRCTransferRequest) (*lindapb.LRCTransferResponse, error) {
-	// Implementation would call the appropriate handler or service
-	return &lindapb.LRCTransferResponse{}, nil
-}
-
-// GetContractAccountHistory gets contract account history
-func (s *Service) GetContractAccountHistory(ctx context.Context, req *lindapb.ContractAccountHistoryRequest) (*lindapb.ContractAccountHistoryResponse, error) {
-	// Implementation would call the appropriate handler or service
-	return &lindapb.ContractAccountHistoryResponse{}, nil
-}
-
-// GetSmartContractTriggersBatch gets smart contract triggers batch
-func (s *Service) GetSmartContractTriggersBatch(ctx context.Context, req *lindapb.SmartContractTriggersRequest) (*lindapb.SmartContractTriggersResponse, error) {
-	// Implementation would call the appropriate handler or service
-	return &lindapb.SmartContractTriggersResponse{}, nil
-}
-
-// GetEnergyStatistic gets energy statistics
-func (s *Service) GetEnergyStatistic(ctx context.Context, req *lindapb.EnergyStatisticRequest) (*lindapb.EnergyStatisticResponse, error) {
-	// Implementation would call the appropriate handler or service
-	return &lindapb.EnergyStatisticResponse{}, nil
-}
-
-// GetTriggerStatistic gets trigger statistics
-func (s *Service) GetTriggerStatistic(ctx context.Context, req *lindapb.TriggerStatisticRequest) (*lindapb.TriggerStatisticResponse, error) {
-	// Implementation would call the appropriate handler or service
-	return &lindapb.TriggerStatisticResponse{}, nil
-}
-
-// GetCallerAddressStatistic gets caller address statistics
-func (s *Service) GetCallerAddressStatistic(ctx context.Context, req *lindapb.CallerAddressStatisticRequest) (*lindapb.CallerAddressStatisticResponse, error) {
-	// Implementation would call the appropriate handler or service
-	return &lindapb.CallerAddressStatisticResponse{}, nil
-}
-
-// GetEnergyDailyStatistic gets daily energy statistics
-func (s *Service) GetEnergyDailyStatistic(ctx context.Context, req *lindapb.EnergyDailyStatisticRequest) (*lindapb.EnergyDailyStatisticResponse, error) {
-	// Implementation would call the appropriate handler or service
-	return &lindapb.EnergyDailyStatisticResponse{}, nil
-}
-
-// GetTriggerAmountStatistic gets trigger amount statistics
-func (s *Service) GetTriggerAmountStatistic(ctx context.Context, req *lindapb.TriggerAmountStatisticRequest) (*lindapb.TriggerAmountStatisticResponse, error) {
-	// Implementation would call the appropriate handler or service
-	return &lindapb.TriggerAmountStatisticResponse{}, nil
-}
-
-// GetFreezeResource gets freeze resource data
-func (s *Service) GetFreezeResource(ctx context.Context, req *lindapb.FreezeResourceRequest) (*lindapb.FreezeResourceResponse, error) {
-	// Implementation would call the appropriate handler or service
-	return &lindapb.FreezeResourceResponse{}, nil
-}
-
-// GetTurnover gets turnover data
-func (s *Service) GetTurnover(ctx context.Context, req *lindapb.TurnoverRequest) (*lindapb.TurnoverResponse, error) {
-	// Implementation would call the appropriate handler or service
-	return &lindapb.TurnoverResponse{}, nil
-}
-
-// GetLindHolderStats gets LIND holder statistics
-func (s *Service) GetLindHolderStats(ctx context.Context, req *lindapb.LindHolderRequest) (*lindapb.LindHolderResponse, error) {
-	// Implementation would call the appropriate handler or service
-	return &lindapb.LindHolderResponse{}, nil
-}
-
-// GetOneContractEnergyStatistic gets one contract energy statistics
-func (s *Service) GetOneContractEnergyStatistic(ctx context.Context, req *lindapb.OneContractEnergyStatisticRequest) (*lindapb.OneContractEnergyStatisticResponse, error) {
-	// Implementation would call the appropriate handler or service
-	return &lindapb.OneContractEnergyStatisticResponse{}, nil
-}
-
-// GetOneContractTriggerStatistic gets one contract trigger statistics
-func (s *Service) GetOneContractTriggerStatistic(ctx context.Context, req *lindapb.OneContractTriggerStatisticRequest) (*lindapb.OneContractTriggerStatisticResponse, error) {
-	// Implementation would call the appropriate handler or service
-	return &lindapb.OneContractTriggerStatisticResponse{}, nil
-}
-
-// GetOneContractCallerStatistic gets one contract caller statistics
-func (s *Service) GetOneContractCallerStatistic(ctx context.Context, req *lindapb.OneContractCallerStatisticRequest) (*lindapb.OneContractCallerStatisticResponse, error) {
-	// Implementation would call the appropriate handler or service
-	return &lindapb.OneContractCallerStatisticResponse{}, nil
-}
-
-// GetOneContractCallers gets one contract callers
-func (s *Service) GetOneContractCallers(ctx context.Context, req *lindapb.OneContractCallersRequest) (*lindapb.OneContractCallersResponse, error) {
-	// Implementation would call the appropriate handler or service
-	return &lindapb.OneContractCallersResponse{}, nil
-}
-
-// GetNodeOverviewUpload gets node overview upload
-func (s *Service) GetNodeOverviewUpload(ctx context.Context, req *lindapb.NodeOverviewRequest) (*lindapb.NodeOverviewResponse, error) {
-	// Implementation would call the appropriate handler or service
-	return &lindapb.NodeOverviewResponse{}, nil
-}
-
-// GetNodeInfoUpload gets node info upload
-func (s *Service) GetNodeInfoUpload(ctx context.Context, req *lindapb.NodeInfoUploadRequest) (*lindapb.NodeInfoUploadResponse, error) {
-	// Implementation would call the appropriate handler or service
-	return &lindapb.NodeInfoUploadResponse{}, nil
-}
-
-// GetLedger gets ledger data
-func (s *Service) GetLedger(ctx context.Context, req *lindapb.LedgerRequest) (*lindapb.LedgerResponse, error) {
-	// Implementation would call the appropriate handler or service
-	return &lindapb.LedgerResponse{}, nil
-}
-
-// Search performs a search
-func (s *Service) Search(ctx context.Context, req *lindapb.SearchRequest) (*lindapb.SearchResponse, error) {
-	// Implementation would call the appropriate handler or service
-	return &lindapb.SearchResponse{}, nil
-}
-
-// GetFund gets fund data
-func (s *Service) GetFund(ctx context.Context, req *lindapb.FundRequest) (*lindapb.FundResponse, error) {
-	// Implementation would call the appropriate handler or service
-	return &lindapb.FundResponse{}, nil
-}
-
-// RequestTestnetCoins requests testnet coins
-func (s *Service) RequestTestnetCoins(ctx context.Context, req *lindapb.TestnetRequest) (*lindapb.TestnetResponse, error) {
-	// Implementation would call the appropriate handler or service
-	return &lindapb.TestnetResponse{}, nil
-}
-
-// ExportToCSV exports data to CSV
-func (s *Service) ExportToCSV(ctx context.Context, req *lindapb.CSVExportRequest) (*lindapb.CSVExportResponse, error) {
-	// Implementation would call the appropriate handler or service
-	return &lindapb.CSVExportResponse{}, nil
-}
-
-// Monitor handles monitor requests
-func (s *Service) Monitor(ctx context.Context, req *lindapb.MonitorRequest) (*lindapb.MonitorResponse, error) {
-	// Implementation would call the appropriate handler or service
-	return &lindapb.MonitorResponse{}, nil
+// internal/services/lindascan/service.go
+package lindascan
+
+import (
+	"context"
+	"encoding/hex"
+	"time"
+
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/blockchain"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/storage/repository"
+	"github.com/lindaprotocol/grpc-api-gateway/pkg/lindapb"
+)
+
+// Service implements the LindascanServer interface
+type Service struct {
+	lindapb.UnimplementedLindascanServer
+	blockchainClient *blockchain.Client
+	accountRepo      *repository.AccountRepository
+	blockRepo        *repository.BlockRepository
+	txRepo           *repository.TransactionRepository
+	tokenRepo        *repository.TokenRepository
+	eventRepo        *repository.EventRepository
+	tagRepo          *repository.TagRepository
+	statsRepo        *repository.StatsRepository
+}
+
+// NewService creates a new Lindascan service
+func NewService(
+	client *blockchain.Client,
+	accountRepo *repository.AccountRepository,
+	blockRepo *repository.BlockRepository,
+	txRepo *repository.TransactionRepository,
+	tokenRepo *repository.TokenRepository,
+	eventRepo *repository.EventRepository,
+	tagRepo *repository.TagRepository,
+	statsRepo *repository.StatsRepository,
+) *Service {
+	return &Service{
+		blockchainClient: client,
+		accountRepo:      accountRepo,
+		blockRepo:        blockRepo,
+		txRepo:           txRepo,
+		tokenRepo:        tokenRepo,
+		eventRepo:        eventRepo,
+		tagRepo:          tagRepo,
+		statsRepo:        statsRepo,
+	}
+}
+
+// ==================== Lindascan Custom Methods ====================
+
+// GetHomepageBundle gets homepage bundle data
+func (s *Service) GetHomepageBundle(ctx context.Context, req *lindapb.EmptyMessage) (*lindapb.HomepageBundle, error) {
+	// Implementation would call the appropriate handler or service
+	return &lindapb.HomepageBundle{}, nil
+}
+
+// GetNodeMap gets node map data
+func (s *Service) GetNodeMap(ctx context.Context, req *lindapb.EmptyMessage) (*lindapb.NodeMapResponse, error) {
+	// Implementation would call the appropriate handler or service
+	return &lindapb.NodeMapResponse{}, nil
+}
+
+// GetTop10 gets top 10 data
+func (s *Service) GetTop10(ctx context.Context, req *lindapb.Top10Request) (*lindapb.Top10Response, error) {
+	// Implementation would call the appropriate handler or service
+	return &lindapb.Top10Response{}, nil
+}
+
+// ProxyRequest proxies a request to an external API
+func (s *Service) ProxyRequest(ctx context.Context, req *lindapb.ProxyRequestMessage) (*lindapb.ProxyResponse, error) {
+	// Implementation would call the appropriate handler or service
+	return &lindapb.ProxyResponse{}, nil
+}
+
+// GetTokens gets token list
+func (s *Service) GetTokens(ctx context.Context, req *lindapb.TokenListRequest) (*lindapb.TokenListResponse, error) {
+	// Implementation would call the appropriate handler or service
+	return &lindapb.TokenListResponse{}, nil
+}
+
+// GetTokenById gets token by ID
+func (s *Service) GetTokenById(ctx context.Context, req *lindapb.TokenByIdRequest) (*lindapb.TokenInfo, error) {
+	// Implementation would call the appropriate handler or service
+	return &lindapb.TokenInfo{}, nil
+}
+
+// GetLRC20Tokens gets LRC20 tokens
+func (s *Service) GetLRC20Tokens(ctx context.Context, req *lindapb.LRC20TokenRequest) (*lindapb.LRC20TokenListResponse, error) {
+	// Implementation would call the appropriate handler or service
+	return &lindapb.LRC20TokenListResponse{}, nil
+}
+
+// GetLRC20TokenByContract gets LRC20 token by contract address
+func (s *Service) GetLRC20TokenByContract(ctx context.Context, req *lindapb.LRC20TokenByContractRequest) (*lindapb.LRC20TokenInfo, error) {
+	// Implementation would call the appropriate handler or service
+	return &lindapb.LRC20TokenInfo{}, nil
+}
+
+// GetTokenHolders gets token holders
+func (s *Service) GetTokenHolders(ctx context.Context, req *lindapb.TokenHoldersRequest) (*lindapb.TokenHoldersResponse, error) {
+	// Implementation would call the appropriate handler or service
+	return &lindapb.TokenHoldersResponse{}, nil
+}
+
+// GetTokenTransfers gets token transfers
+func (s *Service) GetTokenTransfers(ctx context.Context, req *lindapb.TokenTransfersRequest) (*lindapb.TokenTransfersResponse, error) {
+	// Implementation would call the appropriate handler or service
+	return &lindapb.TokenTransfersResponse{}, nil
+}
+
+// GetTokensOverview gets tokens overview
+func (s *Service) GetTokensOverview(ctx context.Context, req *lindapb.TokensOverviewRequest) (*lindapb.TokensOverviewResponse, error) {
+	// Implementation would call the appropriate handler or service
+	return &lindapb.TokensOverviewResponse{}, nil
+}
+
+// GetTokenPrice gets token price
+func (s *Service) GetTokenPrice(ctx context.Context, req *lindapb.EmptyMessage) (*lindapb.TokenPriceResponse, error) {
+	// Implementation would call the appropriate handler or service
+	return &lindapb.TokenPriceResponse{}, nil
+}
+
+// GetParticipateAssetIssue gets participate asset issue data
+func (s *Service) GetParticipateAssetIssue(ctx context.Context, req *lindapb.ParticipateAssetIssueRequest) (*lindapb.ParticipateAssetIssueResponse, error) {
+	// Implementation would call the appropriate handler or service
+	return &lindapb.ParticipateAssetIssueResponse{}, nil
+}
+
+// GetWinkFund gets WINK fund data
+func (s *Service) GetWinkFund(ctx context.Context, req *lindapb.EmptyMessage) (*lindapb.WinkFundResponse, error) {
+	// Implementation would call the appropriate handler or service
+	return &lindapb.WinkFundResponse{}, nil
+}
+
+// GetWinkGraphic gets WINK graphic data
+func (s *Service) GetWinkGraphic(ctx context.Context, req *lindapb.EmptyMessage) (*lindapb.GraphicResponse, error) {
+	// Implementation would call the appropriate handler or service
+	return &lindapb.GraphicResponse{}, nil
+}
+
+// GetJSTFund gets JST fund data
+func (s *Service) GetJSTFund(ctx context.Context, req *lindapb.EmptyMessage) (*lindapb.JSTFundResponse, error) {
+	// Implementation would call the appropriate handler or service
+	return &lindapb.JSTFundResponse{}, nil
+}
+
+// GetJSTGraphic gets JST graphic data
+func (s *Service) GetJSTGraphic(ctx context.Context, req *lindapb.EmptyMessage) (*lindapb.GraphicResponse, error) {
+	// Implementation would call the appropriate handler or service
+	return &lindapb.GraphicResponse{}, nil
+}
+
+// GetBitTorrentGraphic gets BitTorrent graphic data
+func (s *Service) GetBitTorrentGraphic(ctx context.Context, req *lindapb.EmptyMessage) (*lindapb.GraphicResponse, error) {
+	// Implementation would call the appropriate handler or service
+	return &lindapb.GraphicResponse{}, nil
+}
+
+// GetTokenPositionDistribution gets token position distribution
+func (s *Service) GetTokenPositionDistribution(ctx context.Context, req *lindapb.TokenPositionRequest) (*lindapb.TokenPositionResponse, error) {
+	// Implementation would call the appropriate handler or service
+	return &lindapb.TokenPositionResponse{}, nil
+}
+
+// GetAssetTransfer gets asset transfers
+func (s *Service) GetAssetTransfer(ctx context.Context, req *lindapb.AssetTransferRequest) (*lindapb.AssetTransferResponse, error) {
+	// Implementation would call the appropriate handler or service
+	return &lindapb.AssetTransferResponse{}, nil
+}
+
+// GetAccountList gets account list
+func (s *Service) GetAccountList(ctx context.Context, req *lindapb.AccountListRequest) (*lindapb.AccountListResponse, error) {
+	// Implementation would call the appropriate handler or service
+	return &lindapb.AccountListResponse{}, nil
+}
+
+// GetAccountResource gets account resource - CORRECT SIGNATURE for Lindascan
+func (s *Service) GetAccountResource(ctx context.Context, req *lindapb.AccountResourceRequest) (*lindapb.AccountResourceResponse, error) {
+	// Implementation would call the appropriate handler or service
+	return &lindapb.AccountResourceResponse{}, nil
+}
+
+// GetAccountOverview gets account overview
+func (s *Service) GetAccountOverview(ctx context.Context, req *lindapb.AccountOverviewRequest) (*lindapb.AccountOverviewResponse, error) {
+	// Implementation would call the appropriate handler or service
+	return &lindapb.AccountOverviewResponse{}, nil
+}
+
+// GetAccountProposals gets account proposals
+func (s *Service) GetAccountProposals(ctx context.Context, req *lindapb.AccountProposalRequest) (*lindapb.AccountProposalResponse, error) {
+	// Implementation would call the appropriate handler or service
+	return &lindapb.AccountProposalResponse{}, nil
+}
+
+// GetTags gets tags
+func (s *Service) GetTags(ctx context.Context, req *lindapb.TagRequest) (*lindapb.TagListResponse, error) {
+	// Implementation would call the appropriate handler or service
+	return &lindapb.TagListResponse{}, nil
+}
+
+// InsertTag inserts a tag
+func (s *Service) InsertTag(ctx context.Context, req *lindapb.TagInsertRequest) (*lindapb.TagResponse, error) {
+	// Implementation would call the appropriate handler or service
+	return &lindapb.TagResponse{}, nil
+}
+
+// UpdateTag updates a tag
+func (s *Service) UpdateTag(ctx context.Context, req *lindapb.TagUpdateRequest) (*lindapb.TagResponse, error) {
+	// Implementation would call the appropriate handler or service
+	return &lindapb.TagResponse{}, nil
+}
+
+// DeleteTag deletes a tag
+func (s *Service) DeleteTag(ctx context.Context, req *lindapb.TagDeleteRequest) (*lindapb.TagResponse, error) {
+	// Implementation would call the appropriate handler or service
+	return &lindapb.TagResponse{}, nil
+}
+
+// RecommendTag recommends tags
+func (s *Service) RecommendTag(ctx context.Context, req *lindapb.TagRecommendRequest) (*lindapb.TagListResponse, error) {
+	// Implementation would call the appropriate handler or service
+	return &lindapb.TagListResponse{}, nil
+}
+
+// UploadLogo uploads a logo
+func (s *Service) UploadLogo(ctx context.Context, req *lindapb.UploadLogoRequest) (*lindapb.UploadLogoResponse, error) {
+	// Implementation would call the appropriate handler or service
+	return &lindapb.UploadLogoResponse{}, nil
+}
+
+// GetVoteInfo gets vote information
+func (s *Service) GetVoteInfo(ctx context.Context, req *lindapb.VoteInfoRequest) (*lindapb.VoteInfoResponse, error) {
+	// Implementation would call the appropriate handler or service
+	return &lindapb.VoteInfoResponse{}, nil
+}
+
+// GetChainParametersV2 gets chain parameters v2
+func (s *Service) GetChainParametersV2(ctx context.Context, req *lindapb.EmptyMessage) (*lindapb.ChainParametersV2, error) {
+	// Implementation would call the appropriate handler or service
+	return &lindapb.ChainParametersV2{}, nil
+}
+
+// GetBlocksV2 gets blocks v2
+func (s *Service) GetBlocksV2(ctx context.Context, req *lindapb.BlockListV2Request) (*lindapb.BlockListV2Response, error) {
+	// Implementation would call the appropriate handler or service
+	return &lindapb.BlockListV2Response{}, nil
+}
+
+// GetTransactionsV2 gets transactions v2
+func (s *Service) GetTransactionsV2(ctx context.Context, req *lindapb.TransactionListV2Request) (*lindapb.TransactionListV2Response, error) {
+	// Implementation would call the appropriate handler or service
+	return &lindapb.TransactionListV2Response{}, nil
+}
+
+// GetInternalTransactions gets internal transactions
+func (s *Service) GetInternalTransactions(ctx context.Context, req *lindapb.InternalTransactionRequest) (*lindapb.InternalTransactionResponse, error) {
+	// Implementation would call the appropriate handler or service
+	return &lindapb.InternalTransactionResponse{}, nil
+}
+
+// GetContractTransactions gets contract transactions
+func (s *Service) GetContractTransactions(ctx context.Context, req *lindapb.ContractTransactionRequest) (*lindapb.ContractTransactionResponse, error) {
+	// Implementation would call the appropriate handler or service
+	return &lindapb.ContractTransactionResponse{}, nil
+}
+
+// GetLRC10LRC20Transfers gets LRC10/LRC20 transfers
+func (s *Service) GetLRC10LRC20Transfers(ctx context.Context, req *lindapb.LRCTransferRequest) (*lindapb.LRCTransferResponse, error) {
+	// Implementation would call the appropriate handler or service
+	return &lindapb.LRCTransferResponse{}, nil
+}
+
+// GetContractAccountHistory gets contract account history
+func (s *Service) GetContractAccountHistory(ctx context.Context, req *lindapb.ContractAccountHistoryRequest) (*lindapb.ContractAccountHistoryResponse, error) {
+	// Implementation would call the appropriate handler or service
+	return &lindapb.ContractAccountHistoryResponse{}, nil
+}
+
+// GetSmartContractTriggersBatch gets the indexed calls to a set of contracts, newest first
+func (s *Service) GetSmartContractTriggersBatch(ctx context.Context, req *lindapb.SmartContractTriggersRequest) (*lindapb.SmartContractTriggersResponse, error) {
+	limit := int(req.Limit)
+	if limit <= 0 || limit > 200 {
+		limit = 20
+	}
+	to := req.To
+	if to <= 0 {
+		to = time.Now().UnixMilli()
+	}
+	if len(req.Contracts) == 0 {
+		return &lindapb.SmartContractTriggersResponse{}, nil
+	}
+
+	triggers, total, err := s.statsRepo.GetContractTriggers(req.Contracts, req.From, to, int(req.Start), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &lindapb.SmartContractTriggersResponse{
+		Triggers: make([]*lindapb.Transaction, 0, len(triggers)),
+		Total:    total,
+	}
+	for _, trigger := range triggers {
+		txID, err := hex.DecodeString(trigger.TransactionID)
+		if err != nil {
+			return nil, err
+		}
+		tx, err := s.blockchainClient.GetTransactionById(ctx, &lindapb.BytesMessage{Value: txID})
+		if err != nil {
+			return nil, err
+		}
+		resp.Triggers = append(resp.Triggers, tx)
+	}
+	return resp, nil
+}
+
+// GetEnergyStatistic gets energy statistics
+func (s *Service) GetEnergyStatistic(ctx context.Context, req *lindapb.EnergyStatisticRequest) (*lindapb.EnergyStatisticResponse, error) {
+	// Implementation would call the appropriate handler or service
+	return &lindapb.EnergyStatisticResponse{}, nil
+}
+
+// GetTriggerStatistic gets trigger statistics
+func (s *Service) GetTriggerStatistic(ctx context.Context, req *lindapb.TriggerStatisticRequest) (*lindapb.TriggerStatisticResponse, error) {
+	// Implementation would call the appropriate handler or service
+	return &lindapb.TriggerStatisticResponse{}, nil
+}
+
+// GetCallerAddressStatistic gets caller address statistics
+func (s *Service) GetCallerAddressStatistic(ctx context.Context, req *lindapb.CallerAddressStatisticRequest) (*lindapb.CallerAddressStatisticResponse, error) {
+	// Implementation would call the appropriate handler or service
+	return &lindapb.CallerAddressStatisticResponse{}, nil
+}
+
+// GetEnergyDailyStatistic gets daily energy statistics
+func (s *Service) GetEnergyDailyStatistic(ctx context.Context, req *lindapb.EnergyDailyStatisticRequest) (*lindapb.EnergyDailyStatisticResponse, error) {
+	// Implementation would call the appropriate handler or service
+	return &lindapb.EnergyDailyStatisticResponse{}, nil
+}
+
+// GetTriggerAmountStatistic gets trigger amount statistics
+func (s *Service) GetTriggerAmountStatistic(ctx context.Context, req *lindapb.TriggerAmountStatisticRequest) (*lindapb.TriggerAmountStatisticResponse, error) {
+	// Implementation would call the appropriate handler or service
+	return &lindapb.TriggerAmountStatisticResponse{}, nil
+}
+
+// GetFreezeResource gets freeze resource data
+func (s *Service) GetFreezeResource(ctx context.Context, req *lindapb.FreezeResourceRequest) (*lindapb.FreezeResourceResponse, error) {
+	// Implementation would call the appropriate handler or service
+	return &lindapb.FreezeResourceResponse{}, nil
+}
+
+// GetTurnover gets turnover data
+func (s *Service) GetTurnover(ctx context.Context, req *lindapb.TurnoverRequest) (*lindapb.TurnoverResponse, error) {
+	// Implementation would call the appropriate handler or service
+	return &lindapb.TurnoverResponse{}, nil
+}
+
+// GetLindHolderStats gets LIND holder statistics
+func (s *Service) GetLindHolderStats(ctx context.Context, req *lindapb.LindHolderRequest) (*lindapb.LindHolderResponse, error) {
+	// Implementation would call the appropriate handler or service
+	return &lindapb.LindHolderResponse{}, nil
+}
+
+// GetOneContractEnergyStatistic gets one contract energy statistics
+func (s *Service) GetOneContractEnergyStatistic(ctx context.Context, req *lindapb.OneContractEnergyStatisticRequest) (*lindapb.OneContractEnergyStatisticResponse, error) {
+	// Implementation would call the appropriate handler or service
+	return &lindapb.OneContractEnergyStatisticResponse{}, nil
+}
+
+// GetOneContractTriggerStatistic gets one contract trigger statistics
+func (s *Service) GetOneContractTriggerStatistic(ctx context.Context, req *lindapb.OneContractTriggerStatisticRequest) (*lindapb.OneContractTriggerStatisticResponse, error) {
+	// Implementation would call the appropriate handler or service
+	return &lindapb.OneContractTriggerStatisticResponse{}, nil
+}
+
+// GetOneContractCallerStatistic gets one contract caller statistics
+func (s *Service) GetOneContractCallerStatistic(ctx context.Context, req *lindapb.OneContractCallerStatisticRequest) (*lindapb.OneContractCallerStatisticResponse, error) {
+	// Implementation would call the appropriate handler or service
+	return &lindapb.OneContractCallerStatisticResponse{}, nil
+}
+
+// GetOneContractCallers gets one contract callers
+func (s *Service) GetOneContractCallers(ctx context.Context, req *lindapb.OneContractCallersRequest) (*lindapb.OneContractCallersResponse, error) {
+	// Implementation would call the appropriate handler or service
+	return &lindapb.OneContractCallersResponse{}, nil
+}
+
+// GetNodeOverviewUpload gets node overview upload
+func (s *Service) GetNodeOverviewUpload(ctx context.Context, req *lindapb.NodeOverviewRequest) (*lindapb.NodeOverviewResponse, error) {
+	// Implementation would call the appropriate handler or service
+	return &lindapb.NodeOverviewResponse{}, nil
+}
+
+// GetNodeInfoUpload gets node info upload
+func (s *Service) GetNodeInfoUpload(ctx context.Context, req *lindapb.NodeInfoUploadRequest) (*lindapb.NodeInfoUploadResponse, error) {
+	// Implementation would call the appropriate handler or service
+	return &lindapb.NodeInfoUploadResponse{}, nil
+}
+
+// GetLedger gets ledger data
+func (s *Service) GetLedger(ctx context.Context, req *lindapb.LedgerRequest) (*lindapb.LedgerResponse, error) {
+	// Implementation would call the appropriate handler or service
+	return &lindapb.LedgerResponse{}, nil
+}
+
+// Search performs a search
+func (s *Service) Search(ctx context.Context, req *lindapb.SearchRequest) (*lindapb.SearchResponse, error) {
+	// Implementation would call the appropriate handler or service
+	return &lindapb.SearchResponse{}, nil
+}
+
+// GetFund gets fund data
+func (s *Service) GetFund(ctx context.Context, req *lindapb.FundRequest) (*lindapb.FundResponse, error) {
+	// Implementation would call the appropriate handler or service
+	return &lindapb.FundResponse{}, nil
+}
+
+// RequestTestnetCoins requests testnet coins
+func (s *Service) RequestTestnetCoins(ctx context.Context, req *lindapb.TestnetRequest) (*lindapb.TestnetResponse, error) {
+	// Implementation would call the appropriate handler or service
+	return &lindapb.TestnetResponse{}, nil
+}
+
+// ExportToCSV exports data to CSV
+func (s *Service) ExportToCSV(ctx context.Context, req *lindapb.CSVExportRequest) (*lindapb.CSVExportResponse, error) {
+	// Implementation would call the appropriate handler or service
+	return &lindapb.CSVExportResponse{}, nil
+}
+
+// Monitor handles monitor requests
+func (s *Service) Monitor(ctx context.Context, req *lindapb.MonitorRequest) (*lindapb.MonitorResponse, error) {
+	// Implementation would call the appropriate handler or service
+	return &lindapb.MonitorResponse{}, nil
 }
\ No newline at end of file
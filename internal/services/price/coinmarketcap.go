@@ -0,0 +1,95 @@
+package price
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/lindaprotocol/grpc-api-gateway/internal/config"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
+)
+
+// cmcQuotesResponse is the body of the CoinMarketCap quotes/latest endpoint
+type cmcQuotesResponse struct {
+	Status struct {
+		ErrorCode    int    `json:"error_code"`
+		ErrorMessage string `json:"error_message"`
+	} `json:"status"`
+	Data map[string]struct {
+		Quote map[string]struct {
+			Price            float64   `json:"price"`
+			Volume24h        float64   `json:"volume_24h"`
+			PercentChange24h float64   `json:"percent_change_24h"`
+			MarketCap        float64   `json:"market_cap"`
+			LastUpdated      time.Time `json:"last_updated"`
+		} `json:"quote"`
+	} `json:"data"`
+}
+
+// CoinMarketCapProvider takes USD quotes from the CoinMarketCap API
+type CoinMarketCapProvider struct {
+	config     config.CoinMarketCapConfig
+	httpClient *http.Client
+}
+
+func NewCoinMarketCapProvider(cfg config.CoinMarketCapConfig) *CoinMarketCapProvider {
+	if cfg.BaseURL == "" {
+		cfg.BaseURL = "https://pro-api.coinmarketcap.com/v1"
+	}
+	return &CoinMarketCapProvider{
+		config:     cfg,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (p *CoinMarketCapProvider) Name() string {
+	return models.PriceSourceCoinMarketCap
+}
+
+func (p *CoinMarketCapProvider) Quotes(ctx context.Context, symbols []string) (map[string]*Quote, error) {
+	query := url.Values{}
+	query.Set("symbol", strings.Join(symbols, ","))
+	query.Set("convert", "USD")
+
+	endpoint := strings.TrimRight(p.config.BaseURL, "/") + "/cryptocurrency/quotes/latest?" + query.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("X-CMC_PRO_API_KEY", p.config.APIKey)
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var body cmcQuotesResponse
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("coinmarketcap returned status %d: %w", resp.StatusCode, err)
+	}
+	if resp.StatusCode != http.StatusOK || body.Status.ErrorCode != 0 {
+		return nil, fmt.Errorf("coinmarketcap returned status %d: %s", resp.StatusCode, body.Status.ErrorMessage)
+	}
+
+	quotes := make(map[string]*Quote)
+	for symbol, data := range body.Data {
+		usd, ok := data.Quote["USD"]
+		if !ok {
+			continue
+		}
+		quotes[symbol] = &Quote{
+			Price:     usd.Price,
+			Volume24h: usd.Volume24h,
+			Change24h: usd.PercentChange24h,
+			MarketCap: usd.MarketCap,
+			Timestamp: usd.LastUpdated.UnixMilli(),
+		}
+	}
+	return quotes, nil
+}
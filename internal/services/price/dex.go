@@ -0,0 +1,82 @@
+package price
+
+import (
+	"context"
+	"errors"
+	"math"
+	"time"
+
+	"github.com/lindaprotocol/grpc-api-gateway/internal/config"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/storage/repository"
+	"gorm.io/gorm"
+)
+
+// DEXProvider prices one symbol from the last indexed trade of a Bancor
+// exchange pairing it with a USD stablecoin
+type DEXProvider struct {
+	config       config.DEXPriceConfig
+	exchangeRepo *repository.ExchangeRepository
+}
+
+func NewDEXProvider(cfg config.DEXPriceConfig, exchangeRepo *repository.ExchangeRepository) *DEXProvider {
+	if cfg.Symbol == "" {
+		cfg.Symbol = "LIND"
+	}
+	return &DEXProvider{
+		config:       cfg,
+		exchangeRepo: exchangeRepo,
+	}
+}
+
+func (p *DEXProvider) Name() string {
+	return models.PriceSourceDEX
+}
+
+func (p *DEXProvider) Quotes(ctx context.Context, symbols []string) (map[string]*Quote, error) {
+	quotes := make(map[string]*Quote)
+	if !containsSymbol(symbols, p.config.Symbol) {
+		return quotes, nil
+	}
+
+	trade, err := p.exchangeRepo.GetLastExchangeTrade(p.config.ExchangeID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return quotes, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if trade.Price <= 0 {
+		return quotes, nil
+	}
+
+	volume, err := p.exchangeRepo.GetExchangeVolume(p.config.ExchangeID, time.Now().Add(-24*time.Hour).UnixMilli())
+	if err != nil {
+		return nil, err
+	}
+
+	// Trade prices are in quote units per base unit, the first token of the exchange is the base
+	baseScale := math.Pow10(p.config.BaseDecimals)
+	quoteScale := math.Pow10(p.config.QuoteDecimals)
+	quote := &Quote{
+		Price:     trade.Price * baseScale / quoteScale,
+		Volume24h: float64(volume.QuoteVolume) / quoteScale,
+		Timestamp: trade.BlockTimestamp,
+	}
+	if p.config.Invert {
+		quote.Price = 1 / quote.Price
+		quote.Volume24h = float64(volume.Volume) / baseScale
+	}
+
+	quotes[p.config.Symbol] = quote
+	return quotes, nil
+}
+
+func containsSymbol(symbols []string, symbol string) bool {
+	for _, s := range symbols {
+		if s == symbol {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,118 @@
+package price
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+
+	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
+)
+
+// Quote is the USD price of an asset reported by a provider
+type Quote struct {
+	Price     float64 `json:"price"`
+	Volume24h float64 `json:"volume_24h"`
+	High24h   float64 `json:"high_24h"`
+	Low24h    float64 `json:"low_24h"`
+	Change24h float64 `json:"change_24h"` // percent
+	MarketCap float64 `json:"market_cap"`
+	Timestamp int64   `json:"timestamp"` // milliseconds
+}
+
+// Provider is a source of USD prices
+type Provider interface {
+	// Name is the value of MarketData.Source for quotes of this provider
+	Name() string
+	// Quotes returns the quotes of the requested symbols the provider knows,
+	// keyed by symbol
+	Quotes(ctx context.Context, symbols []string) (map[string]*Quote, error)
+}
+
+// StaticProvider reads quotes from a JSON file of the form
+// {"LIND": {"price": 0.012, "timestamp": 1700000000000}}. Quotes without a
+// timestamp take the modification time of the file.
+type StaticProvider struct {
+	path string
+}
+
+func NewStaticProvider(path string) *StaticProvider {
+	return &StaticProvider{path: path}
+}
+
+func (p *StaticProvider) Name() string {
+	return models.PriceSourceStatic
+}
+
+func (p *StaticProvider) Quotes(ctx context.Context, symbols []string) (map[string]*Quote, error) {
+	info, err := os.Stat(p.path)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(p.path)
+	if err != nil {
+		return nil, err
+	}
+
+	var all map[string]*Quote
+	if err := json.Unmarshal(data, &all); err != nil {
+		return nil, err
+	}
+
+	quotes := make(map[string]*Quote)
+	for _, symbol := range symbols {
+		if quote, ok := all[symbol]; ok && quote != nil {
+			if quote.Timestamp <= 0 {
+				quote.Timestamp = info.ModTime().UnixMilli()
+			}
+			quotes[symbol] = quote
+		}
+	}
+	return quotes, nil
+}
+
+// MockProvider serves quotes set in memory, for tests and local development
+type MockProvider struct {
+	mu     sync.Mutex
+	quotes map[string]*Quote
+	err    error
+}
+
+func NewMockProvider() *MockProvider {
+	return &MockProvider{quotes: make(map[string]*Quote)}
+}
+
+// SetQuote sets the quote returned for a symbol
+func (p *MockProvider) SetQuote(symbol string, quote *Quote) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.quotes[symbol] = quote
+}
+
+// SetError makes Quotes fail with err, nil restores it
+func (p *MockProvider) SetError(err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.err = err
+}
+
+func (p *MockProvider) Name() string {
+	return models.PriceSourceMock
+}
+
+func (p *MockProvider) Quotes(ctx context.Context, symbols []string) (map[string]*Quote, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	quotes := make(map[string]*Quote)
+	for _, symbol := range symbols {
+		if quote, ok := p.quotes[symbol]; ok {
+			copied := *quote
+			quotes[symbol] = &copied
+		}
+	}
+	return quotes, nil
+}
@@ -0,0 +1,209 @@
+package price
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/config"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/storage/repository"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// NewProviders creates the providers named in the price feed configuration, in order
+func NewProviders(cfg config.PriceFeedConfig, external config.ExternalAPIConfig, exchangeRepo *repository.ExchangeRepository) ([]Provider, error) {
+	providers := make([]Provider, 0, len(cfg.Providers))
+	for _, name := range cfg.Providers {
+		switch name {
+		case models.PriceSourceCoinMarketCap:
+			providers = append(providers, NewCoinMarketCapProvider(external.CoinMarketCap))
+		case models.PriceSourceDEX:
+			providers = append(providers, NewDEXProvider(cfg.DEX, exchangeRepo))
+		case models.PriceSourceStatic:
+			providers = append(providers, NewStaticProvider(cfg.StaticFile))
+		case models.PriceSourceMock:
+			providers = append(providers, NewMockProvider())
+		default:
+			return nil, fmt.Errorf("unknown price provider %q", name)
+		}
+	}
+	return providers, nil
+}
+
+// Store keeps the quotes taken by the refresher, repository.TokenRepository in production
+type Store interface {
+	SaveMarketData(quotes []*models.MarketData) error
+	GetMarketData(pair string, at int64) (*models.MarketData, error)
+}
+
+// Refresher periodically takes the USD price of the configured symbols from
+// the first provider with a fresh quote and stores it in market_data
+type Refresher struct {
+	config    config.PriceFeedConfig
+	providers []Provider
+	store     Store
+	logger    *logrus.Logger
+	stopChan  chan struct{}
+	wg        sync.WaitGroup
+
+	// last stored quote time of each pair, so unchanged quotes are not stored again
+	lastStored map[string]int64
+}
+
+func NewRefresher(cfg config.PriceFeedConfig, store Store, providers []Provider) *Refresher {
+	if cfg.RefreshInterval <= 0 {
+		cfg.RefreshInterval = time.Minute
+	}
+	if cfg.StaleAfter <= 0 {
+		cfg.StaleAfter = 15 * time.Minute
+	}
+	if len(cfg.Symbols) == 0 {
+		cfg.Symbols = []string{"LIND"}
+	}
+
+	return &Refresher{
+		config:     cfg,
+		providers:  providers,
+		store:      store,
+		logger:     logrus.New(),
+		stopChan:   make(chan struct{}),
+		lastStored: make(map[string]int64),
+	}
+}
+
+// Start begins refreshing prices
+func (r *Refresher) Start() {
+	r.logger.Info("Starting price refresher")
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+
+		ticker := time.NewTicker(r.config.RefreshInterval)
+		defer ticker.Stop()
+
+		for {
+			if err := r.Refresh(context.Background()); err != nil {
+				r.logger.WithError(err).Error("Failed to refresh prices")
+			}
+
+			select {
+			case <-ticker.C:
+			case <-r.stopChan:
+				return
+			}
+		}
+	}()
+}
+
+// Stop halts price refreshing
+func (r *Refresher) Stop() {
+	r.logger.Info("Stopping price refresher")
+	close(r.stopChan)
+	r.wg.Wait()
+}
+
+// Refresh asks the providers in order for the symbols still without a fresh
+// quote, stores the quotes taken and warns about symbols whose stored price is stale
+func (r *Refresher) Refresh(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, r.config.RefreshInterval)
+	defer cancel()
+
+	now := time.Now()
+	remaining := r.config.Symbols
+	var quotes []*models.MarketData
+
+	for _, provider := range r.providers {
+		if len(remaining) == 0 {
+			break
+		}
+
+		got, err := provider.Quotes(ctx, remaining)
+		if err != nil {
+			r.logger.WithError(err).WithField("provider", provider.Name()).Warn("Price provider failed")
+			continue
+		}
+
+		var missing []string
+		for _, symbol := range remaining {
+			quote, ok := got[symbol]
+			if !ok || quote.Price <= 0 || r.isStale(quote.Timestamp, now) {
+				missing = append(missing, symbol)
+				continue
+			}
+
+			pair := models.MarketDataPair(symbol)
+			if r.lastStored[pair] == quote.Timestamp {
+				continue
+			}
+			quotes = append(quotes, marketData(pair, provider.Name(), quote))
+		}
+		remaining = missing
+	}
+
+	if err := r.store.SaveMarketData(quotes); err != nil {
+		return err
+	}
+	for _, data := range quotes {
+		r.lastStored[data.Pair] = data.Timestamp
+	}
+
+	for _, symbol := range remaining {
+		r.checkStale(symbol, now)
+	}
+	return nil
+}
+
+// checkStale warns when no provider had a fresh quote for a symbol and its stored price is stale
+func (r *Refresher) checkStale(symbol string, now time.Time) {
+	latest, err := r.store.GetMarketData(models.MarketDataPair(symbol), 0)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		r.logger.WithField("symbol", symbol).Warn("No price available")
+		return
+	}
+	if err != nil {
+		r.logger.WithError(err).WithField("symbol", symbol).Error("Failed to get stored price")
+		return
+	}
+	if r.isStale(latest.Timestamp, now) {
+		r.logger.WithFields(logrus.Fields{
+			"symbol": symbol,
+			"source": latest.Source,
+			"age":    now.Sub(time.UnixMilli(latest.Timestamp)).Round(time.Second).String(),
+		}).Warn("Price is stale")
+	}
+}
+
+func (r *Refresher) isStale(timestamp int64, now time.Time) bool {
+	return now.Sub(time.UnixMilli(timestamp)) > r.config.StaleAfter
+}
+
+func marketData(pair, source string, quote *Quote) *models.MarketData {
+	data := &models.MarketData{
+		ID:        uuid.New().String(),
+		Pair:      pair,
+		Price:     formatAmount(quote.Price),
+		Volume24h: formatAmount(quote.Volume24h),
+		Change24h: strconv.FormatFloat(quote.Change24h, 'f', 2, 64),
+		MarketCap: formatAmount(quote.MarketCap),
+		Source:    source,
+		Timestamp: quote.Timestamp,
+	}
+	if quote.High24h > 0 {
+		data.High24h = formatAmount(quote.High24h)
+	}
+	if quote.Low24h > 0 {
+		data.Low24h = formatAmount(quote.Low24h)
+	}
+	return data
+}
+
+func formatAmount(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
@@ -0,0 +1,235 @@
+package price
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/lindaprotocol/grpc-api-gateway/internal/config"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/storage/repository"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// memoryStore keeps market data in memory with the lookup rules of TokenRepository
+type memoryStore struct {
+	data []*models.MarketData
+}
+
+func (s *memoryStore) SaveMarketData(quotes []*models.MarketData) error {
+	s.data = append(s.data, quotes...)
+	return nil
+}
+
+func (s *memoryStore) GetMarketData(pair string, at int64) (*models.MarketData, error) {
+	var found *models.MarketData
+	for _, data := range s.data {
+		if data.Pair != pair || (at > 0 && data.Timestamp > at) {
+			continue
+		}
+		if found == nil || data.Timestamp > found.Timestamp {
+			found = data
+		}
+	}
+	if found == nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return found, nil
+}
+
+func (s *memoryStore) prices(pair string) []string {
+	var prices []string
+	for _, data := range s.data {
+		if data.Pair == pair {
+			prices = append(prices, data.Price)
+		}
+	}
+	return prices
+}
+
+func newTestRefresher(store Store, symbols []string, providers ...Provider) *Refresher {
+	r := NewRefresher(config.PriceFeedConfig{
+		Symbols:    symbols,
+		StaleAfter: 10 * time.Minute,
+	}, store, providers)
+	r.logger.SetOutput(io.Discard)
+	return r
+}
+
+func TestRefreshFallsBackToNextProvider(t *testing.T) {
+	now := time.Now().UnixMilli()
+	primary := NewMockProvider()
+	secondary := NewMockProvider()
+	primary.SetQuote("LIND", &Quote{Price: 0.5, Timestamp: now})
+	secondary.SetQuote("LIND", &Quote{Price: 0.6, Timestamp: now})
+	secondary.SetQuote("USDL", &Quote{Price: 1, Timestamp: now})
+
+	store := &memoryStore{}
+	r := newTestRefresher(store, []string{"LIND", "USDL"}, primary, secondary)
+	if err := r.Refresh(context.Background()); err != nil {
+		t.Fatalf("Refresh: %v", err)
+	}
+
+	// LIND is taken from the first provider, USDL only the second one knows
+	if got := store.prices("LIND_USD"); len(got) != 1 || got[0] != "0.5" {
+		t.Errorf("LIND prices = %v, want [0.5]", got)
+	}
+	if got := store.prices("USDL_USD"); len(got) != 1 || got[0] != "1" {
+		t.Errorf("USDL prices = %v, want [1]", got)
+	}
+}
+
+func TestRefreshSkipsFailingProvider(t *testing.T) {
+	now := time.Now().UnixMilli()
+	primary := NewMockProvider()
+	secondary := NewMockProvider()
+	primary.SetQuote("LIND", &Quote{Price: 0.5, Timestamp: now})
+	primary.SetError(errors.New("unavailable"))
+	secondary.SetQuote("LIND", &Quote{Price: 0.6, Timestamp: now})
+
+	store := &memoryStore{}
+	r := newTestRefresher(store, []string{"LIND"}, primary, secondary)
+	if err := r.Refresh(context.Background()); err != nil {
+		t.Fatalf("Refresh: %v", err)
+	}
+
+	if got := store.prices("LIND_USD"); len(got) != 1 || got[0] != "0.6" {
+		t.Errorf("LIND prices = %v, want [0.6]", got)
+	}
+}
+
+func TestRefreshSkipsStaleQuotes(t *testing.T) {
+	now := time.Now()
+	primary := NewMockProvider()
+	secondary := NewMockProvider()
+	primary.SetQuote("LIND", &Quote{Price: 0.5, Timestamp: now.Add(-time.Hour).UnixMilli()})
+	secondary.SetQuote("LIND", &Quote{Price: 0.6, Timestamp: now.UnixMilli()})
+
+	store := &memoryStore{}
+	r := newTestRefresher(store, []string{"LIND"}, primary, secondary)
+	if err := r.Refresh(context.Background()); err != nil {
+		t.Fatalf("Refresh: %v", err)
+	}
+	if got := store.prices("LIND_USD"); len(got) != 1 || got[0] != "0.6" {
+		t.Errorf("LIND prices = %v, want [0.6]", got)
+	}
+
+	// Without a fresh quote nothing is stored
+	secondary.SetQuote("LIND", &Quote{Price: 0.7, Timestamp: now.Add(-time.Hour).UnixMilli()})
+	if err := r.Refresh(context.Background()); err != nil {
+		t.Fatalf("Refresh: %v", err)
+	}
+	if got := store.prices("LIND_USD"); len(got) != 1 {
+		t.Errorf("LIND prices = %v, want one price", got)
+	}
+}
+
+func TestRefreshStoresQuoteOnce(t *testing.T) {
+	provider := NewMockProvider()
+	provider.SetQuote("LIND", &Quote{Price: 0.5, Timestamp: time.Now().UnixMilli()})
+
+	store := &memoryStore{}
+	r := newTestRefresher(store, []string{"LIND"}, provider)
+	for i := 0; i < 3; i++ {
+		if err := r.Refresh(context.Background()); err != nil {
+			t.Fatalf("Refresh: %v", err)
+		}
+	}
+	if got := store.prices("LIND_USD"); len(got) != 1 {
+		t.Errorf("LIND prices = %v, want one price", got)
+	}
+}
+
+func TestIsStale(t *testing.T) {
+	r := newTestRefresher(&memoryStore{}, nil)
+	now := time.UnixMilli(time.Now().UnixMilli())
+
+	tests := []struct {
+		age  time.Duration
+		want bool
+	}{
+		{0, false},
+		{10 * time.Minute, false},
+		{10*time.Minute + time.Millisecond, true},
+		{time.Hour, true},
+	}
+	for _, tt := range tests {
+		if got := r.isStale(now.Add(-tt.age).UnixMilli(), now); got != tt.want {
+			t.Errorf("isStale(age %v) = %v, want %v", tt.age, got, tt.want)
+		}
+	}
+}
+
+// TestHistoricalLookup stores quotes taken at different times and reads them
+// back through TokenRepository. It needs a Postgres database, set
+// PRICE_TEST_DATABASE_DSN to run it.
+func TestHistoricalLookup(t *testing.T) {
+	dsn := os.Getenv("PRICE_TEST_DATABASE_DSN")
+	if dsn == "" {
+		t.Skip("PRICE_TEST_DATABASE_DSN is not set")
+	}
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open database: %v", err)
+	}
+	if err := db.AutoMigrate(&models.MarketData{}); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+
+	const symbol = "PRICETEST"
+	pair := models.MarketDataPair(symbol)
+	t.Cleanup(func() {
+		db.Where("pair = ?", pair).Delete(&models.MarketData{})
+	})
+
+	tokenRepo := repository.NewTokenRepository(db)
+	provider := NewMockProvider()
+	r := newTestRefresher(tokenRepo, []string{symbol}, provider)
+
+	now := time.Now()
+	times := []int64{
+		now.Add(-6 * time.Minute).UnixMilli(),
+		now.Add(-4 * time.Minute).UnixMilli(),
+		now.Add(-2 * time.Minute).UnixMilli(),
+	}
+	for i, timestamp := range times {
+		provider.SetQuote(symbol, &Quote{Price: float64(i + 1), Timestamp: timestamp})
+		if err := r.Refresh(context.Background()); err != nil {
+			t.Fatalf("Refresh: %v", err)
+		}
+	}
+
+	tests := []struct {
+		at   int64
+		want float64
+	}{
+		{times[0], 1},
+		{times[1] - 1, 1},
+		{times[1], 2},
+		{times[2] + 1, 3},
+		{0, 3},
+	}
+	for _, tt := range tests {
+		price, err := tokenRepo.GetTokenPrice(symbol, tt.at)
+		if err != nil {
+			t.Fatalf("GetTokenPrice(%d): %v", tt.at, err)
+		}
+		if price.Price != tt.want {
+			t.Errorf("GetTokenPrice(%d) = %v, want %v", tt.at, price.Price, tt.want)
+		}
+	}
+
+	if _, err := tokenRepo.GetTokenPrice(symbol, times[0]-1); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("GetTokenPrice before the first quote: err = %v, want record not found", err)
+	}
+
+	var stored int64
+	db.Model(&models.MarketData{}).Where("pair = ?", pair).Count(&stored)
+	if stored != int64(len(times)) {
+		t.Errorf("stored %d quotes, want %d", stored, len(times))
+	}
+}
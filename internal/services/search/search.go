@@ -0,0 +1,394 @@
+package search
+
+import (
+	"context"
+	"encoding/hex"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lindaprotocol/grpc-api-gateway/internal/config"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/blockchain"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/storage/repository"
+	"github.com/lindaprotocol/grpc-api-gateway/pkg/lindapb"
+	"github.com/lindaprotocol/grpc-api-gateway/pkg/utils"
+	"github.com/sirupsen/logrus"
+)
+
+// exactScore ranks blocks, transactions and addresses identified by the query above name matches
+const exactScore = 10
+
+// lookup finds the results of one type for a query
+type lookup struct {
+	searchType string
+	find       func(ctx context.Context) ([]models.SearchResult, error)
+}
+
+// Service searches blocks, transactions, addresses, tokens, contracts, tags and witnesses.
+// The lookups a query may match run in parallel and those still running when the timeout
+// expires are left out, so a slow node or database only costs its own results.
+type Service struct {
+	config     config.SearchConfig
+	client     *blockchain.Client
+	searchRepo *repository.SearchRepository
+	blockRepo  *repository.BlockRepository
+	txRepo     *repository.TransactionRepository
+	logger     *logrus.Logger
+
+	mu        sync.Mutex
+	witnesses []*lindapb.Witness
+	loadedAt  time.Time
+}
+
+func NewService(
+	cfg config.SearchConfig,
+	client *blockchain.Client,
+	searchRepo *repository.SearchRepository,
+	blockRepo *repository.BlockRepository,
+	txRepo *repository.TransactionRepository,
+) *Service {
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = 2 * time.Second
+	}
+	if cfg.MinSimilarity <= 0 {
+		cfg.MinSimilarity = 0.3
+	}
+	if cfg.WitnessRefresh <= 0 {
+		cfg.WitnessRefresh = 10 * time.Minute
+	}
+	return &Service{
+		config:     cfg,
+		client:     client,
+		searchRepo: searchRepo,
+		blockRepo:  blockRepo,
+		txRepo:     txRepo,
+		logger:     logrus.New(),
+	}
+}
+
+// Search returns the best results for a query, optionally of one type, ranked by score
+func (s *Service) Search(ctx context.Context, query, searchType string, limit int) []models.SearchResult {
+	query = strings.TrimSpace(query)
+	var lookups []lookup
+
+	if num, err := strconv.ParseInt(query, 10, 64); err == nil && num >= 0 {
+		lookups = append(lookups, lookup{models.SearchTypeBlock, func(ctx context.Context) ([]models.SearchResult, error) {
+			return s.blockByNumber(ctx, num)
+		}})
+	}
+	if hash, err := hex.DecodeString(strings.TrimPrefix(query, "0x")); err == nil && len(hash) == 32 {
+		lookups = append(lookups,
+			lookup{models.SearchTypeBlock, func(ctx context.Context) ([]models.SearchResult, error) {
+				return s.blockByHash(ctx, hash)
+			}},
+			lookup{models.SearchTypeTransaction, func(ctx context.Context) ([]models.SearchResult, error) {
+				return s.transaction(ctx, hash)
+			}},
+		)
+	}
+	if utils.IsValidBase58Address(query) || utils.IsValidHexAddress(query) {
+		lookups = append(lookups, lookup{models.SearchTypeAddress, func(ctx context.Context) ([]models.SearchResult, error) {
+			return s.address(ctx, query)
+		}})
+	} else if query != "" {
+		lookups = append(lookups, s.nameLookups(query, s.config.MinSimilarity, limit)...)
+	}
+
+	if searchType != "" {
+		filtered := lookups[:0]
+		for _, l := range lookups {
+			if l.searchType == searchType {
+				filtered = append(filtered, l)
+			}
+		}
+		lookups = filtered
+	}
+	return s.run(ctx, lookups, limit)
+}
+
+// Autocomplete suggests the blocks and the names starting with a partially typed query
+func (s *Service) Autocomplete(ctx context.Context, query string, limit int) []models.SearchResult {
+	query = strings.TrimSpace(query)
+	var lookups []lookup
+
+	if num, err := strconv.ParseInt(query, 10, 64); err == nil && num >= 0 {
+		lookups = append(lookups, lookup{models.SearchTypeBlock, func(ctx context.Context) ([]models.SearchResult, error) {
+			return s.blockByNumber(ctx, num)
+		}})
+	}
+	if query != "" {
+		lookups = append(lookups, s.nameLookups(query, 0, limit)...)
+	}
+	return s.run(ctx, lookups, limit)
+}
+
+// nameLookups returns the name searches, a minSimilarity of 0 only matches prefixes
+func (s *Service) nameLookups(query string, minSimilarity float64, limit int) []lookup {
+	searches := []struct {
+		searchType string
+		search     func(context.Context, string, float64, int) ([]*models.SearchMatch, error)
+	}{
+		{models.SearchTypeToken, s.searchRepo.SearchTokens},
+		{models.SearchTypeContract, s.searchRepo.SearchContracts},
+		{models.SearchTypeTag, s.searchRepo.SearchTags},
+		{models.SearchTypeAddress, s.searchRepo.SearchAccounts},
+	}
+
+	lookups := make([]lookup, 0, len(searches)+1)
+	for _, search := range searches {
+		search := search
+		lookups = append(lookups, lookup{search.searchType, func(ctx context.Context) ([]models.SearchResult, error) {
+			matches, err := search.search(ctx, query, minSimilarity, limit)
+			return rankMatches(matches), err
+		}})
+	}
+	return append(lookups, lookup{models.SearchTypeWitness, func(ctx context.Context) ([]models.SearchResult, error) {
+		matches, err := s.searchWitnesses(ctx, query, minSimilarity == 0)
+		return rankMatches(matches), err
+	}})
+}
+
+// run executes the lookups in parallel and merges the results they return before the timeout
+func (s *Service) run(ctx context.Context, lookups []lookup, limit int) []models.SearchResult {
+	ctx, cancel := context.WithTimeout(ctx, s.config.Timeout)
+	defer cancel()
+
+	type outcome struct {
+		searchType string
+		results    []models.SearchResult
+		err        error
+	}
+	outcomes := make(chan outcome, len(lookups))
+	for _, l := range lookups {
+		go func(l lookup) {
+			results, err := l.find(ctx)
+			outcomes <- outcome{searchType: l.searchType, results: results, err: err}
+		}(l)
+	}
+
+	results := make([]models.SearchResult, 0)
+	seen := make(map[string]bool)
+collect:
+	for pending := len(lookups); pending > 0; pending-- {
+		select {
+		case o := <-outcomes:
+			if o.err != nil {
+				s.logger.WithError(o.err).WithField("type", o.searchType).Debug("Search lookup failed")
+			}
+			for _, result := range o.results {
+				key := result.Type + "/" + result.ID
+				if !seen[key] {
+					seen[key] = true
+					results = append(results, result)
+				}
+			}
+		case <-ctx.Done():
+			s.logger.WithField("pending", pending).Debug("Search lookups timed out")
+			break collect
+		}
+	}
+
+	sort.SliceStable(results, func(a, b int) bool {
+		return results[a].Score > results[b].Score
+	})
+	if len(results) > limit {
+		results = results[:limit]
+	}
+	return results
+}
+
+// blockByNumber finds an indexed block, or asks the node for blocks not indexed yet
+func (s *Service) blockByNumber(ctx context.Context, num int64) ([]models.SearchResult, error) {
+	if block, err := s.blockRepo.GetByNumber(num); err == nil {
+		return []models.SearchResult{blockResult(block.Number, block.Hash)}, nil
+	}
+	block, err := s.client.GetBlockByNum(ctx, &lindapb.NumberMessage{Num: num})
+	if err != nil || block.BlockHeader == nil {
+		return nil, err
+	}
+	return []models.SearchResult{blockResult(num, hex.EncodeToString(block.BlockID))}, nil
+}
+
+// blockByHash finds an indexed block by hash, or asks the node for blocks not indexed yet
+func (s *Service) blockByHash(ctx context.Context, hash []byte) ([]models.SearchResult, error) {
+	if block, err := s.blockRepo.GetByHash(hex.EncodeToString(hash)); err == nil {
+		return []models.SearchResult{blockResult(block.Number, block.Hash)}, nil
+	}
+	block, err := s.client.GetBlockById(ctx, &lindapb.BytesMessage{Value: hash})
+	if err != nil || block.BlockHeader == nil {
+		return nil, err
+	}
+	return []models.SearchResult{blockResult(block.BlockHeader.RawData.Number, hex.EncodeToString(hash))}, nil
+}
+
+// transaction finds an indexed transaction, or asks the node for transactions not indexed yet
+func (s *Service) transaction(ctx context.Context, hash []byte) ([]models.SearchResult, error) {
+	txID := hex.EncodeToString(hash)
+	if _, err := s.txRepo.GetByHash(string(hash)); err != nil {
+		tx, err := s.client.GetTransactionById(ctx, &lindapb.BytesMessage{Value: hash})
+		if err != nil || tx.RawData == nil {
+			return nil, err
+		}
+	}
+	return []models.SearchResult{{
+		Type:        models.SearchTypeTransaction,
+		ID:          txID,
+		Name:        "Transaction " + txID[:8] + "...",
+		URL:         "/#/transaction/" + txID,
+		Description: "Transaction with hash " + txID[:16] + "...",
+		Score:       exactScore,
+	}}, nil
+}
+
+// address looks up an account or contract address on the node
+func (s *Service) address(ctx context.Context, query string) ([]models.SearchResult, error) {
+	hexAddr, err := utils.NormalizeAddress(query)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := hex.DecodeString(hexAddr)
+	if err != nil {
+		return nil, err
+	}
+	address := utils.MustHexToBase58(hexAddr)
+
+	account, err := s.client.GetAccount(ctx, &lindapb.Account{Address: raw})
+	if err != nil {
+		return nil, err
+	}
+	accountType := models.SearchTypeAddress
+	if account.Type == lindapb.AccountType_Contract {
+		accountType = models.SearchTypeContract
+	}
+	return []models.SearchResult{{
+		Type:        accountType,
+		ID:          address,
+		Name:        utils.TruncateString(address, 12),
+		URL:         "/#/" + accountType + "/" + address,
+		Description: accountType + " with address " + address[:8] + "...",
+		Score:       exactScore,
+	}}, nil
+}
+
+// searchWitnesses matches the URL or address of the witnesses, which are few enough to be kept
+// in memory and refreshed from the node
+func (s *Service) searchWitnesses(ctx context.Context, query string, prefixOnly bool) ([]*models.SearchMatch, error) {
+	witnesses, err := s.loadWitnesses(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	lower := strings.ToLower(query)
+	matches := make([]*models.SearchMatch, 0)
+	for _, witness := range witnesses {
+		address := utils.MustHexToBase58(hex.EncodeToString(witness.Address))
+		url := strings.ToLower(witness.Url)
+		host := strings.TrimPrefix(strings.TrimPrefix(strings.TrimPrefix(url, "https://"), "http://"), "www.")
+
+		match := &models.SearchMatch{
+			Type:       models.SearchTypeWitness,
+			ID:         address,
+			Name:       witness.Url,
+			Popularity: witness.VoteCount,
+			Prefix:     strings.HasPrefix(address, query),
+		}
+		switch {
+		case host != "" && strings.HasPrefix(host, lower):
+			match.Prefix = true
+			match.Similarity = float64(len(lower)) / float64(len(host))
+		case host != "" && !prefixOnly && strings.Contains(host, lower):
+			match.Similarity = float64(len(lower)) / float64(len(host))
+		case !match.Prefix:
+			continue
+		}
+		matches = append(matches, match)
+	}
+	return matches, nil
+}
+
+// loadWitnesses returns the witnesses, listing them again once they are older than the refresh interval
+func (s *Service) loadWitnesses(ctx context.Context) ([]*lindapb.Witness, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.witnesses == nil || time.Since(s.loadedAt) > s.config.WitnessRefresh {
+		list, err := s.client.ListWitnesses(ctx, &lindapb.EmptyMessage{})
+		if err != nil {
+			return s.witnesses, err
+		}
+		s.witnesses = list.Witnesses
+		s.loadedAt = time.Now()
+	}
+	return s.witnesses, nil
+}
+
+// rankMatches converts name matches to results scored by prefix, similarity, verification and popularity
+func rankMatches(matches []*models.SearchMatch) []models.SearchResult {
+	results := make([]models.SearchResult, 0, len(matches))
+	for _, match := range matches {
+		results = append(results, matchResult(match))
+	}
+	return results
+}
+
+// score ranks a name match. Prefix matches come first, verified contracts and tokens next, and
+// popularity orders matches of the same quality without outweighing a closer name.
+func score(match *models.SearchMatch) float64 {
+	score := match.Similarity
+	if match.Prefix {
+		score++
+	}
+	if match.Verified {
+		score += 0.5
+	}
+	return score + math.Log10(1+float64(match.Popularity))/10
+}
+
+// matchResult builds the search result of a name match
+func matchResult(match *models.SearchMatch) models.SearchResult {
+	result := models.SearchResult{
+		Type:  match.Type,
+		ID:    match.ID,
+		Name:  match.Name,
+		Score: score(match),
+	}
+	switch match.Type {
+	case models.SearchTypeToken:
+		result.Name = match.Symbol + " (" + match.Name + ")"
+		result.URL = "/#/token/" + match.ID
+		result.Description = match.Standard + " token " + match.Name + " with " + strconv.FormatInt(match.Popularity, 10) + " holders"
+	case models.SearchTypeContract:
+		result.URL = "/#/contract/" + match.ID
+		result.Description = "Contract " + match.Name + " at " + match.ID
+	case models.SearchTypeTag:
+		result.URL = "/#/address/" + match.ID
+		result.Description = "Address tagged " + match.Name
+	case models.SearchTypeWitness:
+		result.URL = "/#/address/" + match.ID
+		result.Description = "Witness with " + strconv.FormatInt(match.Popularity, 10) + " votes"
+	default:
+		result.URL = "/#/address/" + match.ID
+		result.Description = "Account " + match.Name
+	}
+	if match.Verified {
+		result.Description += ", verified"
+	}
+	return result
+}
+
+// blockResult builds the search result of a block
+func blockResult(num int64, hash string) models.SearchResult {
+	number := strconv.FormatInt(num, 10)
+	return models.SearchResult{
+		Type:        models.SearchTypeBlock,
+		ID:          number,
+		Name:        "Block #" + number,
+		URL:         "/#/block/" + number,
+		Description: "Block at height " + number + " with hash " + utils.TruncateString(hash, 16),
+		Score:       exactScore,
+	}
+}
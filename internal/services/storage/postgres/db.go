@@ -1,117 +1,363 @@
-package postgres
-
-import (
-	"fmt"
-	"log"
-	"time"
-
-	"github.com/lindaprotocol/grpc-api-gateway/internal/config"
-	"github.com/lindaprotocol/grpc-api-gateway/internal/services/auth"
-	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
-	"gorm.io/driver/postgres"
-	"gorm.io/gorm"
-	"gorm.io/gorm/logger"
-)
-
-// NewConnection creates a new database connection
-func NewConnection(cfg config.DatabaseConfig) (*gorm.DB, error) {
-	dsn := fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=%s",
-		cfg.Host, cfg.Port, cfg.User, cfg.Password, cfg.DBName, cfg.SSLMode)
-
-	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
-		Logger: logger.Default.LogMode(logger.Info),
-	})
-	if err != nil {
-		return nil, err
-	}
-
-	sqlDB, err := db.DB()
-	if err != nil {
-		return nil, err
-	}
-
-	// Set connection pool settings
-	sqlDB.SetMaxOpenConns(cfg.MaxConnections)
-	sqlDB.SetMaxIdleConns(cfg.IdleConnections)
-	sqlDB.SetConnMaxLifetime(time.Duration(cfg.ConnMaxLifetime) * time.Second)
-
-	// Auto migrate schemas
-	if err := autoMigrate(db); err != nil {
-		return nil, err
-	}
-
-	return db, nil
-}
-
-// autoMigrate runs database migrations
-func autoMigrate(db *gorm.DB) error {
-	log.Println("Running database migrations...")
-
-	// Account related tables
-	if err := db.AutoMigrate(
-		&models.Account{},
-		&models.AccountResource{},
-		&models.Frozen{},
-		&models.FreezeV2{},
-		&models.UnFreezeV2{},
-		&models.Permission{},
-		&models.Vote{},
-	); err != nil {
-		return err
-	}
-
-	// Block and transaction tables
-	if err := db.AutoMigrate(
-		&models.Block{},
-		&models.Transaction{},
-		&models.InternalTransaction{},
-	); err != nil {
-		return err
-	}
-
-	// Token related tables
-	if err := db.AutoMigrate(
-		&models.TokenInfo{},           // LRC-10 token database model
-		&models.LRC20TokenInfo{},       // LRC20 token database model
-		&models.TokenHolder{},          // Token holder database model
-		&models.TokenTransferDB{},       // Token transfer database model AssetIssueDB
-		&models.AssetIssueDB{},         // Asset issue database model
-		// &models.TokenTransferResponse{}, // NULL THIS - it's an API response type
-		// &models.AssetIssueResponse{},   // NULL THIS - it's an API response type
-	); err != nil {
-		return err
-	}
-
-	// Event tables
-	if err := db.AutoMigrate(
-		&models.Event{},
-	); err != nil {
-		return err
-	}
-
-	// Stats tables
-	if err := db.AutoMigrate(
-		&models.Statistic{},
-	); err != nil {
-		return err
-	}
-
-	// Tag tables
-	if err := db.AutoMigrate(
-		&models.TagResponse{},
-	); err != nil {
-		return err
-	}
-
-	// Auth tables
-	if err := db.AutoMigrate(
-		&auth.APIKey{},
-		&auth.JWTKey{},
-		&auth.Allowlist{},
-	); err != nil {
-		return err
-	}
-
-	log.Println("Database migrations completed successfully")
-	return nil
+package postgres
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/lindaprotocol/grpc-api-gateway/internal/config"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/auth"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// NewConnection creates a new database connection
+func NewConnection(cfg config.DatabaseConfig) (*gorm.DB, error) {
+	dsn := fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=%s",
+		cfg.Host, cfg.Port, cfg.User, cfg.Password, cfg.DBName, cfg.SSLMode)
+
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Info),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, err
+	}
+
+	// Set connection pool settings
+	sqlDB.SetMaxOpenConns(cfg.MaxConnections)
+	sqlDB.SetMaxIdleConns(cfg.IdleConnections)
+	sqlDB.SetConnMaxLifetime(time.Duration(cfg.ConnMaxLifetime) * time.Second)
+
+	// Auto migrate schemas
+	if err := autoMigrate(db); err != nil {
+		return nil, err
+	}
+
+	return db, nil
+}
+
+// dedupeTags removes the repeated tags an owner put on an address before
+// idx_tag_owner made them unique, keeping the first one
+func dedupeTags(db *gorm.DB) error {
+	migrator := db.Migrator()
+	if !migrator.HasTable(&models.Tag{}) || migrator.HasIndex(&models.Tag{}, "idx_tag_owner") {
+		return nil
+	}
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec(`
+			DELETE FROM tags a USING tags b
+			WHERE a.address = b.address AND a.tag = b.tag AND a.owner = b.owner AND a.id > b.id
+		`).Error; err != nil {
+			return fmt.Errorf("failed to remove duplicate tags: %w", err)
+		}
+		if !tx.Migrator().HasTable(&models.TagVote{}) {
+			return nil
+		}
+		return tx.Exec("DELETE FROM tag_votes WHERE tag_id NOT IN (SELECT id FROM tags)").Error
+	})
+}
+
+// mergeTokenHolders converts token holder balances to numbers and merges the
+// rows concurrent updates created for one holder before idx_token_holder made
+// them unique, adding their balances into the first one
+func mergeTokenHolders(db *gorm.DB) error {
+	migrator := db.Migrator()
+	if !migrator.HasTable(&models.TokenHolder{}) || migrator.HasIndex(&models.TokenHolder{}, "idx_token_holder") {
+		return nil
+	}
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec(`
+			ALTER TABLE token_holders ALTER COLUMN balance TYPE NUMERIC(78,0)
+			USING (CASE WHEN balance::TEXT ~ '^-?[0-9]+$' THEN balance::TEXT::NUMERIC ELSE 0 END)
+		`).Error; err != nil {
+			return fmt.Errorf("failed to convert token holder balances: %w", err)
+		}
+		if err := tx.Exec(`
+			UPDATE token_holders h SET balance = d.balance
+			FROM (
+				SELECT MIN(id) AS id, SUM(balance) AS balance
+				FROM token_holders
+				GROUP BY contract_address, address
+				HAVING COUNT(*) > 1
+			) d
+			WHERE h.id = d.id
+		`).Error; err != nil {
+			return fmt.Errorf("failed to merge duplicate token holders: %w", err)
+		}
+		return tx.Exec(`
+			DELETE FROM token_holders a USING token_holders b
+			WHERE a.contract_address = b.contract_address AND a.address = b.address AND a.id > b.id
+		`).Error
+	})
+}
+
+// uniqueKey is a unique index a table gained to make indexing a transaction
+// again a no-op, legacy the plain index it replaces
+type uniqueKey struct {
+	model   interface{}
+	table   string
+	index   string
+	legacy  string
+	columns []string
+}
+
+// uniqueKeys are the keys of the rows the indexer records once per transaction, log or slot
+var uniqueKeys = []uniqueKey{
+	{&models.NFTTransfer{}, "nft_transfers", "idx_nft_transfer_log", "", []string{"transaction_id", "log_index", "token_id"}},
+	{&models.StakeAction{}, "stake_actions", "idx_stake_actions_tx", "idx_stake_actions_transaction_id", []string{"transaction_id"}},
+	{&models.DelegationAction{}, "delegation_actions", "idx_delegation_actions_tx", "idx_delegation_actions_transaction_id", []string{"transaction_id"}},
+	{&models.WitnessMissedSlot{}, "witness_missed_slots", "idx_witness_slot", "idx_witness_missed_slot", []string{"witness", "slot_timestamp"}},
+	{&models.WitnessBrokerageChange{}, "witness_brokerage_changes", "idx_witness_brokerage_changes_tx", "idx_witness_brokerage_changes_transaction_id", []string{"transaction_id"}},
+}
+
+// dedupeUniqueKeys removes the rows recorded twice by reindexing before the
+// unique keys existed, keeping the first one, and drops the plain indexes the
+// keys replace
+func dedupeUniqueKeys(db *gorm.DB) error {
+	migrator := db.Migrator()
+	for _, key := range uniqueKeys {
+		if !migrator.HasTable(key.model) || migrator.HasIndex(key.model, key.index) {
+			continue
+		}
+		conditions := make([]string, 0, len(key.columns)+1)
+		for _, column := range key.columns {
+			conditions = append(conditions, fmt.Sprintf("a.%s = b.%s", column, column))
+		}
+		conditions = append(conditions, "a.id > b.id")
+		err := db.Transaction(func(tx *gorm.DB) error {
+			if err := tx.Exec(fmt.Sprintf("DELETE FROM %s a USING %s b WHERE %s",
+				key.table, key.table, strings.Join(conditions, " AND "))).Error; err != nil {
+				return fmt.Errorf("failed to remove duplicate %s: %w", key.table, err)
+			}
+			if key.legacy == "" || !tx.Migrator().HasIndex(key.model, key.legacy) {
+				return nil
+			}
+			return tx.Migrator().DropIndex(key.model, key.legacy)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// searchIndexes are the trigram indexes of the columns searched by name
+var searchIndexes = []string{
+	"CREATE INDEX IF NOT EXISTS idx_lrc20_tokens_name_trgm ON lrc20_token_infos USING gin (name gin_trgm_ops)",
+	"CREATE INDEX IF NOT EXISTS idx_lrc20_tokens_symbol_trgm ON lrc20_token_infos USING gin (symbol gin_trgm_ops)",
+	"CREATE INDEX IF NOT EXISTS idx_token_infos_name_trgm ON token_infos USING gin (name gin_trgm_ops)",
+	"CREATE INDEX IF NOT EXISTS idx_token_infos_symbol_trgm ON token_infos USING gin (symbol gin_trgm_ops)",
+	"CREATE INDEX IF NOT EXISTS idx_contracts_name_trgm ON contracts USING gin (name gin_trgm_ops)",
+	"CREATE INDEX IF NOT EXISTS idx_tags_tag_trgm ON tags USING gin (tag gin_trgm_ops)",
+	"CREATE INDEX IF NOT EXISTS idx_accounts_account_name_trgm ON accounts USING gin (account_name gin_trgm_ops)",
+}
+
+// autoMigrate runs database migrations
+func autoMigrate(db *gorm.DB) error {
+	log.Println("Running database migrations...")
+
+	// Account related tables
+	if err := db.AutoMigrate(
+		&models.Account{},
+		&models.AccountResource{},
+		&models.Frozen{},
+		&models.FreezeV2{},
+		&models.UnFreezeV2{},
+		&models.Permission{},
+		&models.Vote{},
+	); err != nil {
+		return err
+	}
+
+	// Block and transaction tables
+	if err := db.AutoMigrate(
+		&models.Block{},
+		&models.Transaction{},
+		&models.InternalTransaction{},
+	); err != nil {
+		return err
+	}
+
+	// Token related tables
+	if err := mergeTokenHolders(db); err != nil {
+		return err
+	}
+	if err := db.AutoMigrate(
+		&models.TokenInfo{},           // LRC-10 token database model
+		&models.LRC20TokenInfo{},       // LRC20 token database model
+		&models.TokenHolder{},          // Token holder database model
+		&models.TokenTransferDB{},       // Token transfer database model AssetIssueDB
+		&models.AssetIssueDB{},         // Asset issue database model
+		&models.TokenAllowance{},       // LRC20 allowance database model
+		&models.TokenAllowanceChange{}, // LRC20 allowance history database model
+		// &models.TokenTransferResponse{}, // NULL THIS - it's an API response type
+		// &models.AssetIssueResponse{},   // NULL THIS - it's an API response type
+	); err != nil {
+		return err
+	}
+
+	// Event tables
+	if err := db.AutoMigrate(
+		&models.Event{},
+	); err != nil {
+		return err
+	}
+
+	// Stats tables
+	if err := db.AutoMigrate(
+		&models.Statistic{},
+	); err != nil {
+		return err
+	}
+
+	// Tag tables
+	if err := dedupeTags(db); err != nil {
+		return err
+	}
+	if err := db.AutoMigrate(
+		&models.Tag{},
+		&models.TagVote{},
+	); err != nil {
+		return err
+	}
+
+	// Auth tables
+	if err := db.AutoMigrate(
+		&auth.APIKey{},
+		&auth.JWTKey{},
+		&auth.Allowlist{},
+	); err != nil {
+		return err
+	}
+
+	// Webhook tables
+	if err := db.AutoMigrate(
+		&models.WebhookSubscription{},
+		&models.WebhookDelivery{},
+	); err != nil {
+		return err
+	}
+
+	// Alert tables
+	if err := db.AutoMigrate(
+		&models.Watchlist{},
+		&models.WatchlistAddress{},
+		&models.AlertRule{},
+		&models.Alert{},
+	); err != nil {
+		return err
+	}
+
+	// Rows recorded once per transaction, log or slot
+	if err := dedupeUniqueKeys(db); err != nil {
+		return err
+	}
+
+	// NFT tables
+	if err := db.AutoMigrate(
+		&models.NFTCollection{},
+		&models.NFTToken{},
+		&models.NFTBalance{},
+		&models.NFTTransfer{},
+	); err != nil {
+		return err
+	}
+
+	// Balance history tables
+	if err := db.AutoMigrate(
+		&models.BalanceDelta{},
+	); err != nil {
+		return err
+	}
+
+	// Staking history tables
+	if err := db.AutoMigrate(
+		&models.StakeAction{},
+		&models.DelegationAction{},
+		&models.VoteAction{},
+		&models.RewardWithdrawal{},
+	); err != nil {
+		return err
+	}
+
+	// Witness analytics tables
+	if err := db.AutoMigrate(
+		&models.WitnessMissedSlot{},
+		&models.WitnessVoteSnapshot{},
+		&models.WitnessBrokerageChange{},
+	); err != nil {
+		return err
+	}
+
+	// Governance tables
+	if err := db.AutoMigrate(
+		&models.GovernanceProposal{},
+		&models.ProposalApproval{},
+		&models.ChainParameterChange{},
+	); err != nil {
+		return err
+	}
+
+	// Exchange and market history tables
+	if err := db.AutoMigrate(
+		&models.ExchangeTrade{},
+		&models.ExchangeLiquidityEvent{},
+		&models.MarketOrder{},
+		&models.MarketTrade{},
+	); err != nil {
+		return err
+	}
+
+	// Price feed tables
+	if err := db.AutoMigrate(
+		&models.MarketData{},
+	); err != nil {
+		return err
+	}
+
+	// Node map and telemetry tables
+	if err := db.AutoMigrate(
+		&models.NodeReport{},
+		&models.NodeTelemetry{},
+		&models.GeoIPRecord{},
+	); err != nil {
+		return err
+	}
+
+	// Contract analytics tables
+	if err := db.AutoMigrate(
+		&models.ContractTrigger{},
+		&models.ContractDailyStat{},
+		&models.ContractDailyCaller{},
+	); err != nil {
+		return err
+	}
+
+	// Search tables
+	if err := db.AutoMigrate(
+		&models.Contract{},
+	); err != nil {
+		return err
+	}
+
+	// Name searches match with pg_trgm and rely on the trigram indexes
+	if err := db.Exec("CREATE EXTENSION IF NOT EXISTS pg_trgm").Error; err != nil {
+		return fmt.Errorf("failed to create the pg_trgm extension required by search: %w", err)
+	}
+	for _, index := range searchIndexes {
+		if err := db.Exec(index).Error; err != nil {
+			return fmt.Errorf("failed to create search index: %w", err)
+		}
+	}
+
+	log.Println("Database migrations completed successfully")
+	return nil
 }
\ No newline at end of file
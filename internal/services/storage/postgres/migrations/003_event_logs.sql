@@ -0,0 +1,20 @@
+-- Raw log columns used to answer eth_getLogs from the event index
+
+ALTER TABLE events ADD COLUMN IF NOT EXISTS block_hash VARCHAR(64);
+ALTER TABLE events ADD COLUMN IF NOT EXISTS transaction_index INTEGER DEFAULT 0;
+ALTER TABLE events ADD COLUMN IF NOT EXISTS log_index INTEGER DEFAULT 0;
+ALTER TABLE events ADD COLUMN IF NOT EXISTS topic0 VARCHAR(64);
+ALTER TABLE events ADD COLUMN IF NOT EXISTS topic1 VARCHAR(64);
+ALTER TABLE events ADD COLUMN IF NOT EXISTS topic2 VARCHAR(64);
+ALTER TABLE events ADD COLUMN IF NOT EXISTS topic3 VARCHAR(64);
+ALTER TABLE events ADD COLUMN IF NOT EXISTS topics JSONB;
+ALTER TABLE events ADD COLUMN IF NOT EXISTS data TEXT;
+
+-- Log filter indexes
+CREATE INDEX IF NOT EXISTS idx_events_block_log ON events(block_number, log_index);
+CREATE INDEX IF NOT EXISTS idx_events_block_hash ON events(block_hash);
+CREATE INDEX IF NOT EXISTS idx_events_contract_block ON events(contract_address, block_number);
+CREATE INDEX IF NOT EXISTS idx_events_topic0_block ON events(topic0, block_number);
+CREATE INDEX IF NOT EXISTS idx_events_topic1 ON events(topic1);
+CREATE INDEX IF NOT EXISTS idx_events_topic2 ON events(topic2);
+CREATE INDEX IF NOT EXISTS idx_events_topic3 ON events(topic3);
@@ -0,0 +1,40 @@
+-- Outbound webhook subscriptions and their delivery log
+
+CREATE TABLE IF NOT EXISTS webhook_subscriptions (
+    id UUID PRIMARY KEY,
+    api_key_id VARCHAR(255) NOT NULL,
+    user_id VARCHAR(255),
+    callback_url VARCHAR(512) NOT NULL,
+    secret VARCHAR(128) NOT NULL,
+    filter_type VARCHAR(20) NOT NULL,
+    address VARCHAR(42),
+    contract_address VARCHAR(42),
+    event_name VARCHAR(100),
+    wait_for_solidified BOOLEAN DEFAULT FALSE,
+    active BOOLEAN DEFAULT TRUE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id UUID PRIMARY KEY,
+    subscription_id UUID NOT NULL,
+    event_type VARCHAR(20),
+    block_number BIGINT,
+    transaction_id VARCHAR(64),
+    payload JSONB,
+    wait_for_solidified BOOLEAN DEFAULT FALSE,
+    status VARCHAR(20),
+    attempts INTEGER DEFAULT 0,
+    next_attempt_at TIMESTAMP,
+    last_error TEXT,
+    response_code INTEGER,
+    delivered_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_subscriptions_api_key ON webhook_subscriptions(api_key_id);
+CREATE INDEX IF NOT EXISTS idx_webhook_subscriptions_active ON webhook_subscriptions(active);
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_subscription ON webhook_deliveries(subscription_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_due ON webhook_deliveries(status, next_attempt_at);
@@ -0,0 +1,59 @@
+-- Address watchlists, alert rules and fired alerts
+
+CREATE TABLE IF NOT EXISTS watchlists (
+    id UUID PRIMARY KEY,
+    user_id VARCHAR(255) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS watchlist_addresses (
+    id SERIAL PRIMARY KEY,
+    watchlist_id UUID NOT NULL,
+    address VARCHAR(42) NOT NULL,
+    label VARCHAR(100),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(watchlist_id, address)
+);
+
+CREATE TABLE IF NOT EXISTS alert_rules (
+    id UUID PRIMARY KEY,
+    user_id VARCHAR(255) NOT NULL,
+    watchlist_id UUID NOT NULL,
+    type VARCHAR(30) NOT NULL,
+    asset VARCHAR(42),
+    threshold VARCHAR(100),
+    notifier VARCHAR(20) NOT NULL,
+    target VARCHAR(512),
+    secret VARCHAR(128),
+    dedup_window BIGINT DEFAULT 0,
+    active BOOLEAN DEFAULT TRUE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS alerts (
+    id UUID PRIMARY KEY,
+    rule_id UUID NOT NULL,
+    user_id VARCHAR(255) NOT NULL,
+    type VARCHAR(30),
+    address VARCHAR(42),
+    asset VARCHAR(42),
+    amount VARCHAR(100),
+    transaction_id VARCHAR(64),
+    block_number BIGINT,
+    block_timestamp BIGINT,
+    message TEXT,
+    dedup_key VARCHAR(200),
+    notified_at TIMESTAMP,
+    notify_error TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_watchlists_user ON watchlists(user_id);
+CREATE INDEX IF NOT EXISTS idx_watchlist_addresses_address ON watchlist_addresses(address);
+CREATE INDEX IF NOT EXISTS idx_alert_rules_watchlist ON alert_rules(watchlist_id);
+CREATE INDEX IF NOT EXISTS idx_alert_rules_user ON alert_rules(user_id);
+CREATE INDEX IF NOT EXISTS idx_alerts_user_created ON alerts(user_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_alerts_dedup ON alerts(rule_id, dedup_key, created_at);
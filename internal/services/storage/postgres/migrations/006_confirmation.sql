@@ -0,0 +1,10 @@
+-- Confirmation state of indexed rows. Head blocks are indexed as unconfirmed
+-- and promoted once the solidified block passes them.
+
+ALTER TABLE blocks ADD COLUMN IF NOT EXISTS unconfirmed BOOLEAN DEFAULT FALSE;
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS unconfirmed BOOLEAN DEFAULT FALSE;
+ALTER TABLE events ADD COLUMN IF NOT EXISTS unconfirmed BOOLEAN DEFAULT FALSE;
+
+CREATE INDEX IF NOT EXISTS idx_blocks_unconfirmed ON blocks(unconfirmed);
+CREATE INDEX IF NOT EXISTS idx_transactions_unconfirmed ON transactions(unconfirmed);
+CREATE INDEX IF NOT EXISTS idx_events_unconfirmed ON events(unconfirmed);
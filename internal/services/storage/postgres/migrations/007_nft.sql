@@ -0,0 +1,62 @@
+-- LRC-721 and LRC-1155 collections, token metadata, ownership and transfers
+
+CREATE TABLE IF NOT EXISTS nft_collections (
+    id SERIAL PRIMARY KEY,
+    contract VARCHAR(42) NOT NULL UNIQUE,
+    standard VARCHAR(10) NOT NULL,
+    name VARCHAR(100),
+    symbol VARCHAR(20),
+    owner VARCHAR(42),
+    tokens BIGINT DEFAULT 0,
+    transfers BIGINT DEFAULT 0,
+    block_number BIGINT DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS nft_tokens (
+    id SERIAL PRIMARY KEY,
+    contract VARCHAR(42) NOT NULL,
+    token_id VARCHAR(80) NOT NULL,
+    uri TEXT,
+    block_number BIGINT DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(contract, token_id)
+);
+
+CREATE TABLE IF NOT EXISTS nft_balances (
+    id SERIAL PRIMARY KEY,
+    contract VARCHAR(42) NOT NULL,
+    token_id VARCHAR(80) NOT NULL,
+    owner VARCHAR(42) NOT NULL,
+    balance VARCHAR(100) NOT NULL,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(contract, token_id, owner)
+);
+
+CREATE TABLE IF NOT EXISTS nft_transfers (
+    id SERIAL PRIMARY KEY,
+    transaction_id VARCHAR(64),
+    block_number BIGINT,
+    block_timestamp BIGINT,
+    log_index INTEGER,
+    contract VARCHAR(42) NOT NULL,
+    standard VARCHAR(10),
+    token_id VARCHAR(80) NOT NULL,
+    operator VARCHAR(42),
+    "from" VARCHAR(42),
+    "to" VARCHAR(42),
+    value VARCHAR(100),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_nft_collections_standard ON nft_collections(standard);
+CREATE INDEX IF NOT EXISTS idx_nft_collections_block ON nft_collections(block_number);
+CREATE INDEX IF NOT EXISTS idx_nft_tokens_block ON nft_tokens(block_number);
+CREATE INDEX IF NOT EXISTS idx_nft_balances_owner ON nft_balances(owner);
+CREATE INDEX IF NOT EXISTS idx_nft_transfer_token ON nft_transfers(contract, token_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_nft_transfer_log ON nft_transfers(transaction_id, log_index, token_id);
+CREATE INDEX IF NOT EXISTS idx_nft_transfers_block ON nft_transfers(block_number);
+CREATE INDEX IF NOT EXISTS idx_nft_transfers_from ON nft_transfers("from");
+CREATE INDEX IF NOT EXISTS idx_nft_transfers_to ON nft_transfers("to");
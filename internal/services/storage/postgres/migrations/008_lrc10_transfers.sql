@@ -0,0 +1,15 @@
+-- LRC-10 asset transfers share token_transfers and token_holders with LRC-20,
+-- keyed by the asset ID instead of a contract address
+
+ALTER TABLE token_infos ADD COLUMN IF NOT EXISTS lind_num INTEGER DEFAULT 0;
+ALTER TABLE token_infos ADD COLUMN IF NOT EXISTS num INTEGER DEFAULT 0;
+ALTER TABLE token_infos ADD COLUMN IF NOT EXISTS holders BIGINT DEFAULT 0;
+ALTER TABLE token_infos ADD COLUMN IF NOT EXISTS transfers BIGINT DEFAULT 0;
+ALTER TABLE token_infos ADD COLUMN IF NOT EXISTS block_number BIGINT DEFAULT 0;
+
+-- Transfers are keyed by their log, LRC-10 transfers have log index -1
+ALTER TABLE token_transfer_responses ADD COLUMN IF NOT EXISTS log_index INTEGER;
+
+CREATE INDEX IF NOT EXISTS idx_token_infos_block ON token_infos(block_number);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_token_transfer_log ON token_transfer_responses(transaction_id, log_index);
+CREATE INDEX IF NOT EXISTS idx_token_holders_contract_balance ON token_holders(contract_address, balance);
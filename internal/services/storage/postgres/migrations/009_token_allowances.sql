@@ -0,0 +1,32 @@
+-- Current LRC-20 allowances, maintained from Approval events and transferFrom transfers
+
+CREATE TABLE IF NOT EXISTS token_allowances (
+    id SERIAL PRIMARY KEY,
+    owner VARCHAR(42) NOT NULL,
+    spender VARCHAR(42) NOT NULL,
+    contract VARCHAR(42) NOT NULL,
+    allowance VARCHAR(100) NOT NULL,
+    transaction_id VARCHAR(64),
+    block_number BIGINT,
+    block_timestamp BIGINT,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(owner, spender, contract)
+);
+
+CREATE INDEX IF NOT EXISTS idx_token_allowances_owner ON token_allowances(owner);
+CREATE INDEX IF NOT EXISTS idx_token_allowances_block ON token_allowances(block_number);
+
+-- Every allowance value set in a block, to restore the previous value on a rollback
+CREATE TABLE IF NOT EXISTS token_allowance_changes (
+    id SERIAL PRIMARY KEY,
+    owner VARCHAR(42) NOT NULL,
+    spender VARCHAR(42) NOT NULL,
+    contract VARCHAR(42) NOT NULL,
+    allowance VARCHAR(100) NOT NULL,
+    transaction_id VARCHAR(64),
+    block_number BIGINT,
+    block_timestamp BIGINT
+);
+
+CREATE INDEX IF NOT EXISTS idx_token_allowance_change ON token_allowance_changes(owner, spender, contract);
+CREATE INDEX IF NOT EXISTS idx_token_allowance_changes_block_number ON token_allowance_changes(block_number);
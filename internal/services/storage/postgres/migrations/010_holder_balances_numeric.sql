@@ -0,0 +1,31 @@
+-- Store token holder balances as NUMERIC so they sort and add correctly, and
+-- key holders by (contract, address) so balances can be updated with upserts
+
+-- Merge duplicate holder rows created by concurrent read-modify-write updates
+CREATE TEMP TABLE token_holders_merged AS
+SELECT contract_address, address,
+       SUM(CASE WHEN balance ~ '^-?[0-9]+$' THEN balance::NUMERIC ELSE 0 END) AS balance,
+       MAX(percentage) AS percentage,
+       MAX(updated_at) AS updated_at
+FROM token_holders
+GROUP BY contract_address, address
+HAVING COUNT(*) > 1;
+
+DELETE FROM token_holders h
+USING token_holders_merged m
+WHERE h.contract_address = m.contract_address AND h.address = m.address;
+
+ALTER TABLE token_holders
+    ALTER COLUMN balance TYPE NUMERIC(78,0)
+    USING (CASE WHEN balance ~ '^-?[0-9]+$' THEN balance::NUMERIC ELSE 0 END);
+ALTER TABLE token_holders ALTER COLUMN balance SET DEFAULT 0;
+ALTER TABLE token_holders ALTER COLUMN balance SET NOT NULL;
+
+INSERT INTO token_holders (contract_address, address, balance, percentage, updated_at)
+SELECT contract_address, address, balance, percentage, updated_at FROM token_holders_merged;
+
+DROP TABLE token_holders_merged;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_token_holder ON token_holders(contract_address, address);
+DROP INDEX IF EXISTS idx_token_holders_contract_balance;
+CREATE INDEX IF NOT EXISTS idx_token_holders_contract_balance ON token_holders(contract_address, balance DESC);
@@ -0,0 +1,19 @@
+-- Per-address balance deltas of LIND, LRC-10 and LRC-20 tokens, summed to
+-- serve balance history and balances as of a block from the index
+CREATE TABLE IF NOT EXISTS balance_deltas (
+    id BIGSERIAL PRIMARY KEY,
+    address VARCHAR(42) NOT NULL,
+    asset VARCHAR(42) NOT NULL,
+    block_number BIGINT NOT NULL,
+    block_timestamp BIGINT NOT NULL,
+    transaction_id VARCHAR(64),
+    kind VARCHAR(20),
+    seq INTEGER,
+    delta NUMERIC(78,0) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_balance_delta ON balance_deltas(address, asset, block_number);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_balance_delta_source ON balance_deltas(address, asset, transaction_id, kind, seq);
+CREATE INDEX IF NOT EXISTS idx_balance_deltas_block_number ON balance_deltas(block_number);
+CREATE INDEX IF NOT EXISTS idx_balance_deltas_block_timestamp ON balance_deltas(block_timestamp);
@@ -0,0 +1,71 @@
+-- Typed history of Stake 2.0 freezes and unfreezes, resource delegations,
+-- witness votes and reward withdrawals
+CREATE TABLE IF NOT EXISTS stake_actions (
+    id BIGSERIAL PRIMARY KEY,
+    transaction_id VARCHAR(64),
+    block_number BIGINT NOT NULL,
+    block_timestamp BIGINT NOT NULL,
+    owner VARCHAR(42) NOT NULL,
+    action VARCHAR(20) NOT NULL,
+    resource VARCHAR(20),
+    amount BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_stake_actions_tx ON stake_actions(transaction_id);
+CREATE INDEX IF NOT EXISTS idx_stake_actions_block_number ON stake_actions(block_number);
+CREATE INDEX IF NOT EXISTS idx_stake_actions_block_timestamp ON stake_actions(block_timestamp);
+CREATE INDEX IF NOT EXISTS idx_stake_actions_owner ON stake_actions(owner);
+
+CREATE TABLE IF NOT EXISTS delegation_actions (
+    id BIGSERIAL PRIMARY KEY,
+    transaction_id VARCHAR(64),
+    block_number BIGINT NOT NULL,
+    block_timestamp BIGINT NOT NULL,
+    owner VARCHAR(42) NOT NULL,
+    receiver VARCHAR(42) NOT NULL,
+    action VARCHAR(20) NOT NULL,
+    resource VARCHAR(20),
+    amount BIGINT NOT NULL DEFAULT 0,
+    lock BOOLEAN NOT NULL DEFAULT FALSE,
+    expire_at BIGINT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_delegation_actions_tx ON delegation_actions(transaction_id);
+CREATE INDEX IF NOT EXISTS idx_delegation_actions_block_number ON delegation_actions(block_number);
+CREATE INDEX IF NOT EXISTS idx_delegation_actions_block_timestamp ON delegation_actions(block_timestamp);
+CREATE INDEX IF NOT EXISTS idx_delegation_actions_owner ON delegation_actions(owner);
+CREATE INDEX IF NOT EXISTS idx_delegation_actions_receiver ON delegation_actions(receiver);
+CREATE INDEX IF NOT EXISTS idx_delegation_actions_resource ON delegation_actions(resource);
+
+CREATE TABLE IF NOT EXISTS vote_actions (
+    id BIGSERIAL PRIMARY KEY,
+    transaction_id VARCHAR(64),
+    block_number BIGINT NOT NULL,
+    block_timestamp BIGINT NOT NULL,
+    voter VARCHAR(42) NOT NULL,
+    witness VARCHAR(42) NOT NULL,
+    votes BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_vote_actions_transaction_id ON vote_actions(transaction_id);
+CREATE INDEX IF NOT EXISTS idx_vote_actions_block_number ON vote_actions(block_number);
+CREATE INDEX IF NOT EXISTS idx_vote_actions_block_timestamp ON vote_actions(block_timestamp);
+CREATE INDEX IF NOT EXISTS idx_vote_actions_voter ON vote_actions(voter);
+CREATE INDEX IF NOT EXISTS idx_vote_actions_witness ON vote_actions(witness);
+
+CREATE TABLE IF NOT EXISTS reward_withdrawals (
+    id BIGSERIAL PRIMARY KEY,
+    transaction_id VARCHAR(64) UNIQUE,
+    block_number BIGINT NOT NULL,
+    block_timestamp BIGINT NOT NULL,
+    owner VARCHAR(42) NOT NULL,
+    amount BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_reward_withdrawals_block_number ON reward_withdrawals(block_number);
+CREATE INDEX IF NOT EXISTS idx_reward_withdrawals_block_timestamp ON reward_withdrawals(block_timestamp);
+CREATE INDEX IF NOT EXISTS idx_reward_withdrawals_owner ON reward_withdrawals(owner);
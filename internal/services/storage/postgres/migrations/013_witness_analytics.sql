@@ -0,0 +1,41 @@
+-- Witness production analytics: slots missed by active witnesses, votes at
+-- the start of each maintenance cycle and brokerage changes
+CREATE TABLE IF NOT EXISTS witness_missed_slots (
+    id BIGSERIAL PRIMARY KEY,
+    block_number BIGINT NOT NULL,
+    witness VARCHAR(42) NOT NULL,
+    slot_timestamp BIGINT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_witness_missed_slots_block_number ON witness_missed_slots(block_number);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_witness_slot ON witness_missed_slots(witness, slot_timestamp);
+
+CREATE TABLE IF NOT EXISTS witness_vote_snapshots (
+    id BIGSERIAL PRIMARY KEY,
+    cycle_start BIGINT NOT NULL,
+    witness VARCHAR(42) NOT NULL,
+    vote_count BIGINT NOT NULL DEFAULT 0,
+    rank INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_witness_vote_snapshot ON witness_vote_snapshots(cycle_start, witness);
+CREATE INDEX IF NOT EXISTS idx_witness_vote_snapshots_witness ON witness_vote_snapshots(witness);
+
+CREATE TABLE IF NOT EXISTS witness_brokerage_changes (
+    id BIGSERIAL PRIMARY KEY,
+    transaction_id VARCHAR(64),
+    block_number BIGINT NOT NULL,
+    block_timestamp BIGINT NOT NULL,
+    witness VARCHAR(42) NOT NULL,
+    brokerage INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_witness_brokerage_changes_tx ON witness_brokerage_changes(transaction_id);
+CREATE INDEX IF NOT EXISTS idx_witness_brokerage_changes_block_number ON witness_brokerage_changes(block_number);
+CREATE INDEX IF NOT EXISTS idx_witness_brokerage_changes_witness ON witness_brokerage_changes(witness);
+
+-- Blocks indexed before this migration stored the raw witness address bytes
+-- and must be reindexed for production counts to include them
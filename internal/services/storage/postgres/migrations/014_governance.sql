@@ -0,0 +1,53 @@
+-- Proposals with their approval timeline and the chain parameter values
+-- observed at each maintenance period
+CREATE TABLE IF NOT EXISTS governance_proposals (
+    proposal_id BIGINT PRIMARY KEY,
+    transaction_id VARCHAR(64),
+    block_number BIGINT NOT NULL,
+    proposer VARCHAR(42) NOT NULL,
+    parameters JSONB,
+    create_time BIGINT NOT NULL DEFAULT 0,
+    expiration_time BIGINT NOT NULL DEFAULT 0,
+    state VARCHAR(20) NOT NULL DEFAULT 'PENDING',
+    approval_count INTEGER NOT NULL DEFAULT 0,
+    resolved_block BIGINT NOT NULL DEFAULT 0,
+    resolved_at BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_governance_proposals_transaction_id ON governance_proposals(transaction_id);
+CREATE INDEX IF NOT EXISTS idx_governance_proposals_block_number ON governance_proposals(block_number);
+CREATE INDEX IF NOT EXISTS idx_governance_proposals_proposer ON governance_proposals(proposer);
+CREATE INDEX IF NOT EXISTS idx_governance_proposals_expiration_time ON governance_proposals(expiration_time);
+CREATE INDEX IF NOT EXISTS idx_governance_proposals_state ON governance_proposals(state);
+
+CREATE TABLE IF NOT EXISTS proposal_approvals (
+    id BIGSERIAL PRIMARY KEY,
+    proposal_id BIGINT NOT NULL,
+    transaction_id VARCHAR(64),
+    block_number BIGINT NOT NULL,
+    block_timestamp BIGINT NOT NULL,
+    witness VARCHAR(42) NOT NULL,
+    approve BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_proposal_approvals_proposal_id ON proposal_approvals(proposal_id);
+CREATE INDEX IF NOT EXISTS idx_proposal_approvals_transaction_id ON proposal_approvals(transaction_id);
+CREATE INDEX IF NOT EXISTS idx_proposal_approvals_block_number ON proposal_approvals(block_number);
+CREATE INDEX IF NOT EXISTS idx_proposal_approvals_witness ON proposal_approvals(witness);
+
+CREATE TABLE IF NOT EXISTS chain_parameter_changes (
+    id BIGSERIAL PRIMARY KEY,
+    key VARCHAR(64) NOT NULL,
+    old_value BIGINT,
+    new_value BIGINT NOT NULL,
+    block_number BIGINT NOT NULL,
+    changed_at BIGINT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_chain_parameter_changes_key ON chain_parameter_changes(key);
+CREATE INDEX IF NOT EXISTS idx_chain_parameter_changes_block_number ON chain_parameter_changes(block_number);
+CREATE INDEX IF NOT EXISTS idx_chain_parameter_changes_changed_at ON chain_parameter_changes(changed_at);
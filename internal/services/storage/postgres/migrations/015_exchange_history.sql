@@ -0,0 +1,81 @@
+-- Bancor exchange trades and liquidity changes, market orders and their fills
+CREATE TABLE IF NOT EXISTS exchange_trades (
+    id BIGSERIAL PRIMARY KEY,
+    transaction_id VARCHAR(64) UNIQUE,
+    block_number BIGINT NOT NULL,
+    block_timestamp BIGINT NOT NULL,
+    exchange_id BIGINT NOT NULL,
+    trader VARCHAR(42) NOT NULL,
+    side VARCHAR(4) NOT NULL,
+    base_amount BIGINT NOT NULL DEFAULT 0,
+    quote_amount BIGINT NOT NULL DEFAULT 0,
+    price DOUBLE PRECISION NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_exchange_trades_block_number ON exchange_trades(block_number);
+CREATE INDEX IF NOT EXISTS idx_exchange_trade ON exchange_trades(block_timestamp, exchange_id);
+CREATE INDEX IF NOT EXISTS idx_exchange_trades_trader ON exchange_trades(trader);
+
+CREATE TABLE IF NOT EXISTS exchange_liquidity_events (
+    id BIGSERIAL PRIMARY KEY,
+    transaction_id VARCHAR(64) UNIQUE,
+    block_number BIGINT NOT NULL,
+    block_timestamp BIGINT NOT NULL,
+    exchange_id BIGINT NOT NULL,
+    provider VARCHAR(42) NOT NULL,
+    action VARCHAR(10) NOT NULL,
+    first_token_amount BIGINT NOT NULL DEFAULT 0,
+    second_token_amount BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_exchange_liquidity_events_block_number ON exchange_liquidity_events(block_number);
+CREATE INDEX IF NOT EXISTS idx_exchange_liquidity_events_block_timestamp ON exchange_liquidity_events(block_timestamp);
+CREATE INDEX IF NOT EXISTS idx_exchange_liquidity_events_exchange_id ON exchange_liquidity_events(exchange_id);
+CREATE INDEX IF NOT EXISTS idx_exchange_liquidity_events_provider ON exchange_liquidity_events(provider);
+
+CREATE TABLE IF NOT EXISTS market_orders (
+    id BIGSERIAL PRIMARY KEY,
+    order_id VARCHAR(64) UNIQUE,
+    transaction_id VARCHAR(64),
+    block_number BIGINT NOT NULL,
+    block_timestamp BIGINT NOT NULL,
+    owner VARCHAR(42) NOT NULL,
+    pair VARCHAR(64) NOT NULL,
+    sell_token_id VARCHAR(32) NOT NULL,
+    sell_quantity BIGINT NOT NULL DEFAULT 0,
+    buy_token_id VARCHAR(32) NOT NULL,
+    buy_quantity BIGINT NOT NULL DEFAULT 0,
+    filled_quantity BIGINT NOT NULL DEFAULT 0,
+    state VARCHAR(10) NOT NULL,
+    canceled_block BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_market_orders_transaction_id ON market_orders(transaction_id);
+CREATE INDEX IF NOT EXISTS idx_market_orders_block_number ON market_orders(block_number);
+CREATE INDEX IF NOT EXISTS idx_market_orders_owner ON market_orders(owner);
+CREATE INDEX IF NOT EXISTS idx_market_orders_pair ON market_orders(pair);
+
+CREATE TABLE IF NOT EXISTS market_trades (
+    id BIGSERIAL PRIMARY KEY,
+    transaction_id VARCHAR(64),
+    block_number BIGINT NOT NULL,
+    block_timestamp BIGINT NOT NULL,
+    pair VARCHAR(64) NOT NULL,
+    maker_order_id VARCHAR(64),
+    taker_order_id VARCHAR(64),
+    taker VARCHAR(42) NOT NULL,
+    side VARCHAR(4) NOT NULL,
+    base_amount BIGINT NOT NULL DEFAULT 0,
+    quote_amount BIGINT NOT NULL DEFAULT 0,
+    price DOUBLE PRECISION NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_market_trades_transaction_id ON market_trades(transaction_id);
+CREATE INDEX IF NOT EXISTS idx_market_trades_block_number ON market_trades(block_number);
+CREATE INDEX IF NOT EXISTS idx_market_trade ON market_trades(block_timestamp, pair);
+CREATE INDEX IF NOT EXISTS idx_market_trades_taker ON market_trades(taker);
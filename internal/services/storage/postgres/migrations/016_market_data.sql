@@ -0,0 +1,5 @@
+-- Source and market cap of price feed quotes
+ALTER TABLE market_data ADD COLUMN IF NOT EXISTS market_cap VARCHAR(100);
+ALTER TABLE market_data ADD COLUMN IF NOT EXISTS source VARCHAR(20);
+
+CREATE INDEX IF NOT EXISTS idx_pair_timestamp ON market_data(pair, timestamp);
@@ -0,0 +1,29 @@
+-- Node info uploads and the GeoIP lookup cache of the node map
+CREATE TABLE IF NOT EXISTS node_reports (
+    host VARCHAR(255) PRIMARY KEY,
+    port INTEGER NOT NULL DEFAULT 0,
+    node_type VARCHAR(20),
+    version VARCHAR(50),
+    location VARCHAR(128),
+    block_height BIGINT NOT NULL DEFAULT 0,
+    peers INTEGER NOT NULL DEFAULT 0,
+    reported_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_node_reports_reported_at ON node_reports(reported_at);
+
+CREATE TABLE IF NOT EXISTS geo_ip_records (
+    ip VARCHAR(45) PRIMARY KEY,
+    country_code VARCHAR(2),
+    country VARCHAR(64),
+    city VARCHAR(128),
+    latitude DOUBLE PRECISION NOT NULL DEFAULT 0,
+    longitude DOUBLE PRECISION NOT NULL DEFAULT 0,
+    asn BIGINT NOT NULL DEFAULT 0,
+    as_organization VARCHAR(255),
+    hosting BOOLEAN NOT NULL DEFAULT FALSE,
+    database_build BIGINT NOT NULL DEFAULT 0,
+    resolved_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_geo_ip_records_country_code ON geo_ip_records(country_code);
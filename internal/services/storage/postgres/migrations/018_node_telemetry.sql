@@ -0,0 +1,33 @@
+-- Authenticated node telemetry uploads and their history
+ALTER TABLE node_reports ADD COLUMN IF NOT EXISTS reporter VARCHAR(64);
+ALTER TABLE node_reports ADD COLUMN IF NOT EXISTS solidity_block_height BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE node_reports ADD COLUMN IF NOT EXISTS syncing BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE node_reports ADD COLUMN IF NOT EXISTS uptime BIGINT NOT NULL DEFAULT 0;
+
+CREATE INDEX IF NOT EXISTS idx_node_reports_reporter ON node_reports(reporter);
+CREATE INDEX IF NOT EXISTS idx_node_reports_version ON node_reports(version);
+
+CREATE TABLE IF NOT EXISTS node_telemetries (
+    id BIGSERIAL PRIMARY KEY,
+    host VARCHAR(255) NOT NULL,
+    port INTEGER NOT NULL DEFAULT 0,
+    reporter VARCHAR(64),
+    node_type VARCHAR(20),
+    version VARCHAR(50),
+    block_height BIGINT NOT NULL DEFAULT 0,
+    solidity_block_height BIGINT NOT NULL DEFAULT 0,
+    peers INTEGER NOT NULL DEFAULT 0,
+    syncing BOOLEAN NOT NULL DEFAULT FALSE,
+    uptime BIGINT NOT NULL DEFAULT 0,
+    os_name VARCHAR(64),
+    cpu_count INTEGER NOT NULL DEFAULT 0,
+    cpu_rate DOUBLE PRECISION NOT NULL DEFAULT 0,
+    memory_total BIGINT NOT NULL DEFAULT 0,
+    memory_free BIGINT NOT NULL DEFAULT 0,
+    disk_total BIGINT NOT NULL DEFAULT 0,
+    disk_free BIGINT NOT NULL DEFAULT 0,
+    reported_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_node_telemetry_host ON node_telemetries(host, reported_at);
+CREATE INDEX IF NOT EXISTS idx_node_telemetries_reported_at ON node_telemetries(reported_at);
@@ -0,0 +1,8 @@
+-- Hourly and daily activity series and the overview summary computed by the aggregator
+CREATE INDEX IF NOT EXISTS idx_statistics_type_timestamp ON statistics(type, timestamp);
+
+-- First balance change of each account, for new account counts
+CREATE INDEX IF NOT EXISTS idx_balance_deltas_address_time ON balance_deltas(address, block_timestamp);
+
+-- LIND supply at the end of each bucket
+CREATE INDEX IF NOT EXISTS idx_balance_deltas_asset_time ON balance_deltas(asset, block_timestamp);
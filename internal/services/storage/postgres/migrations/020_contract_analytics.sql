@@ -0,0 +1,52 @@
+-- Smart contract calls and their daily per-contract and per-caller rollups
+CREATE TABLE IF NOT EXISTS contract_triggers (
+    id BIGSERIAL PRIMARY KEY,
+    transaction_id VARCHAR(64) UNIQUE,
+    block_number BIGINT NOT NULL,
+    block_timestamp BIGINT NOT NULL,
+    contract VARCHAR(42) NOT NULL,
+    caller VARCHAR(42) NOT NULL,
+    call_value BIGINT NOT NULL DEFAULT 0,
+    energy_used BIGINT NOT NULL DEFAULT 0,
+    caller_energy BIGINT NOT NULL DEFAULT 0,
+    owner_energy BIGINT NOT NULL DEFAULT 0,
+    energy_fee BIGINT NOT NULL DEFAULT 0,
+    fee BIGINT NOT NULL DEFAULT 0,
+    failed BOOLEAN NOT NULL DEFAULT FALSE,
+    result VARCHAR(32),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_contract_triggers_block_number ON contract_triggers(block_number);
+CREATE INDEX IF NOT EXISTS idx_contract_trigger ON contract_triggers(block_timestamp, contract);
+CREATE INDEX IF NOT EXISTS idx_contract_triggers_contract_time ON contract_triggers(contract, block_timestamp DESC);
+CREATE INDEX IF NOT EXISTS idx_contract_triggers_caller ON contract_triggers(caller);
+
+CREATE TABLE IF NOT EXISTS contract_daily_stats (
+    id BIGSERIAL PRIMARY KEY,
+    contract VARCHAR(42) NOT NULL,
+    day BIGINT NOT NULL,
+    triggers BIGINT NOT NULL DEFAULT 0,
+    failed BIGINT NOT NULL DEFAULT 0,
+    unique_callers BIGINT NOT NULL DEFAULT 0,
+    call_value BIGINT NOT NULL DEFAULT 0,
+    energy_used BIGINT NOT NULL DEFAULT 0,
+    caller_energy BIGINT NOT NULL DEFAULT 0,
+    owner_energy BIGINT NOT NULL DEFAULT 0,
+    energy_fee BIGINT NOT NULL DEFAULT 0,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_contract_day ON contract_daily_stats(contract, day);
+CREATE INDEX IF NOT EXISTS idx_contract_daily_stats_day ON contract_daily_stats(day);
+
+CREATE TABLE IF NOT EXISTS contract_daily_callers (
+    id BIGSERIAL PRIMARY KEY,
+    contract VARCHAR(42) NOT NULL,
+    day BIGINT NOT NULL,
+    caller VARCHAR(42) NOT NULL,
+    triggers BIGINT NOT NULL DEFAULT 0,
+    energy_used BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_contract_day_caller ON contract_daily_callers(contract, day, caller);
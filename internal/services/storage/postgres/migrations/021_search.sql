@@ -0,0 +1,20 @@
+-- Deployed contracts and the trigram indexes of the searched names
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE TABLE IF NOT EXISTS contracts (
+    id BIGSERIAL PRIMARY KEY,
+    address VARCHAR(42) UNIQUE,
+    name VARCHAR(100),
+    owner VARCHAR(42),
+    block_number BIGINT NOT NULL,
+    verified BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_contracts_owner ON contracts(owner);
+CREATE INDEX IF NOT EXISTS idx_contracts_block_number ON contracts(block_number);
+CREATE INDEX IF NOT EXISTS idx_contracts_name_trgm ON contracts USING gin (name gin_trgm_ops);
+
+CREATE INDEX IF NOT EXISTS idx_token_infos_name_trgm ON token_infos USING gin (name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_token_infos_symbol_trgm ON token_infos USING gin (symbol gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_accounts_account_name_trgm ON accounts USING gin (account_name gin_trgm_ops);
@@ -0,0 +1,27 @@
+-- Signed tags: moderation status, curator tags and one vote per signer.
+-- Tags saved before signatures were verified go to the moderation queue.
+ALTER TABLE tags ADD COLUMN IF NOT EXISTS status VARCHAR(16) NOT NULL DEFAULT 'pending';
+ALTER TABLE tags ADD COLUMN IF NOT EXISTS curated BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE tags ADD COLUMN IF NOT EXISTS moderated_by VARCHAR(42) NOT NULL DEFAULT '';
+ALTER TABLE tags ADD COLUMN IF NOT EXISTS moderated_at BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE tags ADD COLUMN IF NOT EXISTS signed_at BIGINT NOT NULL DEFAULT 0;
+
+CREATE INDEX IF NOT EXISTS idx_tags_status ON tags(status, created_at);
+
+-- An owner could tag an address with the same tag more than once, the first tag is kept
+DELETE FROM tags a USING tags b
+WHERE a.address = b.address AND a.tag = b.tag AND a.owner = b.owner AND a.id > b.id;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_tag_owner ON tags(address, tag, owner);
+
+CREATE TABLE IF NOT EXISTS tag_votes (
+    id BIGSERIAL PRIMARY KEY,
+    tag_id INT NOT NULL REFERENCES tags(id) ON DELETE CASCADE,
+    voter VARCHAR(42) NOT NULL,
+    value INT NOT NULL,
+    signature TEXT,
+    signed_at BIGINT NOT NULL DEFAULT 0,
+    created_at BIGINT NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_tag_voter ON tag_votes(tag_id, voter);
+CREATE INDEX IF NOT EXISTS idx_tag_votes_voter ON tag_votes(voter);
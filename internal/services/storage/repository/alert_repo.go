@@ -0,0 +1,161 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
+	"gorm.io/gorm"
+)
+
+// AlertRepository struct: Repository for watchlists, alert rules and fired alerts
+type AlertRepository struct {
+	db *gorm.DB
+}
+
+// NewAlertRepository function: Creates a new alert repository
+func NewAlertRepository(db *gorm.DB) *AlertRepository {
+	return &AlertRepository{db: db}
+}
+
+// CreateWatchlist function: Saves a new watchlist with its addresses
+func (r *AlertRepository) CreateWatchlist(watchlist *models.Watchlist) error {
+	return r.db.Create(watchlist).Error
+}
+
+// GetWatchlist function: Retrieves a watchlist owned by a user, with its addresses
+func (r *AlertRepository) GetWatchlist(id, userID string) (*models.Watchlist, error) {
+	var watchlist models.Watchlist
+	err := r.db.Preload("Addresses").Where("id = ? AND user_id = ?", id, userID).First(&watchlist).Error
+	if err != nil {
+		return nil, err
+	}
+	return &watchlist, nil
+}
+
+// GetWatchlists function: Retrieves the watchlists of a user
+func (r *AlertRepository) GetWatchlists(userID string) ([]*models.Watchlist, error) {
+	var watchlists []*models.Watchlist
+	err := r.db.Preload("Addresses").Where("user_id = ?", userID).Order("created_at DESC").Find(&watchlists).Error
+	return watchlists, err
+}
+
+// DeleteWatchlist function: Deletes a watchlist together with its addresses and rules
+func (r *AlertRepository) DeleteWatchlist(id, userID string) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("id = ? AND user_id = ?", id, userID).Delete(&models.Watchlist{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		if err := tx.Where("watchlist_id = ?", id).Delete(&models.WatchlistAddress{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("watchlist_id = ?", id).Delete(&models.AlertRule{}).Error
+	})
+}
+
+// AddWatchlistAddress function: Adds an address to a watchlist, updating the label if it is already present
+func (r *AlertRepository) AddWatchlistAddress(address *models.WatchlistAddress) error {
+	var existing models.WatchlistAddress
+	err := r.db.Where("watchlist_id = ? AND address = ?", address.WatchlistID, address.Address).First(&existing).Error
+	if err == nil {
+		existing.Label = address.Label
+		return r.db.Save(&existing).Error
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	return r.db.Create(address).Error
+}
+
+// RemoveWatchlistAddress function: Removes an address from a watchlist
+func (r *AlertRepository) RemoveWatchlistAddress(watchlistID, address string) error {
+	result := r.db.Where("watchlist_id = ? AND address = ?", watchlistID, address).Delete(&models.WatchlistAddress{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// CreateRule function: Saves a new alert rule
+func (r *AlertRepository) CreateRule(rule *models.AlertRule) error {
+	return r.db.Create(rule).Error
+}
+
+// GetRules function: Retrieves the alert rules of a user
+func (r *AlertRepository) GetRules(userID string) ([]*models.AlertRule, error) {
+	var rules []*models.AlertRule
+	err := r.db.Where("user_id = ?", userID).Order("created_at DESC").Find(&rules).Error
+	return rules, err
+}
+
+// DeleteRule function: Deletes an alert rule owned by a user
+func (r *AlertRepository) DeleteRule(id, userID string) error {
+	result := r.db.Where("id = ? AND user_id = ?", id, userID).Delete(&models.AlertRule{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// GetActiveRules function: Retrieves all active alert rules
+func (r *AlertRepository) GetActiveRules() ([]*models.AlertRule, error) {
+	var rules []*models.AlertRule
+	err := r.db.Where("active = ?", true).Find(&rules).Error
+	return rules, err
+}
+
+// GetAddressesByWatchlists function: Retrieves the addresses on a set of watchlists
+func (r *AlertRepository) GetAddressesByWatchlists(watchlistIDs []string) ([]*models.WatchlistAddress, error) {
+	var addresses []*models.WatchlistAddress
+	if len(watchlistIDs) == 0 {
+		return addresses, nil
+	}
+	err := r.db.Where("watchlist_id IN ?", watchlistIDs).Find(&addresses).Error
+	return addresses, err
+}
+
+// HasRecentAlert function: Checks whether an alert with the dedup key fired since the given time
+func (r *AlertRepository) HasRecentAlert(ruleID, dedupKey string, since time.Time) (bool, error) {
+	var count int64
+	err := r.db.Model(&models.Alert{}).
+		Where("rule_id = ? AND dedup_key = ? AND created_at >= ?", ruleID, dedupKey, since).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// SaveAlert function: Saves a fired alert or the outcome of its notification
+func (r *AlertRepository) SaveAlert(alert *models.Alert) error {
+	return r.db.Save(alert).Error
+}
+
+// GetAlerts function: Retrieves the alert history of a user
+func (r *AlertRepository) GetAlerts(userID, ruleID string, offset, limit int) ([]*models.Alert, int64, error) {
+	var alerts []*models.Alert
+	var total int64
+
+	query := r.db.Model(&models.Alert{}).Where("user_id = ?", userID)
+	if ruleID != "" {
+		query = query.Where("rule_id = ?", ruleID)
+	}
+
+	// Count total
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if err := query.Order("created_at DESC").Offset(offset).Limit(limit).Find(&alerts).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return alerts, total, nil
+}
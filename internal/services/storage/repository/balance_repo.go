@@ -0,0 +1,181 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ErrBalanceNotAvailable is returned for a balance before the indexed history of an address
+var ErrBalanceNotAvailable = errors.New("balance not available")
+
+// BalanceRepository struct: Repository for per-address balance deltas
+type BalanceRepository struct {
+	db *gorm.DB
+}
+
+// NewBalanceRepository function: Creates a new balance repository
+func NewBalanceRepository(db *gorm.DB) *BalanceRepository {
+	return &BalanceRepository{db: db}
+}
+
+// SaveDeltas function: Saves balance deltas, zero deltas are skipped
+func (r *BalanceRepository) SaveDeltas(deltas []*models.BalanceDelta) error {
+	return saveBalanceDeltas(r.db, deltas)
+}
+
+// saveBalanceDeltas inserts the non-zero deltas and the openings in one
+// statement, numbering the deltas of each address and asset of a record in
+// order. Deltas already recorded by an earlier run over the same transaction
+// are skipped.
+func saveBalanceDeltas(db *gorm.DB, deltas []*models.BalanceDelta) error {
+	rows := make([]*models.BalanceDelta, 0, len(deltas))
+	seqs := make(map[[3]string]int)
+	for _, delta := range deltas {
+		if (delta.Delta == "" || delta.Delta == "0") && delta.Kind != models.BalanceDeltaOpening {
+			continue
+		}
+		key := [3]string{delta.Kind, delta.Address, delta.Asset}
+		delta.Seq = seqs[key]
+		seqs[key]++
+		rows = append(rows, delta)
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+	return db.Clauses(clause.OnConflict{DoNothing: true}).Create(&rows).Error
+}
+
+// transferDeltas returns the deltas of a token transfer for its sender and
+// recipient, the untracked mint/burn address gets none
+func transferDeltas(transfer *models.TokenTransferResponse, value *big.Int, untrackedAddress string) []*models.BalanceDelta {
+	deltas := make([]*models.BalanceDelta, 0, 2)
+	if transfer.From != untrackedAddress {
+		deltas = append(deltas, &models.BalanceDelta{
+			Address:        transfer.From,
+			Asset:          transfer.TokenAddress,
+			BlockNumber:    transfer.BlockNumber,
+			BlockTimestamp: transfer.BlockTimestamp,
+			TransactionID:  transfer.TransactionID,
+			Kind:           models.BalanceDeltaTransfer(transfer.LogIndex),
+			Delta:          new(big.Int).Neg(value).String(),
+		})
+	}
+	if transfer.To != untrackedAddress {
+		deltas = append(deltas, &models.BalanceDelta{
+			Address:        transfer.To,
+			Asset:          transfer.TokenAddress,
+			BlockNumber:    transfer.BlockNumber,
+			BlockTimestamp: transfer.BlockTimestamp,
+			TransactionID:  transfer.TransactionID,
+			Kind:           models.BalanceDeltaTransfer(transfer.LogIndex),
+			Delta:          value.String(),
+		})
+	}
+	return deltas
+}
+
+// HasDeltas function: Reports whether any delta of an address in an asset is recorded
+func (r *BalanceRepository) HasDeltas(address, asset string) (bool, error) {
+	var count int64
+	err := r.db.Model(&models.BalanceDelta{}).
+		Where("address = ? AND asset = ?", address, asset).
+		Limit(1).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// DeleteFromBlock function: Removes the balance deltas of blocks at and above a height
+func (r *BalanceRepository) DeleteFromBlock(blockNumber int64) error {
+	return r.db.Where("block_number >= ?", blockNumber).Delete(&models.BalanceDelta{}).Error
+}
+
+// GetBalanceAt function: Gets the balance of an address in an asset at the end of a block,
+// a non-positive block number returns the latest indexed balance. Blocks before the opening
+// of an address, where its indexed history starts, return ErrBalanceNotAvailable.
+func (r *BalanceRepository) GetBalanceAt(address, asset string, blockNumber int64) (string, error) {
+	query := r.db.Model(&models.BalanceDelta{}).
+		Where("address = ? AND asset = ?", address, asset)
+	if blockNumber > 0 {
+		var start sql.NullInt64
+		if err := r.db.Model(&models.BalanceDelta{}).
+			Where("address = ? AND asset = ? AND kind = ?", address, asset, models.BalanceDeltaOpening).
+			Select("MIN(block_number)").
+			Scan(&start).Error; err != nil {
+			return "", err
+		}
+		if start.Valid && blockNumber < start.Int64 {
+			return "", fmt.Errorf("%w: history starts at block %d", ErrBalanceNotAvailable, start.Int64)
+		}
+		query = query.Where("block_number <= ?", blockNumber)
+	}
+
+	var balance string
+	if err := query.Select("COALESCE(SUM(delta), 0)::TEXT").Scan(&balance).Error; err != nil {
+		return "", err
+	}
+	return balance, nil
+}
+
+// GetHistory function: Gets the balance of an address in an asset at the end of each
+// interval between two timestamps, all in milliseconds
+func (r *BalanceRepository) GetHistory(address, asset string, from, to, interval int64) ([]*models.BalancePoint, error) {
+	if interval <= 0 || to < from {
+		return nil, errors.New("invalid history range")
+	}
+
+	// Balance before the first interval
+	var opening string
+	if err := r.db.Model(&models.BalanceDelta{}).
+		Where("address = ? AND asset = ? AND block_timestamp < ?", address, asset, from).
+		Select("COALESCE(SUM(delta), 0)::TEXT").
+		Scan(&opening).Error; err != nil {
+		return nil, err
+	}
+	balance, ok := new(big.Int).SetString(opening, 10)
+	if !ok {
+		return nil, errors.New("invalid balance format")
+	}
+
+	// Net change of each interval that has one
+	var changes []struct {
+		Bucket int64
+		Delta  string
+	}
+	if err := r.db.Model(&models.BalanceDelta{}).
+		Where("address = ? AND asset = ? AND block_timestamp >= ? AND block_timestamp <= ?", address, asset, from, to).
+		Select("(block_timestamp - ?) / ? AS bucket, SUM(delta)::TEXT AS delta", from, interval).
+		Group("bucket").
+		Order("bucket ASC").
+		Scan(&changes).Error; err != nil {
+		return nil, err
+	}
+
+	buckets := (to-from)/interval + 1
+	points := make([]*models.BalancePoint, 0, buckets)
+	next := 0
+	for bucket := int64(0); bucket < buckets; bucket++ {
+		if next < len(changes) && changes[next].Bucket == bucket {
+			if delta, ok := new(big.Int).SetString(changes[next].Delta, 10); ok {
+				balance.Add(balance, delta)
+			}
+			next++
+		}
+
+		end := from + (bucket+1)*interval - 1
+		if end > to {
+			end = to
+		}
+		points = append(points, &models.BalancePoint{
+			Timestamp: end,
+			Balance:   balance.String(),
+		})
+	}
+
+	return points, nil
+}
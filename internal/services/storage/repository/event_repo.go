@@ -1,108 +1,181 @@
-package repository
-
-import (
-	"encoding/json"
-	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
-	"gorm.io/gorm"
-)
-
-// EventRepository struct: Repository for event operations
-type EventRepository struct {
-	db *gorm.DB
-}
-
-// NewEventRepository function: Creates a new event repository
-func NewEventRepository(db *gorm.DB) *EventRepository {
-	return &EventRepository{db: db}
-}
-
-// SaveEvent function: Saves an event
-func (r *EventRepository) SaveEvent(event *models.EventResponse) error {
-		// Marshal the maps to JSON
-		resultJSON, err := json.Marshal(event.Result)
-		if err != nil {
-			return err
-		}
-		
-		resultTypeJSON, err := json.Marshal(event.ResultType)
-		if err != nil {
-			return err
-		}
-    // Convert to Event model for storage
-    eventModel := &models.Event{
-        BlockNumber:     event.BlockNumber,
-        BlockTimestamp:  event.BlockTimestamp,
-        ContractAddress: event.ContractAddress,
-        EventIndex:      event.EventIndex,
-        EventName:       event.EventName,
-        EventSignature:  event.Event,
-        TransactionID:   event.TransactionID,
-        Result:          models.JSON(resultJSON),
-        ResultType:      models.JSON(resultTypeJSON),
-        Unconfirmed:     event.Unconfirmed,
-    }
-    return r.db.Save(eventModel).Error
-}
-
-// GetEvents function: Retrieves events with filters
-func (r *EventRepository) GetEvents(contractAddress, eventName, transactionID string, blockNumber int64, fromTimestamp, toTimestamp int64, offset, limit int, sort string, confirmed bool) ([]*models.EventResponse, int64, error) {
-	var events []*models.EventResponse
-	var total int64
-
-	query := r.db.Model(&models.EventResponse{})
-
-	if contractAddress != "" {
-		query = query.Where("contract_address = ?", contractAddress)
-	}
-	if eventName != "" {
-		query = query.Where("event_name = ?", eventName)
-	}
-	if transactionID != "" {
-		query = query.Where("transaction_id = ?", transactionID)
-	}
-	if blockNumber > 0 {
-		query = query.Where("block_number = ?", blockNumber)
-	}
-	if fromTimestamp > 0 {
-		query = query.Where("block_timestamp >= ?", fromTimestamp)
-	}
-	if toTimestamp > 0 {
-		query = query.Where("block_timestamp <= ?", toTimestamp)
-	}
-	if confirmed {
-		query = query.Where("unconfirmed = ?", false)
-	}
-
-	// Count total
-	if err := query.Count(&total).Error; err != nil {
-		return nil, 0, err
-	}
-
-	// Apply sorting
-	if sort != "" {
-		if sort[0] == '-' {
-			query = query.Order(sort[1:] + " DESC")
-		} else {
-			query = query.Order(sort + " ASC")
-		}
-	} else {
-		query = query.Order("block_timestamp DESC")
-	}
-
-	// Apply pagination
-	if err := query.Offset(offset).Limit(limit).Find(&events).Error; err != nil {
-		return nil, 0, err
-	}
-
-	return events, total, nil
-}
-
-// GetEventsByTransactionId function: Retrieves events for a transaction
-func (r *EventRepository) GetEventsByTransactionId(transactionID string, offset, limit int) ([]*models.EventResponse, int64, error) {
-	return r.GetEvents("", "", transactionID, 0, 0, 0, offset, limit, "", false)
-}
-
-// GetEventsByContractAddress function: Retrieves events for a contract
-func (r *EventRepository) GetEventsByContractAddress(contractAddress string, eventName string, fromBlock int64, fromTimestamp, toTimestamp int64, offset, limit int, sort string) ([]*models.EventResponse, int64, error) {
-	return r.GetEvents(contractAddress, eventName, "", fromBlock, fromTimestamp, toTimestamp, offset, limit, sort, false)
+package repository
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
+	"gorm.io/gorm"
+)
+
+// EventRepository struct: Repository for event operations
+type EventRepository struct {
+	db *gorm.DB
+}
+
+// NewEventRepository function: Creates a new event repository
+func NewEventRepository(db *gorm.DB) *EventRepository {
+	return &EventRepository{db: db}
+}
+
+// SaveEvent function: Saves an event
+func (r *EventRepository) SaveEvent(event *models.EventResponse) error {
+		// Marshal the maps to JSON
+		resultJSON, err := json.Marshal(event.Result)
+		if err != nil {
+			return err
+		}
+		
+		resultTypeJSON, err := json.Marshal(event.ResultType)
+		if err != nil {
+			return err
+		}
+
+		topicsJSON, err := json.Marshal(event.Topics)
+		if err != nil {
+			return err
+		}
+    // Convert to Event model for storage
+    eventModel := &models.Event{
+        BlockNumber:      event.BlockNumber,
+        BlockTimestamp:   event.BlockTimestamp,
+        ContractAddress:  event.ContractAddress,
+        EventIndex:       event.EventIndex,
+        EventName:        event.EventName,
+        EventSignature:   event.Event,
+        TransactionID:    event.TransactionID,
+        Result:           models.JSON(resultJSON),
+        ResultType:       models.JSON(resultTypeJSON),
+        Unconfirmed:      event.Unconfirmed,
+        BlockHash:        event.BlockHash,
+        TransactionIndex: event.TransactionIndex,
+        LogIndex:         event.LogIndex,
+        Topics:           models.JSON(topicsJSON),
+        Data:             event.Data,
+    }
+    // Indexed topic columns back the eth_getLogs filters
+    topicColumns := []*string{&eventModel.Topic0, &eventModel.Topic1, &eventModel.Topic2, &eventModel.Topic3}
+    for i, topic := range event.Topics {
+        if i < len(topicColumns) {
+            *topicColumns[i] = topic
+        }
+    }
+    return r.db.Save(eventModel).Error
+}
+
+// GetEvents function: Retrieves events with filters, optionally only confirmed or only unconfirmed ones
+func (r *EventRepository) GetEvents(contractAddress, eventName, transactionID string, blockNumber int64, fromTimestamp, toTimestamp int64, offset, limit int, sort string, onlyConfirmed, onlyUnconfirmed bool) ([]*models.EventResponse, int64, error) {
+	var events []*models.EventResponse
+	var total int64
+
+	query := r.db.Model(&models.EventResponse{})
+
+	if contractAddress != "" {
+		query = query.Where("contract_address = ?", contractAddress)
+	}
+	if eventName != "" {
+		query = query.Where("event_name = ?", eventName)
+	}
+	if transactionID != "" {
+		query = query.Where("transaction_id = ?", transactionID)
+	}
+	if blockNumber > 0 {
+		query = query.Where("block_number = ?", blockNumber)
+	}
+	if fromTimestamp > 0 {
+		query = query.Where("block_timestamp >= ?", fromTimestamp)
+	}
+	if toTimestamp > 0 {
+		query = query.Where("block_timestamp <= ?", toTimestamp)
+	}
+	if onlyConfirmed {
+		query = query.Where("unconfirmed = ?", false)
+	}
+	if onlyUnconfirmed {
+		query = query.Where("unconfirmed = ?", true)
+	}
+
+	// Count total
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Apply sorting
+	if sort != "" {
+		if sort[0] == '-' {
+			query = query.Order(sort[1:] + " DESC")
+		} else {
+			query = query.Order(sort + " ASC")
+		}
+	} else {
+		query = query.Order("block_timestamp DESC")
+	}
+
+	// Apply pagination
+	if err := query.Offset(offset).Limit(limit).Find(&events).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return events, total, nil
+}
+
+// GetEventsByTransactionId function: Retrieves events for a transaction
+func (r *EventRepository) GetEventsByTransactionId(transactionID string, offset, limit int) ([]*models.EventResponse, int64, error) {
+	return r.GetEvents("", "", transactionID, 0, 0, 0, offset, limit, "", false, false)
+}
+
+// GetEventsByContractAddress function: Retrieves events for a contract
+func (r *EventRepository) GetEventsByContractAddress(contractAddress string, eventName string, fromBlock int64, fromTimestamp, toTimestamp int64, offset, limit int, sort string) ([]*models.EventResponse, int64, error) {
+	return r.GetEvents(contractAddress, eventName, "", fromBlock, fromTimestamp, toTimestamp, offset, limit, sort, false, false)
+}
+
+// GetLogs function: Retrieves raw logs for eth_getLogs. topics holds the OR-set
+// for each position, an empty set matches any value. Events of unconfirmed blocks are
+// skipped unless includeUnconfirmed is set. At most limit rows are returned.
+func (r *EventRepository) GetLogs(addresses []string, topics [][]string, fromBlock, toBlock int64, blockHash string, includeUnconfirmed bool, limit int) ([]*models.Event, error) {
+	var events []*models.Event
+
+	query := r.db.Model(&models.Event{})
+
+	if blockHash != "" {
+		query = query.Where("block_hash = ?", blockHash)
+	} else {
+		query = query.Where("block_number BETWEEN ? AND ?", fromBlock, toBlock)
+	}
+	if !includeUnconfirmed {
+		query = query.Where("unconfirmed = ?", false)
+	}
+	if len(addresses) > 0 {
+		query = query.Where("contract_address IN ?", addresses)
+	}
+	for i, set := range topics {
+		if i > 3 {
+			break
+		}
+		if len(set) > 0 {
+			query = query.Where(fmt.Sprintf("topic%d IN ?", i), set)
+		}
+	}
+
+	err := query.Order("block_number ASC, log_index ASC").Limit(limit).Find(&events).Error
+	return events, err
+}
+
+// HasBlockLogs function: Checks if any log of a block hash has been indexed
+func (r *EventRepository) HasBlockLogs(blockHash string) (bool, error) {
+	var count int64
+	err := r.db.Model(&models.Event{}).Where("block_hash = ?", blockHash).Limit(1).Count(&count).Error
+	return count > 0, err
+}
+
+// ConfirmUpTo function: Marks the events of blocks up to and including a height as confirmed
+func (r *EventRepository) ConfirmUpTo(blockNumber int64) error {
+	return r.db.Model(&models.Event{}).
+		Where("unconfirmed = ? AND block_number <= ?", true, blockNumber).
+		Update("unconfirmed", false).Error
+}
+
+// DeleteFromBlock function: Removes the events of blocks at and above a height
+func (r *EventRepository) DeleteFromBlock(blockNumber int64) error {
+	return r.db.Where("block_number >= ?", blockNumber).Delete(&models.Event{}).Error
 }
\ No newline at end of file
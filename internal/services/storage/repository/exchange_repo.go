@@ -0,0 +1,241 @@
+package repository
+
+import (
+	"fmt"
+
+	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
+	"gorm.io/gorm"
+)
+
+// candleQuery summarises the trades of one exchange or pair in @interval
+// millisecond buckets. The caller substitutes the table and filter column.
+const candleQuery = `
+	SELECT
+		FLOOR(block_timestamp / @interval) * @interval AS time,
+		(ARRAY_AGG(price ORDER BY block_number, id))[1] AS open,
+		MAX(price) AS high,
+		MIN(price) AS low,
+		(ARRAY_AGG(price ORDER BY block_number DESC, id DESC))[1] AS close,
+		SUM(base_amount) AS volume,
+		SUM(quote_amount) AS quote_volume,
+		COUNT(*) AS trades
+	FROM %s
+	WHERE %s = @key AND block_timestamp >= @from AND block_timestamp <= @to
+	GROUP BY 1
+	ORDER BY 1`
+
+// ExchangeRepository struct: Repository for Bancor exchange and market trades, liquidity and orders
+type ExchangeRepository struct {
+	db *gorm.DB
+}
+
+// NewExchangeRepository function: Creates a new exchange repository
+func NewExchangeRepository(db *gorm.DB) *ExchangeRepository {
+	return &ExchangeRepository{db: db}
+}
+
+// SaveExchangeTrade function: Saves a Bancor exchange trade
+func (r *ExchangeRepository) SaveExchangeTrade(trade *models.ExchangeTrade) error {
+	return r.db.Save(trade).Error
+}
+
+// SaveLiquidityEvent function: Saves an exchange creation, injection or withdrawal
+func (r *ExchangeRepository) SaveLiquidityEvent(event *models.ExchangeLiquidityEvent) error {
+	return r.db.Save(event).Error
+}
+
+// SaveMarketOrder function: Saves a market order
+func (r *ExchangeRepository) SaveMarketOrder(order *models.MarketOrder) error {
+	return r.db.Save(order).Error
+}
+
+// SaveMarketTrades function: Saves the fills of a market order and adds them to the filled
+// quantity of the orders on both sides
+func (r *ExchangeRepository) SaveMarketTrades(trades []*models.MarketTrade, fills map[string]int64) error {
+	if len(trades) == 0 {
+		return nil
+	}
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&trades).Error; err != nil {
+			return err
+		}
+		for orderID, quantity := range fills {
+			if err := tx.Model(&models.MarketOrder{}).
+				Where("order_id = ?", orderID).
+				Updates(map[string]interface{}{
+					"filled_quantity": gorm.Expr("filled_quantity + ?", quantity),
+					"state": gorm.Expr("CASE WHEN filled_quantity + ? >= sell_quantity THEN ? ELSE state END",
+						quantity, models.MarketOrderStateInactive),
+				}).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// CancelMarketOrder function: Marks a market order canceled
+func (r *ExchangeRepository) CancelMarketOrder(orderID string, blockNumber int64) error {
+	return r.db.Model(&models.MarketOrder{}).
+		Where("order_id = ?", orderID).
+		Updates(map[string]interface{}{
+			"state":          models.MarketOrderStateCanceled,
+			"canceled_block": blockNumber,
+		}).Error
+}
+
+// DeleteFromBlock function: Removes the trades, liquidity events and orders of blocks at and above
+// a height and restores the fills and state of older orders
+func (r *ExchangeRepository) DeleteFromBlock(blockNumber int64) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var affected []string
+		if err := tx.Raw(`
+			SELECT maker_order_id FROM market_trades WHERE block_number >= ?
+			UNION
+			SELECT taker_order_id FROM market_trades WHERE block_number >= ?
+			UNION
+			SELECT order_id FROM market_orders WHERE canceled_block >= ?
+		`, blockNumber, blockNumber, blockNumber).Scan(&affected).Error; err != nil {
+			return err
+		}
+
+		for _, model := range []interface{}{
+			&models.ExchangeTrade{},
+			&models.ExchangeLiquidityEvent{},
+			&models.MarketTrade{},
+			&models.MarketOrder{},
+		} {
+			if err := tx.Where("block_number >= ?", blockNumber).Delete(model).Error; err != nil {
+				return err
+			}
+		}
+		if len(affected) == 0 {
+			return nil
+		}
+
+		// An order sells the same token whether it was maker or taker of a fill
+		return tx.Exec(`
+			UPDATE market_orders o
+			SET filled_quantity = f.filled,
+				canceled_block = 0,
+				state = CASE WHEN f.filled >= o.sell_quantity THEN ? ELSE ? END
+			FROM (
+				SELECT o2.order_id, COALESCE(SUM(
+					CASE WHEN o2.sell_token_id = SPLIT_PART(t.pair, '-', 1) THEN t.base_amount ELSE t.quote_amount END
+				), 0) AS filled
+				FROM market_orders o2
+				LEFT JOIN market_trades t ON t.maker_order_id = o2.order_id OR t.taker_order_id = o2.order_id
+				WHERE o2.order_id IN ?
+				GROUP BY o2.order_id
+			) AS f
+			WHERE o.order_id = f.order_id
+		`, models.MarketOrderStateInactive, models.MarketOrderStateActive, affected).Error
+	})
+}
+
+// GetExchangeCandles function: Retrieves the OHLCV candles of an exchange between two millisecond timestamps
+func (r *ExchangeRepository) GetExchangeCandles(exchangeID, interval, fromTime, toTime int64) ([]*models.Candle, error) {
+	return r.candles("exchange_trades", "exchange_id", exchangeID, interval, fromTime, toTime)
+}
+
+// GetMarketCandles function: Retrieves the OHLCV candles of a market pair between two millisecond timestamps
+func (r *ExchangeRepository) GetMarketCandles(pair string, interval, fromTime, toTime int64) ([]*models.Candle, error) {
+	return r.candles("market_trades", "pair", pair, interval, fromTime, toTime)
+}
+
+// candles runs candleQuery against a trade table
+func (r *ExchangeRepository) candles(table, column string, key interface{}, interval, fromTime, toTime int64) ([]*models.Candle, error) {
+	var candles []*models.Candle
+	err := r.db.Raw(fmt.Sprintf(candleQuery, table, column), map[string]interface{}{
+		"interval": interval,
+		"key":      key,
+		"from":     fromTime,
+		"to":       toTime,
+	}).Scan(&candles).Error
+	return candles, err
+}
+
+// GetExchangeVolume function: Gets the volume traded on an exchange since a timestamp
+func (r *ExchangeRepository) GetExchangeVolume(exchangeID, since int64) (*models.TradeVolume, error) {
+	var volume models.TradeVolume
+	err := r.db.Model(&models.ExchangeTrade{}).
+		Select("COALESCE(SUM(base_amount), 0) AS volume, COALESCE(SUM(quote_amount), 0) AS quote_volume, COUNT(*) AS trades").
+		Where("exchange_id = ? AND block_timestamp >= ?", exchangeID, since).
+		Scan(&volume).Error
+	return &volume, err
+}
+
+// GetLastExchangeTrade function: Gets the latest trade of an exchange
+func (r *ExchangeRepository) GetLastExchangeTrade(exchangeID int64) (*models.ExchangeTrade, error) {
+	var trade models.ExchangeTrade
+	err := r.db.Where("exchange_id = ?", exchangeID).
+		Order("block_number DESC, id DESC").
+		First(&trade).Error
+	return &trade, err
+}
+
+// GetMarketVolume function: Gets the volume traded on a market pair since a timestamp
+func (r *ExchangeRepository) GetMarketVolume(pair string, since int64) (*models.TradeVolume, error) {
+	var volume models.TradeVolume
+	err := r.db.Model(&models.MarketTrade{}).
+		Select("COALESCE(SUM(base_amount), 0) AS volume, COALESCE(SUM(quote_amount), 0) AS quote_volume, COUNT(*) AS trades").
+		Where("pair = ? AND block_timestamp >= ?", pair, since).
+		Scan(&volume).Error
+	return &volume, err
+}
+
+// GetMarketTrades function: Retrieves the trades of a market pair, newest first
+func (r *ExchangeRepository) GetMarketTrades(pair string, offset, limit int) ([]*models.MarketTrade, int64, error) {
+	var trades []*models.MarketTrade
+	var total int64
+
+	query := r.db.Model(&models.MarketTrade{}).Where("pair = ?", pair)
+
+	// Count total
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if err := query.Order("block_number DESC, id DESC").Offset(offset).Limit(limit).Find(&trades).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return trades, total, nil
+}
+
+// GetLiquidityHistory function: Retrieves the daily closing balances of an exchange between two
+// millisecond timestamps, rebuilt from its liquidity events and trades
+func (r *ExchangeRepository) GetLiquidityHistory(exchangeID, fromTime, toTime int64) ([]*models.LiquidityPoint, error) {
+	var points []*models.LiquidityPoint
+	err := r.db.Raw(`
+		WITH changes AS (
+			SELECT block_timestamp, first_token_amount AS first_delta, second_token_amount AS second_delta
+			FROM exchange_liquidity_events WHERE exchange_id = @exchange
+			UNION ALL
+			SELECT block_timestamp,
+				CASE WHEN side = @buy THEN -base_amount ELSE base_amount END,
+				CASE WHEN side = @buy THEN quote_amount ELSE -quote_amount END
+			FROM exchange_trades WHERE exchange_id = @exchange
+		), daily AS (
+			SELECT DATE(to_timestamp(block_timestamp / 1000)) AS day,
+				SUM(first_delta) AS first_delta, SUM(second_delta) AS second_delta
+			FROM changes
+			GROUP BY 1
+		), balances AS (
+			SELECT day,
+				SUM(first_delta) OVER (ORDER BY day) AS first_token_balance,
+				SUM(second_delta) OVER (ORDER BY day) AS second_token_balance
+			FROM daily
+		)
+		SELECT TO_CHAR(day, 'YYYY-MM-DD') AS date, first_token_balance, second_token_balance
+		FROM balances
+		WHERE day >= DATE(to_timestamp(@from / 1000)) AND day <= DATE(to_timestamp(@to / 1000))
+		ORDER BY day
+	`, map[string]interface{}{
+		"exchange": exchangeID,
+		"buy":      models.TradeSideBuy,
+		"from":     fromTime,
+		"to":       toTime,
+	}).Scan(&points).Error
+	return points, err
+}
@@ -0,0 +1,193 @@
+package repository
+
+import (
+	"database/sql"
+
+	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
+	"gorm.io/gorm"
+)
+
+// GovernanceRepository struct: Repository for proposals, proposal approvals and chain parameter changes
+type GovernanceRepository struct {
+	db *gorm.DB
+}
+
+// NewGovernanceRepository function: Creates a new governance repository
+func NewGovernanceRepository(db *gorm.DB) *GovernanceRepository {
+	return &GovernanceRepository{db: db}
+}
+
+// SaveProposal function: Saves a proposal
+func (r *GovernanceRepository) SaveProposal(proposal *models.GovernanceProposal) error {
+	return r.db.Save(proposal).Error
+}
+
+// GetProposal function: Gets a proposal by ID
+func (r *GovernanceRepository) GetProposal(proposalID int64) (*models.GovernanceProposal, error) {
+	var proposal models.GovernanceProposal
+	err := r.db.Where("proposal_id = ?", proposalID).First(&proposal).Error
+	return &proposal, err
+}
+
+// GetLatestProposalID function: Gets the highest indexed proposal ID, false when none is indexed
+func (r *GovernanceRepository) GetLatestProposalID() (int64, bool, error) {
+	var latest sql.NullInt64
+	if err := r.db.Model(&models.GovernanceProposal{}).Select("MAX(proposal_id)").Scan(&latest).Error; err != nil {
+		return 0, false, err
+	}
+	return latest.Int64, latest.Valid, nil
+}
+
+// SaveApproval function: Saves an approval and updates the approval count of its proposal
+func (r *GovernanceRepository) SaveApproval(approval *models.ProposalApproval) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(approval).Error; err != nil {
+			return err
+		}
+		delta := 1
+		if !approval.Approve {
+			delta = -1
+		}
+		return tx.Model(&models.GovernanceProposal{}).
+			Where("proposal_id = ?", approval.ProposalID).
+			Update("approval_count", gorm.Expr("approval_count + ?", delta)).Error
+	})
+}
+
+// ResolveProposal function: Sets the final state of a proposal
+func (r *GovernanceRepository) ResolveProposal(proposalID int64, state string, blockNumber, resolvedAt int64) error {
+	return r.db.Model(&models.GovernanceProposal{}).
+		Where("proposal_id = ?", proposalID).
+		Updates(map[string]interface{}{
+			"state":          state,
+			"resolved_block": blockNumber,
+			"resolved_at":    resolvedAt,
+		}).Error
+}
+
+// GetExpiredPendingProposals function: Retrieves the pending proposals that expired before a timestamp
+func (r *GovernanceRepository) GetExpiredPendingProposals(before int64) ([]*models.GovernanceProposal, error) {
+	var proposals []*models.GovernanceProposal
+	err := r.db.Where("state = ? AND expiration_time <= ?", models.ProposalStatePending, before).
+		Order("proposal_id").
+		Find(&proposals).Error
+	return proposals, err
+}
+
+// GetProposals function: Retrieves proposals, newest first, optionally of one proposer
+func (r *GovernanceRepository) GetProposals(proposer string, offset, limit int) ([]*models.GovernanceProposal, int64, error) {
+	var proposals []*models.GovernanceProposal
+	var total int64
+
+	query := r.db.Model(&models.GovernanceProposal{})
+	if proposer != "" {
+		query = query.Where("proposer = ?", proposer)
+	}
+
+	// Count total
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if err := query.Order("proposal_id DESC").Offset(offset).Limit(limit).Find(&proposals).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return proposals, total, nil
+}
+
+// GetApprovals function: Retrieves the approvals cast for proposals, oldest first
+func (r *GovernanceRepository) GetApprovals(proposalIDs []int64) ([]*models.ProposalApproval, error) {
+	var approvals []*models.ProposalApproval
+	if len(proposalIDs) == 0 {
+		return approvals, nil
+	}
+	err := r.db.Where("proposal_id IN ?", proposalIDs).
+		Order("block_number, id").
+		Find(&approvals).Error
+	return approvals, err
+}
+
+// SaveParameterChanges function: Saves chain parameter changes
+func (r *GovernanceRepository) SaveParameterChanges(changes []*models.ChainParameterChange) error {
+	if len(changes) == 0 {
+		return nil
+	}
+	return r.db.Create(&changes).Error
+}
+
+// GetLatestParameters function: Gets the last observed value of every chain parameter
+func (r *GovernanceRepository) GetLatestParameters() (map[string]int64, error) {
+	var changes []*models.ChainParameterChange
+	err := r.db.Raw(`
+		SELECT DISTINCT ON (key) *
+		FROM chain_parameter_changes
+		ORDER BY key, block_number DESC, id DESC
+	`).Scan(&changes).Error
+	if err != nil {
+		return nil, err
+	}
+
+	params := make(map[string]int64, len(changes))
+	for _, change := range changes {
+		params[change.Key] = change.NewValue
+	}
+	return params, nil
+}
+
+// GetParameterHistory function: Retrieves chain parameter changes, newest first, optionally of one parameter
+func (r *GovernanceRepository) GetParameterHistory(key string, offset, limit int) ([]*models.ChainParameterChange, int64, error) {
+	var changes []*models.ChainParameterChange
+	var total int64
+
+	query := r.db.Model(&models.ChainParameterChange{})
+	if key != "" {
+		query = query.Where("key = ?", key)
+	}
+
+	// Count total
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if err := query.Order("block_number DESC, id DESC").Offset(offset).Limit(limit).Find(&changes).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return changes, total, nil
+}
+
+// DeleteFromBlock function: Removes the governance records of blocks at and above a height and
+// reopens proposals resolved in them
+func (r *GovernanceRepository) DeleteFromBlock(blockNumber int64) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("block_number >= ?", blockNumber).Delete(&models.GovernanceProposal{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("block_number >= ?", blockNumber).Delete(&models.ProposalApproval{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("block_number >= ?", blockNumber).Delete(&models.ChainParameterChange{}).Error; err != nil {
+			return err
+		}
+
+		// Recount approvals and reopen proposals
+		if err := tx.Exec(`
+			UPDATE governance_proposals p
+			SET approval_count = COALESCE((
+				SELECT SUM(CASE WHEN a.approve THEN 1 ELSE -1 END)
+				FROM proposal_approvals a
+				WHERE a.proposal_id = p.proposal_id
+			), 0)
+		`).Error; err != nil {
+			return err
+		}
+		return tx.Model(&models.GovernanceProposal{}).
+			Where("resolved_block >= ?", blockNumber).
+			Updates(map[string]interface{}{
+				"state":          models.ProposalStatePending,
+				"resolved_block": 0,
+				"resolved_at":    0,
+			}).Error
+	})
+}
@@ -0,0 +1,297 @@
+package repository
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// NFTRepository struct: Repository for LRC-721 and LRC-1155 collections, ownership and transfers
+type NFTRepository struct {
+	db *gorm.DB
+}
+
+// NewNFTRepository function: Creates a new NFT repository
+func NewNFTRepository(db *gorm.DB) *NFTRepository {
+	return &NFTRepository{db: db}
+}
+
+// SaveCollection function: Saves or updates an NFT collection
+func (r *NFTRepository) SaveCollection(collection *models.NFTCollection) error {
+	return r.db.Save(collection).Error
+}
+
+// GetCollection function: Retrieves an NFT collection by contract address
+func (r *NFTRepository) GetCollection(contract string) (*models.NFTCollection, error) {
+	var collection models.NFTCollection
+	err := r.db.Where("contract = ?", contract).First(&collection).Error
+	if err != nil {
+		return nil, err
+	}
+	return &collection, nil
+}
+
+// GetCollections function: Retrieves paginated NFT collections, optionally of one standard
+func (r *NFTRepository) GetCollections(standard string, offset, limit int) ([]*models.NFTCollection, int64, error) {
+	var collections []*models.NFTCollection
+	var total int64
+
+	query := r.db.Model(&models.NFTCollection{})
+	if standard != "" {
+		query = query.Where("standard = ?", standard)
+	}
+
+	// Count total
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if err := query.Order("transfers DESC").Offset(offset).Limit(limit).Find(&collections).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return collections, total, nil
+}
+
+// HasToken function: Checks whether a token id of a collection is already known
+func (r *NFTRepository) HasToken(contract, tokenID string) (bool, error) {
+	var count int64
+	err := r.db.Model(&models.NFTToken{}).
+		Where("contract = ? AND token_id = ?", contract, tokenID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// CreateToken function: Saves a newly seen token and counts it on its collection
+func (r *NFTRepository) CreateToken(token *models.NFTToken) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(token).Error; err != nil {
+			return err
+		}
+		return tx.Model(&models.NFTCollection{}).
+			Where("contract = ?", token.Contract).
+			Update("tokens", gorm.Expr("tokens + 1")).Error
+	})
+}
+
+// GetToken function: Retrieves a token of a collection
+func (r *NFTRepository) GetToken(contract, tokenID string) (*models.NFTToken, error) {
+	var token models.NFTToken
+	err := r.db.Where("contract = ? AND token_id = ?", contract, tokenID).First(&token).Error
+	if err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// ApplyTransfer function: Saves a transfer and moves the balance between its addresses.
+// untrackedAddress is the mint/burn address, which has no balance row. A transfer already saved
+// is not applied again.
+func (r *NFTRepository) ApplyTransfer(transfer *models.NFTTransfer, untrackedAddress string) error {
+	value, ok := new(big.Int).SetString(transfer.Value, 10)
+	if !ok {
+		return errors.New("invalid transfer value")
+	}
+
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(transfer)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		if err := moveNFTBalance(tx, transfer, value, untrackedAddress); err != nil {
+			return err
+		}
+		return tx.Model(&models.NFTCollection{}).
+			Where("contract = ?", transfer.Contract).
+			Update("transfers", gorm.Expr("transfers + 1")).Error
+	})
+}
+
+// RevertTransfersFromBlock function: Removes the NFT transfers of blocks at and above a height
+// and moves their balances back, then removes the tokens and collections first seen in them
+func (r *NFTRepository) RevertTransfersFromBlock(blockNumber int64, untrackedAddress string) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var transfers []*models.NFTTransfer
+		if err := tx.Where("block_number >= ?", blockNumber).
+			Order("block_number DESC, log_index DESC, id DESC").
+			Find(&transfers).Error; err != nil {
+			return err
+		}
+
+		for _, transfer := range transfers {
+			value, ok := new(big.Int).SetString(transfer.Value, 10)
+			if !ok {
+				continue
+			}
+			if err := moveNFTBalance(tx, transfer, new(big.Int).Neg(value), untrackedAddress); err != nil {
+				return err
+			}
+			if err := tx.Model(&models.NFTCollection{}).
+				Where("contract = ?", transfer.Contract).
+				Update("transfers", gorm.Expr("transfers - 1")).Error; err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Where("block_number >= ?", blockNumber).Delete(&models.NFTTransfer{}).Error; err != nil {
+			return err
+		}
+
+		// Uncount the removed tokens from collections that stay
+		var counts []struct {
+			Contract string
+			Tokens   int64
+		}
+		if err := tx.Model(&models.NFTToken{}).
+			Select("contract, COUNT(*) AS tokens").
+			Where("block_number >= ?", blockNumber).
+			Group("contract").
+			Scan(&counts).Error; err != nil {
+			return err
+		}
+		for _, count := range counts {
+			if err := tx.Model(&models.NFTCollection{}).
+				Where("contract = ?", count.Contract).
+				Update("tokens", gorm.Expr("tokens - ?", count.Tokens)).Error; err != nil {
+				return err
+			}
+		}
+		if err := tx.Where("block_number >= ?", blockNumber).Delete(&models.NFTToken{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("block_number >= ?", blockNumber).Delete(&models.NFTCollection{}).Error
+	})
+}
+
+// moveNFTBalance moves value from the sender to the recipient of a transfer,
+// a negative value moves it back
+func moveNFTBalance(db *gorm.DB, transfer *models.NFTTransfer, value *big.Int, untrackedAddress string) error {
+	if transfer.From != untrackedAddress {
+		if err := updateNFTBalance(db, transfer.Contract, transfer.TokenID, transfer.From, new(big.Int).Neg(value)); err != nil {
+			return err
+		}
+	}
+	if transfer.To != untrackedAddress {
+		if err := updateNFTBalance(db, transfer.Contract, transfer.TokenID, transfer.To, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateNFTBalance adds delta to the balance of an owner, removing the row once it is zero.
+// Balances predating the indexed range are unknown, so a debit can leave a negative balance;
+// it is kept rather than dropped so that reverting the transfer restores the row exactly.
+func updateNFTBalance(db *gorm.DB, contract, tokenID, owner string, delta *big.Int) error {
+	var balance models.NFTBalance
+	err := db.Where("contract = ? AND token_id = ? AND owner = ?", contract, tokenID, owner).First(&balance).Error
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+		if delta.Sign() == 0 {
+			return nil
+		}
+		balance = models.NFTBalance{
+			Contract: contract,
+			TokenID:  tokenID,
+			Owner:    owner,
+			Balance:  delta.String(),
+		}
+		return db.Create(&balance).Error
+	}
+
+	current, ok := new(big.Int).SetString(balance.Balance, 10)
+	if !ok {
+		return errors.New("invalid balance format")
+	}
+	current.Add(current, delta)
+	if current.Sign() == 0 {
+		return db.Delete(&balance).Error
+	}
+	balance.Balance = current.String()
+	return db.Save(&balance).Error
+}
+
+// GetTokensByOwner function: Retrieves the tokens held by an address, optionally in one collection
+func (r *NFTRepository) GetTokensByOwner(owner, contract string, offset, limit int) ([]*models.NFTOwnedToken, int64, error) {
+	var tokens []*models.NFTOwnedToken
+	var total int64
+
+	query := r.db.Table("nft_balances AS b").
+		Joins("LEFT JOIN nft_collections AS c ON c.contract = b.contract").
+		Joins("LEFT JOIN nft_tokens AS t ON t.contract = b.contract AND t.token_id = b.token_id").
+		Where("b.owner = ? AND b.balance NOT LIKE '-%'", owner)
+	if contract != "" {
+		query = query.Where("b.contract = ?", contract)
+	}
+
+	// Count total
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.Select("b.contract, c.standard, c.name, c.symbol, b.token_id, b.balance, t.uri").
+		Order("b.updated_at DESC").
+		Offset(offset).Limit(limit).
+		Scan(&tokens).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return tokens, total, nil
+}
+
+// GetTokenOwners function: Retrieves the holders of a token id
+func (r *NFTRepository) GetTokenOwners(contract, tokenID string, offset, limit int) ([]*models.NFTBalance, int64, error) {
+	var owners []*models.NFTBalance
+	var total int64
+
+	query := r.db.Model(&models.NFTBalance{}).
+		Where("contract = ? AND token_id = ? AND balance NOT LIKE '-%'", contract, tokenID)
+
+	// Count total
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if err := query.Order("updated_at DESC").Offset(offset).Limit(limit).Find(&owners).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return owners, total, nil
+}
+
+// GetTransfers function: Retrieves NFT transfers filtered by collection, token id and address
+func (r *NFTRepository) GetTransfers(contract, tokenID, address string, offset, limit int) ([]*models.NFTTransfer, int64, error) {
+	var transfers []*models.NFTTransfer
+	var total int64
+
+	query := r.db.Model(&models.NFTTransfer{})
+	if contract != "" {
+		query = query.Where("contract = ?", contract)
+	}
+	if tokenID != "" {
+		query = query.Where("token_id = ?", tokenID)
+	}
+	if address != "" {
+		query = query.Where("(\"from\" = ? OR \"to\" = ?)", address, address)
+	}
+
+	// Count total
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if err := query.Order("block_number DESC, log_index DESC").Offset(offset).Limit(limit).Find(&transfers).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return transfers, total, nil
+}
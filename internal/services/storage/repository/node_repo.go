@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// NodeRepository struct: Repository for node reports and the GeoIP lookup cache
+type NodeRepository struct {
+	db *gorm.DB
+}
+
+// NewNodeRepository function: Creates a new node repository
+func NewNodeRepository(db *gorm.DB) *NodeRepository {
+	return &NodeRepository{db: db}
+}
+
+// SaveTelemetry function: Saves a telemetry upload as the latest report of its node and in its history
+func (r *NodeRepository) SaveTelemetry(report *models.NodeReport, sample *models.NodeTelemetry) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(report).Error; err != nil {
+			return err
+		}
+		return tx.Create(sample).Error
+	})
+}
+
+// GetNodeReports function: Retrieves the reports of nodes that reported since a time
+func (r *NodeRepository) GetNodeReports(since time.Time) ([]*models.NodeReport, error) {
+	var reports []*models.NodeReport
+	err := r.db.Where("reported_at >= ?", since).Order("host").Find(&reports).Error
+	return reports, err
+}
+
+// GetVersionDistribution function: Counts the nodes that reported since a time by version, most common first
+func (r *NodeRepository) GetVersionDistribution(since time.Time) ([]*models.NodeVersionCount, error) {
+	var counts []*models.NodeVersionCount
+	err := r.db.Model(&models.NodeReport{}).
+		Select("version, COUNT(*) AS count, COUNT(*) * 100.0 / SUM(COUNT(*)) OVER () AS percentage").
+		Where("reported_at >= ?", since).
+		Group("version").
+		Order("count DESC, version DESC").
+		Scan(&counts).Error
+	return counts, err
+}
+
+// GetLaggingNodes function: Retrieves the nodes that reported since a time a block height
+// more than threshold blocks behind head, furthest behind first
+func (r *NodeRepository) GetLaggingNodes(head, threshold int64, since time.Time) ([]*models.NodeReport, error) {
+	var reports []*models.NodeReport
+	err := r.db.Where("reported_at >= ? AND block_height < ?", since, head-threshold).
+		Order("block_height, host").
+		Find(&reports).Error
+	return reports, err
+}
+
+// GetTelemetryHistory function: Retrieves the telemetry uploads of a node between two times, newest first
+func (r *NodeRepository) GetTelemetryHistory(host string, from, to time.Time, offset, limit int) ([]*models.NodeTelemetry, int64, error) {
+	var samples []*models.NodeTelemetry
+	var total int64
+
+	query := r.db.Model(&models.NodeTelemetry{}).
+		Where("host = ? AND reported_at >= ? AND reported_at <= ?", host, from, to)
+
+	// Count total
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if err := query.Order("reported_at DESC").Offset(offset).Limit(limit).Find(&samples).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return samples, total, nil
+}
+
+// DeleteTelemetryBefore function: Removes telemetry uploads and node reports older than a time
+func (r *NodeRepository) DeleteTelemetryBefore(before time.Time) (int64, error) {
+	var deleted int64
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("reported_at < ?", before).Delete(&models.NodeTelemetry{})
+		if result.Error != nil {
+			return result.Error
+		}
+		deleted = result.RowsAffected
+		return tx.Where("reported_at < ?", before).Delete(&models.NodeReport{}).Error
+	})
+	return deleted, err
+}
+
+// GetGeoIPRecords function: Gets the cached GeoIP records of IP addresses, keyed by IP
+func (r *NodeRepository) GetGeoIPRecords(ips []string) (map[string]*models.GeoIPRecord, error) {
+	records := make(map[string]*models.GeoIPRecord, len(ips))
+	if len(ips) == 0 {
+		return records, nil
+	}
+
+	var rows []*models.GeoIPRecord
+	if err := r.db.Where("ip IN ?", ips).Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		records[row.IP] = row
+	}
+	return records, nil
+}
+
+// SaveGeoIPRecords function: Saves GeoIP records, replacing cached ones
+func (r *NodeRepository) SaveGeoIPRecords(records []*models.GeoIPRecord) error {
+	if len(records) == 0 {
+		return nil
+	}
+	return r.db.Clauses(clause.OnConflict{UpdateAll: true}).Create(&records).Error
+}
@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
+	"gorm.io/gorm"
+)
+
+// likeEscaper escapes the LIKE wildcards of a search query
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// SearchRepository struct: Repository for the name searches of the explorer. Names match when they
+// start with the query, case insensitively, or when their pg_trgm similarity to the query reaches
+// a minimum. Both use the trigram indexes of the searched columns.
+type SearchRepository struct {
+	db *gorm.DB
+}
+
+// NewSearchRepository function: Creates a new search repository
+func NewSearchRepository(db *gorm.DB) *SearchRepository {
+	return &SearchRepository{db: db}
+}
+
+// SearchTokens function: Searches LRC-20 tokens by name or symbol and LRC-10 tokens by name, symbol
+// or ID. A minSimilarity of 0 only matches prefixes.
+func (r *SearchRepository) SearchTokens(ctx context.Context, query string, minSimilarity float64, limit int) ([]*models.SearchMatch, error) {
+	fuzzy := minSimilarity > 0
+	return r.search(ctx, models.SearchTypeToken, minSimilarity, `
+		SELECT t.contract AS id, t.name, t.symbol, 'LRC-20' AS standard,
+			GREATEST(similarity(t.name, @query), similarity(t.symbol, @query)) AS similarity,
+			(t.name ILIKE @prefix OR t.symbol ILIKE @prefix) AS prefix,
+			t.holders AS popularity, COALESCE(c.verified, FALSE) AS verified
+		FROM lrc20_token_infos t
+		LEFT JOIN contracts c ON c.address = t.contract
+		WHERE `+nameMatch(fuzzy, "t.name", "t.symbol")+`
+		UNION ALL
+		SELECT i.id, i.name, i.symbol, 'LRC-10',
+			CASE WHEN i.id = @query THEN 1
+				ELSE GREATEST(similarity(i.name, @query), similarity(i.symbol, @query)) END,
+			(i.id = @query OR i.name ILIKE @prefix OR i.symbol ILIKE @prefix),
+			i.holders, FALSE
+		FROM token_infos i
+		WHERE i.id = @query OR `+nameMatch(fuzzy, "i.name", "i.symbol")+`
+		ORDER BY prefix DESC, similarity DESC, popularity DESC
+		LIMIT @limit
+	`, query, limit)
+}
+
+// SearchContracts function: Searches deployed contracts by name
+func (r *SearchRepository) SearchContracts(ctx context.Context, query string, minSimilarity float64, limit int) ([]*models.SearchMatch, error) {
+	return r.search(ctx, models.SearchTypeContract, minSimilarity, `
+		SELECT c.address AS id, c.name, similarity(c.name, @query) AS similarity,
+			c.name ILIKE @prefix AS prefix, COALESCE(t.holders, 0) AS popularity, c.verified
+		FROM contracts c
+		LEFT JOIN lrc20_token_infos t ON t.contract = c.address
+		WHERE c.name <> '' AND `+nameMatch(minSimilarity > 0, "c.name")+`
+		ORDER BY prefix DESC, similarity DESC, popularity DESC
+		LIMIT @limit
+	`, query, limit)
+}
+
+// SearchTags function: Searches approved address tags, curator tags count as verified
+func (r *SearchRepository) SearchTags(ctx context.Context, query string, minSimilarity float64, limit int) ([]*models.SearchMatch, error) {
+	return r.search(ctx, models.SearchTypeTag, minSimilarity, `
+		SELECT address AS id, tag AS name, similarity(tag, @query) AS similarity,
+			tag ILIKE @prefix AS prefix, votes AS popularity, curated AS verified
+		FROM tags
+		WHERE status = 'approved' AND `+nameMatch(minSimilarity > 0, "tag")+`
+		ORDER BY prefix DESC, similarity DESC, popularity DESC
+		LIMIT @limit
+	`, query, limit)
+}
+
+// SearchAccounts function: Searches accounts by account name
+func (r *SearchRepository) SearchAccounts(ctx context.Context, query string, minSimilarity float64, limit int) ([]*models.SearchMatch, error) {
+	return r.search(ctx, models.SearchTypeAddress, minSimilarity, `
+		SELECT address AS id, account_name AS name, similarity(account_name, @query) AS similarity,
+			account_name ILIKE @prefix AS prefix
+		FROM accounts
+		WHERE account_name <> '' AND `+nameMatch(minSimilarity > 0, "account_name")+`
+		ORDER BY prefix DESC, similarity DESC, balance DESC
+		LIMIT @limit
+	`, query, limit)
+}
+
+// search runs a name search with the fuzzy match threshold set for its transaction only
+func (r *SearchRepository) search(ctx context.Context, searchType string, minSimilarity float64, sql, query string, limit int) ([]*models.SearchMatch, error) {
+	var matches []*models.SearchMatch
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if minSimilarity > 0 {
+			threshold := strconv.FormatFloat(minSimilarity, 'f', -1, 64)
+			if err := tx.Exec("SELECT set_config('pg_trgm.similarity_threshold', ?, true)", threshold).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Raw(sql, map[string]interface{}{
+			"query":  query,
+			"prefix": likeEscaper.Replace(query) + "%",
+			"limit":  limit,
+		}).Scan(&matches).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, match := range matches {
+		match.Type = searchType
+	}
+	return matches, nil
+}
+
+// nameMatch returns the condition on columns starting with the query or, when fuzzy,
+// resembling it
+func nameMatch(fuzzy bool, columns ...string) string {
+	conditions := make([]string, 0, 2*len(columns))
+	for _, column := range columns {
+		conditions = append(conditions, column+" ILIKE @prefix")
+		if fuzzy {
+			conditions = append(conditions, column+" % @query")
+		}
+	}
+	return "(" + strings.Join(conditions, " OR ") + ")"
+}
@@ -0,0 +1,172 @@
+package repository
+
+import (
+	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// stakingTimelineQuery lists the staking, delegation, voting and reward
+// actions of @address as StakingTimelineEntry rows
+const stakingTimelineQuery = `
+	SELECT transaction_id, block_number, block_timestamp, action, resource, amount,
+	       '' AS counterparty, FALSE AS incoming
+	FROM stake_actions WHERE owner = @address
+	UNION ALL
+	SELECT transaction_id, block_number, block_timestamp, action, resource, amount,
+	       receiver AS counterparty, FALSE AS incoming
+	FROM delegation_actions WHERE owner = @address
+	UNION ALL
+	SELECT transaction_id, block_number, block_timestamp, action, resource, amount,
+	       owner AS counterparty, TRUE AS incoming
+	FROM delegation_actions WHERE receiver = @address
+	UNION ALL
+	SELECT transaction_id, block_number, block_timestamp, 'vote' AS action, '' AS resource, votes AS amount,
+	       witness AS counterparty, FALSE AS incoming
+	FROM vote_actions WHERE voter = @address
+	UNION ALL
+	SELECT transaction_id, block_number, block_timestamp, 'withdraw_reward' AS action, '' AS resource, amount,
+	       '' AS counterparty, FALSE AS incoming
+	FROM reward_withdrawals WHERE owner = @address`
+
+// StakingRepository struct: Repository for staking, delegation, voting and reward withdrawal actions
+type StakingRepository struct {
+	db *gorm.DB
+}
+
+// NewStakingRepository function: Creates a new staking repository
+func NewStakingRepository(db *gorm.DB) *StakingRepository {
+	return &StakingRepository{db: db}
+}
+
+// SaveStakeAction function: Saves a freeze or unfreeze action, keeping the one already saved
+// for its transaction
+func (r *StakingRepository) SaveStakeAction(action *models.StakeAction) error {
+	return r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(action).Error
+}
+
+// SaveDelegationAction function: Saves a delegate or undelegate action, keeping the one already
+// saved for its transaction
+func (r *StakingRepository) SaveDelegationAction(action *models.DelegationAction) error {
+	return r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(action).Error
+}
+
+// SaveVoteActions function: Saves the votes cast by one transaction, unless they are already saved
+func (r *StakingRepository) SaveVoteActions(votes []*models.VoteAction) error {
+	if len(votes) == 0 {
+		return nil
+	}
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var count int64
+		if err := tx.Model(&models.VoteAction{}).
+			Where("transaction_id = ?", votes[0].TransactionID).
+			Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return nil
+		}
+		return tx.Create(&votes).Error
+	})
+}
+
+// SaveRewardWithdrawal function: Saves a reward withdrawal, keeping the one already saved for
+// its transaction
+func (r *StakingRepository) SaveRewardWithdrawal(withdrawal *models.RewardWithdrawal) error {
+	return r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(withdrawal).Error
+}
+
+// DeleteFromBlock function: Removes the actions of blocks at and above a height
+func (r *StakingRepository) DeleteFromBlock(blockNumber int64) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		for _, model := range []interface{}{
+			&models.StakeAction{},
+			&models.DelegationAction{},
+			&models.VoteAction{},
+			&models.RewardWithdrawal{},
+		} {
+			if err := tx.Where("block_number >= ?", blockNumber).Delete(model).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// GetTimeline function: Retrieves the staking timeline of an address, newest first
+func (r *StakingRepository) GetTimeline(address string, offset, limit int) ([]*models.StakingTimelineEntry, int64, error) {
+	var entries []*models.StakingTimelineEntry
+	var total int64
+	args := map[string]interface{}{"address": address}
+
+	// Count total
+	if err := r.db.Raw("SELECT COUNT(*) FROM ("+stakingTimelineQuery+") AS timeline", args).Scan(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := r.db.Raw(stakingTimelineQuery+`
+		ORDER BY block_number DESC, transaction_id
+		OFFSET @offset LIMIT @limit`, map[string]interface{}{
+		"address": address,
+		"offset":  offset,
+		"limit":   limit,
+	}).Scan(&entries).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return entries, total, nil
+}
+
+// GetWitnessVoters function: Retrieves the votes cast for a witness, newest first
+func (r *StakingRepository) GetWitnessVoters(witness string, offset, limit int) ([]*models.VoteAction, int64, error) {
+	var votes []*models.VoteAction
+	var total int64
+
+	query := r.db.Model(&models.VoteAction{}).Where("witness = ?", witness)
+
+	// Count total
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if err := query.Order("block_number DESC, id DESC").Offset(offset).Limit(limit).Find(&votes).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return votes, total, nil
+}
+
+// GetDelegatedResourceHistory function: Retrieves the daily amounts delegated and undelegated for a
+// resource between two millisecond timestamps, with the running total delegated at the end of each day
+func (r *StakingRepository) GetDelegatedResourceHistory(resource string, fromTime, toTime int64) ([]*models.DelegatedResourcePoint, error) {
+	var opening int64
+	if err := r.db.Model(&models.DelegationAction{}).
+		Where("resource = ? AND block_timestamp < ?", resource, fromTime).
+		Select("COALESCE(SUM(CASE WHEN action = ? THEN amount ELSE -amount END), 0)", models.StakeActionDelegate).
+		Scan(&opening).Error; err != nil {
+		return nil, err
+	}
+
+	var points []*models.DelegatedResourcePoint
+	err := r.db.Raw(`
+		SELECT
+			TO_CHAR(DATE(to_timestamp(block_timestamp / 1000)), 'YYYY-MM-DD') AS date,
+			SUM(CASE WHEN action = ? THEN amount ELSE 0 END) AS delegated,
+			SUM(CASE WHEN action = ? THEN amount ELSE 0 END) AS undelegated
+		FROM delegation_actions
+		WHERE resource = ? AND block_timestamp >= ? AND block_timestamp <= ?
+		GROUP BY 1
+		ORDER BY 1
+	`, models.StakeActionDelegate, models.StakeActionUndelegate, resource, fromTime, toTime).Scan(&points).Error
+	if err != nil {
+		return nil, err
+	}
+
+	total := opening
+	for _, point := range points {
+		total += point.Delegated - point.Undelegated
+		point.Total = total
+	}
+	return points, nil
+}
@@ -1,259 +1,779 @@
-package repository
-
-import (
-	"encoding/json"
-	
-	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
-	"gorm.io/gorm"
-)
-
-// StatsRepository struct: Repository for stats operations
-type StatsRepository struct {
-	db *gorm.DB
-}
-
-// NewStatsRepository function: Creates a new stats repository
-func NewStatsRepository(db *gorm.DB) *StatsRepository {
-	return &StatsRepository{db: db}
-}
-
-// SaveStatistic function: Saves a statistic
-func (r *StatsRepository) SaveStatistic(statType string, value interface{}, timestamp int64) error {
-	data, err := json.Marshal(value)
-	if err != nil {
-		return err
-	}
-
-	stat := &models.Statistic{
-		Type:      statType,
-		Value:     data,
-		Timestamp: timestamp,
-	}
-
-	return r.db.Save(stat).Error
-}
-
-// GetStatistic function: Retrieves the latest statistic of a type
-func (r *StatsRepository) GetStatistic(statType string) (json.RawMessage, error) {
-	var stat models.Statistic
-	err := r.db.Where("type = ?", statType).
-		Order("timestamp DESC").
-		First(&stat).Error
-	if err != nil {
-		return nil, err
-	}
-	return stat.Value, nil
-}
-
-// GetStatisticsInRange function: Retrieves statistics in a time range
-func (r *StatsRepository) GetStatisticsInRange(statType string, fromTime, toTime int64) ([]json.RawMessage, error) {
-	var stats []models.Statistic
-	err := r.db.Where("type = ? AND timestamp >= ? AND timestamp <= ?",
-		statType, fromTime, toTime).
-		Order("timestamp ASC").
-		Find(&stats).Error
-	if err != nil {
-		return nil, err
-	}
-
-	var results []json.RawMessage
-	for _, stat := range stats {
-		results = append(results, stat.Value)
-	}
-	return results, nil
-}
-
-// GetHomepageBundle function: Retrieves homepage bundle data
-func (r *StatsRepository) GetHomepageBundle() (*models.HomepageBundleResponse, error) {
-	var bundle models.HomepageBundleResponse
-	
-	// Get total blocks
-	r.db.Raw("SELECT COUNT(*) FROM blocks").Scan(&bundle.TotalBlocks)
-	
-	// Get total transactions
-	r.db.Raw("SELECT COUNT(*) FROM transactions").Scan(&bundle.TotalTransactions)
-	
-	// Get total accounts
-	r.db.Raw("SELECT COUNT(*) FROM accounts").Scan(&bundle.TotalAccounts)
-	
-	// Get recent blocks
-	r.db.Raw("SELECT * FROM blocks ORDER BY number DESC LIMIT 10").Scan(&bundle.RecentBlocks)
-	
-	// Get recent transactions
-	r.db.Raw("SELECT * FROM transactions ORDER BY block_timestamp DESC LIMIT 10").Scan(&bundle.RecentTransactions)
-	
-	return &bundle, nil
-}
-
-// GetOverview function: Retrieves overview statistics
-func (r *StatsRepository) GetOverview(statType string) (json.RawMessage, error) {
-	return r.GetStatistic("overview_" + statType)
-}
-
-// GetEnergyStatistic function: Retrieves energy statistics
-func (r *StatsRepository) GetEnergyStatistic(address string, fromTime, toTime int64) (*models.EnergyStatisticResponse, error) {
-	var stats models.EnergyStatisticResponse
-	stats.Address = address
-	
-	// Query from database
-	var daily []models.DailyStat
-	r.db.Raw(`
-		SELECT DATE(to_timestamp(timestamp)) as date, SUM(value) as value
-		FROM statistics
-		WHERE type = 'energy_usage' AND timestamp >= ? AND timestamp <= ?
-		GROUP BY DATE(to_timestamp(timestamp))
-		ORDER BY date
-	`, fromTime, toTime).Scan(&daily)
-	
-	stats.Daily = daily
-	return &stats, nil
-}
-
-// GetTriggerStatistic function: Retrieves trigger statistics
-func (r *StatsRepository) GetTriggerStatistic(contract string, fromTime, toTime int64) (*models.TriggerStatisticResponse, error) {
-	var stats models.TriggerStatisticResponse
-	stats.Contract = contract
-	
-	var daily []models.DailyStat
-	r.db.Raw(`
-		SELECT DATE(to_timestamp(timestamp)) as date, COUNT(*) as value
-		FROM transactions
-		WHERE contract_address = ? AND block_timestamp >= ? AND block_timestamp <= ?
-		GROUP BY DATE(to_timestamp(block_timestamp))
-		ORDER BY date
-	`, contract, fromTime, toTime).Scan(&daily)
-	
-	stats.Daily = daily
-	stats.Count = int64(len(daily))
-	
-	return &stats, nil
-}
-
-// GetCallerAddressStatistic function: Retrieves caller statistics
-func (r *StatsRepository) GetCallerAddressStatistic(contract string, fromTime, toTime int64) (*models.CallerAddressStatisticResponse, error) {
-	var stats models.CallerAddressStatisticResponse
-	stats.Contract = contract
-	
-	var callers []models.CallerStat
-	r.db.Raw(`
-		SELECT from_address as address, COUNT(*) as count
-		FROM transactions
-		WHERE contract_address = ? AND block_timestamp >= ? AND block_timestamp <= ?
-		GROUP BY from_address
-		ORDER BY count DESC
-		LIMIT 100
-	`, contract, fromTime, toTime).Scan(&callers)
-	
-	stats.Callers = callers
-	return &stats, nil
-}
-
-// GetEnergyDailyStatistic function: Retrieves daily energy statistics
-func (r *StatsRepository) GetEnergyDailyStatistic(fromTime, toTime int64) (*models.EnergyDailyStatisticResponse, error) {
-	var stats models.EnergyDailyStatisticResponse
-	
-	var daily []models.EnergyDailyStat
-	r.db.Raw(`
-		SELECT 
-			DATE(to_timestamp(timestamp)) as date,
-			SUM((value->>'energy_usage')::int) as energy_usage,
-			SUM((value->>'energy_fee')::int) as energy_fee
-		FROM statistics
-		WHERE type = 'energy_daily' AND timestamp >= ? AND timestamp <= ?
-		GROUP BY DATE(to_timestamp(timestamp))
-		ORDER BY date
-	`, fromTime, toTime).Scan(&daily)
-	
-	stats.Daily = daily
-	return &stats, nil
-}
-
-// GetFreezeResource function: Retrieves freeze resource information
-func (r *StatsRepository) GetFreezeResource(address string, resourceType string) (*models.FreezeResourceResponse, error) {
-	var response models.FreezeResourceResponse
-	
-	// Get frozen records
-	r.db.Raw(`
-		SELECT amount, expire_at, type
-		FROM frozen_records
-		WHERE address = ? AND (? = '' OR type = ?)
-		ORDER BY expire_at
-	`, address, resourceType, resourceType).Scan(&response.Frozen)
-	
-	// Get delegated records
-	r.db.Raw(`
-		SELECT from_address, to_address, amount, expire_at, type
-		FROM delegated_records
-		WHERE to_address = ? AND (? = '' OR type = ?)
-		ORDER BY expire_at
-	`, address, resourceType, resourceType).Scan(&response.Delegated)
-	
-	return &response, nil
-}
-
-// GetTurnover function: Retrieves turnover statistics
-func (r *StatsRepository) GetTurnover(fromTime, toTime int64) (*models.TurnoverResponse, error) {
-	var response models.TurnoverResponse
-	
-	// Get total
-	r.db.Raw(`
-		SELECT COALESCE(SUM(amount), 0) as total
-		FROM transactions
-		WHERE block_timestamp >= ? AND block_timestamp <= ?
-	`, fromTime, toTime).Scan(&response.Total)
-	
-	// Get daily
-	var daily []models.DailyTurnover
-	r.db.Raw(`
-		SELECT 
-			DATE(to_timestamp(block_timestamp)) as date,
-			SUM(amount) as turnover
-		FROM transactions
-		WHERE block_timestamp >= ? AND block_timestamp <= ?
-		GROUP BY DATE(to_timestamp(block_timestamp))
-		ORDER BY date
-	`, fromTime, toTime).Scan(&daily)
-	
-	response.Daily = daily
-	return &response, nil
-}
-
-// GetLindHolders function: Retrieves LIND holders
-func (r *StatsRepository) GetLindHolders(offset, limit int) (*models.LindHolderResponse, error) {
-	var response models.LindHolderResponse
-	
-	// Get total
-	r.db.Raw("SELECT COUNT(*) FROM accounts WHERE balance > 0").Scan(&response.Total)
-	
-	// Get holders
-	r.db.Raw(`
-		SELECT address, balance, 
-		ROW_NUMBER() OVER (ORDER BY balance DESC) as rank
-		FROM accounts
-		WHERE balance > 0
-		ORDER BY balance DESC
-		OFFSET ? LIMIT ?
-	`, offset, limit).Scan(&response.Holders)
-	
-	return &response, nil
-}
-
-// GetTopAccounts function: Retrieves top accounts
-func (r *StatsRepository) GetTopAccounts(limit int) ([]models.AccountResponse, error) {
-    var accounts []models.AccountResponse
-    err := r.db.Model(&models.Account{}).
-        Order("balance DESC").
-        Limit(limit).
-        Find(&accounts).Error
-    return accounts, err
-}
-
-// GetTopTokens function: Retrieves top tokens
-func (r *StatsRepository) GetTopTokens(limit int) ([]models.LRC20TokenInfo, error) {
-    var tokens []models.LRC20TokenInfo
-    err := r.db.Model(&models.LRC20TokenInfo{}).
-        Order("holders DESC").
-        Limit(limit).
-        Find(&tokens).Error
-    return tokens, err
+package repository
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	
+	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Activity queries group rows into @interval millisecond buckets starting at
+// @from, each fills some of the columns of activityRow
+const (
+	transactionActivityQuery = `
+		SELECT (block_timestamp - @from) / @interval * @interval + @from AS timestamp,
+			COUNT(*) AS transactions,
+			COUNT(DISTINCT from_address) AS active_accounts,
+			COALESCE(SUM(fee), 0) AS fees_burned,
+			COALESCE(SUM(energy_used), 0) AS energy_used,
+			COALESCE(SUM(net_usage), 0) AS net_used
+		FROM transactions
+		WHERE block_timestamp >= @from AND block_timestamp < @to
+		GROUP BY 1`
+
+	blockActivityQuery = `
+		SELECT (timestamp - @from) / @interval * @interval + @from AS timestamp, COUNT(*) AS blocks
+		FROM blocks
+		WHERE timestamp >= @from AND timestamp < @to
+		GROUP BY 1`
+
+	// An account is new in the bucket of its first balance change
+	newAccountQuery = `
+		SELECT (first_seen - @from) / @interval * @interval + @from AS timestamp, COUNT(*) AS new_accounts
+		FROM (
+			SELECT MIN(block_timestamp) AS first_seen
+			FROM balance_deltas
+			GROUP BY address
+			HAVING MIN(block_timestamp) >= @from AND MIN(block_timestamp) < @to
+		) AS accounts
+		GROUP BY 1`
+)
+
+// seriesVolumeTokens is the number of most transferred LRC-20 tokens whose volume is kept per bucket
+const seriesVolumeTokens = 10
+
+// activityRow is one bucket of an activity query
+type activityRow struct {
+	Timestamp      int64
+	Blocks         int64
+	Transactions   int64
+	NewAccounts    int64
+	ActiveAccounts int64
+	FeesBurned     int64
+	EnergyUsed     int64
+	NetUsed        int64
+}
+
+// StatsRepository struct: Repository for stats operations
+type StatsRepository struct {
+	db *gorm.DB
+}
+
+// NewStatsRepository function: Creates a new stats repository
+func NewStatsRepository(db *gorm.DB) *StatsRepository {
+	return &StatsRepository{db: db}
+}
+
+// SaveStatistic function: Saves a statistic
+func (r *StatsRepository) SaveStatistic(statType string, value interface{}, timestamp int64) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	stat := &models.Statistic{
+		Type:      statType,
+		Value:     data,
+		Timestamp: timestamp,
+	}
+
+	return r.db.Save(stat).Error
+}
+
+// ReplaceStatistic function: Saves a statistic in place of the stored ones of its type
+func (r *StatsRepository) ReplaceStatistic(statType string, value interface{}, timestamp int64) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("type = ?", statType).Delete(&models.Statistic{}).Error; err != nil {
+			return err
+		}
+		return tx.Create(&models.Statistic{
+			Type:      statType,
+			Value:     data,
+			Timestamp: timestamp,
+		}).Error
+	})
+}
+
+// SaveStatisticSeries function: Saves the buckets of a series in place of the stored ones of the
+// same type from the first to the last bucket
+func (r *StatsRepository) SaveStatisticSeries(statType string, buckets []*models.StatsBucket) error {
+	if len(buckets) == 0 {
+		return nil
+	}
+
+	stats := make([]*models.Statistic, 0, len(buckets))
+	for _, bucket := range buckets {
+		data, err := json.Marshal(bucket)
+		if err != nil {
+			return err
+		}
+		stats = append(stats, &models.Statistic{
+			Type:      statType,
+			Value:     data,
+			Timestamp: bucket.Timestamp,
+		})
+	}
+
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("type = ? AND timestamp >= ? AND timestamp <= ?",
+			statType, buckets[0].Timestamp, buckets[len(buckets)-1].Timestamp).
+			Delete(&models.Statistic{}).Error; err != nil {
+			return err
+		}
+		return tx.CreateInBatches(stats, 500).Error
+	})
+}
+
+// GetLatestStatisticTimestamp function: Gets the timestamp of the latest statistic of a type, 0 when there is none
+func (r *StatsRepository) GetLatestStatisticTimestamp(statType string) (int64, error) {
+	var timestamp int64
+	err := r.db.Model(&models.Statistic{}).
+		Select("COALESCE(MAX(timestamp), 0)").
+		Where("type = ?", statType).
+		Scan(&timestamp).Error
+	return timestamp, err
+}
+
+// GetStatisticSeries function: Retrieves the latest statistics of a type, oldest first
+func (r *StatsRepository) GetStatisticSeries(statType string, limit int) ([]json.RawMessage, error) {
+	var stats []models.Statistic
+	err := r.db.Where("type = ?", statType).
+		Order("timestamp DESC").
+		Limit(limit).
+		Find(&stats).Error
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]json.RawMessage, len(stats))
+	for i, stat := range stats {
+		results[len(stats)-1-i] = stat.Value
+	}
+	return results, nil
+}
+
+// GetFirstBlockTimestamp function: Gets the timestamp of the earliest indexed block, 0 when there is none
+func (r *StatsRepository) GetFirstBlockTimestamp() (int64, error) {
+	var timestamp int64
+	err := r.db.Raw("SELECT COALESCE(MIN(timestamp), 0) FROM blocks WHERE timestamp > 0").Scan(&timestamp).Error
+	return timestamp, err
+}
+
+// GetActivitySeries function: Computes the chain activity in interval buckets from a millisecond
+// timestamp up to another, including empty buckets
+func (r *StatsRepository) GetActivitySeries(interval, fromTime, toTime int64) ([]*models.StatsBucket, error) {
+	if interval <= 0 || toTime <= fromTime {
+		return nil, errors.New("invalid series range")
+	}
+	args := map[string]interface{}{
+		"interval": interval,
+		"from":     fromTime,
+		"to":       toTime,
+		"lind":     models.AssetLIND,
+	}
+
+	buckets := make([]*models.StatsBucket, 0, (toTime-fromTime+interval-1)/interval)
+	byTime := make(map[int64]*models.StatsBucket)
+	for t := fromTime; t < toTime; t += interval {
+		bucket := &models.StatsBucket{Timestamp: t}
+		buckets = append(buckets, bucket)
+		byTime[t] = bucket
+	}
+
+	// Get blocks, transactions, fees, resources and accounts
+	for _, query := range []string{blockActivityQuery, transactionActivityQuery, newAccountQuery} {
+		var rows []*activityRow
+		if err := r.db.Raw(query, args).Scan(&rows).Error; err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			bucket, ok := byTime[row.Timestamp]
+			if !ok {
+				continue
+			}
+			bucket.Blocks += row.Blocks
+			bucket.Transactions += row.Transactions
+			bucket.NewAccounts += row.NewAccounts
+			bucket.ActiveAccounts += row.ActiveAccounts
+			bucket.FeesBurned += row.FeesBurned
+			bucket.EnergyUsed += row.EnergyUsed
+			bucket.NetUsed += row.NetUsed
+		}
+	}
+
+	// Get LRC-20 transfers, most transferred tokens first
+	var transfers []struct {
+		Timestamp    int64
+		TokenAddress string
+		Transfers    int64
+		Volume       string
+	}
+	err := r.db.Raw(`
+		SELECT (t.block_timestamp - @from) / @interval * @interval + @from AS timestamp,
+			t.token_address, COUNT(*) AS transfers, SUM(t.value::numeric)::text AS volume
+		FROM token_transfer_responses t
+		JOIN lrc20_token_infos i ON i.contract = t.token_address
+		WHERE t.block_timestamp >= @from AND t.block_timestamp < @to
+		GROUP BY 1, 2
+		ORDER BY 1, 3 DESC
+	`, args).Scan(&transfers).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range transfers {
+		bucket, ok := byTime[row.Timestamp]
+		if !ok {
+			continue
+		}
+		bucket.LRC20Transfers += row.Transfers
+		if len(bucket.LRC20Volume) < seriesVolumeTokens {
+			if bucket.LRC20Volume == nil {
+				bucket.LRC20Volume = make(map[string]string)
+			}
+			bucket.LRC20Volume[row.TokenAddress] = row.Volume
+		}
+	}
+
+	// Get the LIND supply at the end of each bucket
+	var opening string
+	err = r.db.Raw(`
+		SELECT COALESCE(SUM(delta), 0)::text FROM balance_deltas
+		WHERE asset = @lind AND block_timestamp < @from
+	`, args).Scan(&opening).Error
+	if err != nil {
+		return nil, err
+	}
+	var changes []struct {
+		Timestamp int64
+		Delta     string
+	}
+	err = r.db.Raw(`
+		SELECT (block_timestamp - @from) / @interval * @interval + @from AS timestamp, SUM(delta)::text AS delta
+		FROM balance_deltas
+		WHERE asset = @lind AND block_timestamp >= @from AND block_timestamp < @to
+		GROUP BY 1
+	`, args).Scan(&changes).Error
+	if err != nil {
+		return nil, err
+	}
+
+	supply, ok := new(big.Int).SetString(opening, 10)
+	if !ok {
+		return nil, errors.New("invalid balance format")
+	}
+	deltas := make(map[int64]string, len(changes))
+	for _, change := range changes {
+		deltas[change.Timestamp] = change.Delta
+	}
+	for _, bucket := range buckets {
+		if delta, ok := deltas[bucket.Timestamp]; ok {
+			value, ok := new(big.Int).SetString(delta, 10)
+			if !ok {
+				return nil, errors.New("invalid balance format")
+			}
+			supply.Add(supply, value)
+		}
+		bucket.TotalSupply = supply.String()
+	}
+
+	return buckets, nil
+}
+
+// GetTotals function: Counts the indexed blocks, transactions, accounts, deployed contracts and
+// tokens and sums the LIND held by indexed accounts
+func (r *StatsRepository) GetTotals() (*models.StatsSummary, error) {
+	var summary models.StatsSummary
+	err := r.db.Raw(`
+		SELECT
+			(SELECT COUNT(*) FROM blocks) AS total_blocks,
+			(SELECT COUNT(*) FROM transactions) AS total_transactions,
+			(SELECT COUNT(DISTINCT address) FROM balance_deltas) AS total_accounts,
+			(SELECT COUNT(*) FROM transactions WHERE contract_type = @create AND result = 0) AS total_contracts,
+			(SELECT COUNT(*) FROM lrc20_token_infos) + (SELECT COUNT(*) FROM token_infos) AS total_tokens,
+			(SELECT COALESCE(SUM(delta), 0)::text FROM balance_deltas WHERE asset = @lind) AS total_supply
+	`, map[string]interface{}{
+		"create": models.ContractTypeCreateSmartContract,
+		"lind":   models.AssetLIND,
+	}).Scan(&summary).Error
+	return &summary, err
+}
+
+// GetStatistic function: Retrieves the latest statistic of a type
+func (r *StatsRepository) GetStatistic(statType string) (json.RawMessage, error) {
+	var stat models.Statistic
+	err := r.db.Where("type = ?", statType).
+		Order("timestamp DESC").
+		First(&stat).Error
+	if err != nil {
+		return nil, err
+	}
+	return stat.Value, nil
+}
+
+// GetStatisticsInRange function: Retrieves statistics in a time range
+func (r *StatsRepository) GetStatisticsInRange(statType string, fromTime, toTime int64) ([]json.RawMessage, error) {
+	var stats []models.Statistic
+	err := r.db.Where("type = ? AND timestamp >= ? AND timestamp <= ?",
+		statType, fromTime, toTime).
+		Order("timestamp ASC").
+		Find(&stats).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var results []json.RawMessage
+	for _, stat := range stats {
+		results = append(results, stat.Value)
+	}
+	return results, nil
+}
+
+// GetHomepageBundle function: Retrieves homepage bundle data
+func (r *StatsRepository) GetHomepageBundle() (*models.HomepageBundleResponse, error) {
+	var bundle models.HomepageBundleResponse
+	
+	// Get totals from the aggregated summary, counting them until it is computed
+	summary := &models.StatsSummary{}
+	data, err := r.GetStatistic(models.StatTypeSummary)
+	if err == nil {
+		err = json.Unmarshal(data, summary)
+	}
+	if err != nil {
+		if summary, err = r.GetTotals(); err != nil {
+			return nil, err
+		}
+	}
+	bundle.TotalBlocks = summary.TotalBlocks
+	bundle.TotalTransactions = summary.TotalTransactions
+	bundle.TotalAccounts = summary.TotalAccounts
+	bundle.TotalContracts = summary.TotalContracts
+	bundle.TotalTokens = summary.TotalTokens
+	
+	// Get recent blocks
+	r.db.Raw("SELECT * FROM blocks ORDER BY number DESC LIMIT 10").Scan(&bundle.RecentBlocks)
+	
+	// Get recent transactions
+	r.db.Raw("SELECT * FROM transactions ORDER BY block_timestamp DESC LIMIT 10").Scan(&bundle.RecentTransactions)
+	
+	return &bundle, nil
+}
+
+// GetOverview function: Retrieves overview statistics, the aggregated summary or the latest
+// buckets of the hourly or daily series
+func (r *StatsRepository) GetOverview(statType string, limit int) (json.RawMessage, error) {
+	switch statType {
+	case models.StatsIntervalHourly, models.StatsIntervalDaily:
+		series, err := r.GetStatisticSeries(models.StatTypeSeriesPrefix+statType, limit)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(series)
+	}
+	return r.GetStatistic(models.StatTypeSummary)
+}
+
+// GetEnergyStatistic function: Retrieves the energy consumed by calls to a contract per day between two
+// millisecond timestamps
+func (r *StatsRepository) GetEnergyStatistic(address string, fromTime, toTime int64) (*models.EnergyStatisticResponse, error) {
+	points, err := r.GetContractDailyStats(address, fromTime, toTime)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &models.EnergyStatisticResponse{
+		Address: address,
+		Daily:   make([]models.DailyStat, 0, len(points)),
+	}
+	for _, point := range points {
+		stats.Total += point.EnergyUsed
+		stats.Daily = append(stats.Daily, models.DailyStat{Date: point.Date, Value: point.EnergyUsed})
+	}
+	return stats, nil
+}
+
+// GetTriggerStatistic function: Retrieves the calls to a contract per day between two millisecond timestamps
+func (r *StatsRepository) GetTriggerStatistic(contract string, fromTime, toTime int64) (*models.TriggerStatisticResponse, error) {
+	points, err := r.GetContractDailyStats(contract, fromTime, toTime)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &models.TriggerStatisticResponse{
+		Contract: contract,
+		Daily:    make([]models.DailyStat, 0, len(points)),
+	}
+	for _, point := range points {
+		stats.Count += point.Triggers
+		stats.Daily = append(stats.Daily, models.DailyStat{Date: point.Date, Value: point.Triggers})
+	}
+	return stats, nil
+}
+
+// GetCallerAddressStatistic function: Retrieves the 100 addresses that called a contract most between two
+// millisecond timestamps
+func (r *StatsRepository) GetCallerAddressStatistic(contract string, fromTime, toTime int64) (*models.CallerAddressStatisticResponse, error) {
+	callers, _, err := r.GetContractCallers(contract, fromTime, toTime, 0, 100)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &models.CallerAddressStatisticResponse{
+		Contract: contract,
+		Callers:  make([]models.CallerStat, 0, len(callers)),
+	}
+	for _, caller := range callers {
+		stats.Callers = append(stats.Callers, models.CallerStat{Address: caller.Address, Count: caller.Triggers})
+	}
+	return stats, nil
+}
+
+// GetEnergyDailyStatistic function: Retrieves the energy consumed and burned by all contract calls per day
+// between two millisecond timestamps
+func (r *StatsRepository) GetEnergyDailyStatistic(fromTime, toTime int64) (*models.EnergyDailyStatisticResponse, error) {
+	points, err := r.GetContractDailyStats("", fromTime, toTime)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &models.EnergyDailyStatisticResponse{
+		Daily: make([]models.EnergyDailyStat, 0, len(points)),
+	}
+	for _, point := range points {
+		stats.Daily = append(stats.Daily, models.EnergyDailyStat{
+			Date:        point.Date,
+			EnergyUsage: point.EnergyUsed,
+			EnergyFee:   point.EnergyFee,
+		})
+	}
+	return stats, nil
+}
+
+// SaveContractTrigger function: Saves a smart contract call and adds it to the daily rollups of its
+// contract and caller. A call already saved is not counted again.
+func (r *StatsRepository) SaveContractTrigger(trigger *models.ContractTrigger) error {
+	day := models.ContractStatDay(trigger.BlockTimestamp)
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(trigger)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		// xmax is 0 when the caller row was inserted rather than updated
+		var newCaller bool
+		if err := tx.Raw(`
+			INSERT INTO contract_daily_callers (contract, day, caller, triggers, energy_used)
+			VALUES (?, ?, ?, 1, ?)
+			ON CONFLICT (contract, day, caller) DO UPDATE SET
+				triggers = contract_daily_callers.triggers + 1,
+				energy_used = contract_daily_callers.energy_used + EXCLUDED.energy_used
+			RETURNING xmax = 0
+		`, trigger.Contract, day, trigger.Caller, trigger.EnergyUsed).Scan(&newCaller).Error; err != nil {
+			return err
+		}
+
+		var failed, uniqueCallers int64
+		if trigger.Failed {
+			failed = 1
+		}
+		if newCaller {
+			uniqueCallers = 1
+		}
+		return tx.Exec(`
+			INSERT INTO contract_daily_stats (contract, day, triggers, failed, unique_callers, call_value,
+				energy_used, caller_energy, owner_energy, energy_fee, updated_at)
+			VALUES (?, ?, 1, ?, ?, ?, ?, ?, ?, ?, NOW())
+			ON CONFLICT (contract, day) DO UPDATE SET
+				triggers = contract_daily_stats.triggers + 1,
+				failed = contract_daily_stats.failed + EXCLUDED.failed,
+				unique_callers = contract_daily_stats.unique_callers + EXCLUDED.unique_callers,
+				call_value = contract_daily_stats.call_value + EXCLUDED.call_value,
+				energy_used = contract_daily_stats.energy_used + EXCLUDED.energy_used,
+				caller_energy = contract_daily_stats.caller_energy + EXCLUDED.caller_energy,
+				owner_energy = contract_daily_stats.owner_energy + EXCLUDED.owner_energy,
+				energy_fee = contract_daily_stats.energy_fee + EXCLUDED.energy_fee,
+				updated_at = NOW()
+		`, trigger.Contract, day, failed, uniqueCallers, trigger.CallValue,
+			trigger.EnergyUsed, trigger.CallerEnergy, trigger.OwnerEnergy, trigger.EnergyFee).Error
+	})
+}
+
+// DeleteContractTriggersFromBlock function: Removes the smart contract calls of blocks at and above a
+// height and rebuilds the daily rollups of the days they fell on
+func (r *StatsRepository) DeleteContractTriggersFromBlock(blockNumber int64) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var days []struct {
+			Contract string
+			Day      int64
+		}
+		if err := tx.Raw(`
+			SELECT DISTINCT contract, block_timestamp / @day * @day AS day
+			FROM contract_triggers
+			WHERE block_number >= @block
+		`, map[string]interface{}{
+			"day":   models.ContractStatDayLength,
+			"block": blockNumber,
+		}).Scan(&days).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("block_number >= ?", blockNumber).Delete(&models.ContractTrigger{}).Error; err != nil {
+			return err
+		}
+
+		for _, d := range days {
+			end := d.Day + models.ContractStatDayLength
+			if err := tx.Where("contract = ? AND day = ?", d.Contract, d.Day).Delete(&models.ContractDailyCaller{}).Error; err != nil {
+				return err
+			}
+			if err := tx.Where("contract = ? AND day = ?", d.Contract, d.Day).Delete(&models.ContractDailyStat{}).Error; err != nil {
+				return err
+			}
+			if err := tx.Exec(`
+				INSERT INTO contract_daily_callers (contract, day, caller, triggers, energy_used)
+				SELECT contract, ?, caller, COUNT(*), SUM(energy_used)
+				FROM contract_triggers
+				WHERE contract = ? AND block_timestamp >= ? AND block_timestamp < ?
+				GROUP BY contract, caller
+			`, d.Day, d.Contract, d.Day, end).Error; err != nil {
+				return err
+			}
+			if err := tx.Exec(`
+				INSERT INTO contract_daily_stats (contract, day, triggers, failed, unique_callers, call_value,
+					energy_used, caller_energy, owner_energy, energy_fee, updated_at)
+				SELECT contract, ?, COUNT(*), COUNT(*) FILTER (WHERE failed), COUNT(DISTINCT caller), SUM(call_value),
+					SUM(energy_used), SUM(caller_energy), SUM(owner_energy), SUM(energy_fee), NOW()
+				FROM contract_triggers
+				WHERE contract = ? AND block_timestamp >= ? AND block_timestamp < ?
+				GROUP BY contract
+			`, d.Day, d.Contract, d.Day, end).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// GetContractDailyStats function: Retrieves the daily rollups of a contract, or summed over all contracts
+// when it is empty, for the days between two millisecond timestamps
+func (r *StatsRepository) GetContractDailyStats(contract string, fromTime, toTime int64) ([]*models.ContractDailyPoint, error) {
+	var points []*models.ContractDailyPoint
+	err := r.db.Raw(`
+		SELECT TO_CHAR(to_timestamp(day / 1000) AT TIME ZONE 'UTC', 'YYYY-MM-DD') AS date,
+			SUM(triggers) AS triggers, SUM(failed) AS failed, SUM(unique_callers) AS unique_callers,
+			SUM(call_value) AS call_value, SUM(energy_used) AS energy_used, SUM(caller_energy) AS caller_energy,
+			SUM(owner_energy) AS owner_energy, SUM(energy_fee) AS energy_fee
+		FROM contract_daily_stats
+		WHERE (@contract = '' OR contract = @contract) AND day >= @from AND day <= @to
+		GROUP BY day
+		ORDER BY day
+	`, map[string]interface{}{
+		"contract": contract,
+		"from":     models.ContractStatDay(fromTime),
+		"to":       toTime,
+	}).Scan(&points).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, point := range points {
+		point.FailureRate = models.FailureRate(point.Failed, point.Triggers)
+	}
+	return points, nil
+}
+
+// GetContractCallers function: Retrieves the callers of a contract on the days between two millisecond
+// timestamps, most active first, with their share of the calls
+func (r *StatsRepository) GetContractCallers(contract string, fromTime, toTime int64, offset, limit int) ([]*models.ContractCallerStat, int64, error) {
+	var callers []*models.ContractCallerStat
+	var totals struct {
+		Callers  int64
+		Triggers int64
+	}
+	args := map[string]interface{}{
+		"contract": contract,
+		"from":     models.ContractStatDay(fromTime),
+		"to":       toTime,
+		"offset":   offset,
+		"limit":    limit,
+	}
+
+	// Count total
+	err := r.db.Raw(`
+		SELECT COUNT(DISTINCT caller) AS callers, COALESCE(SUM(triggers), 0) AS triggers
+		FROM contract_daily_callers
+		WHERE contract = @contract AND day >= @from AND day <= @to
+	`, args).Scan(&totals).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	err = r.db.Raw(`
+		SELECT caller AS address, SUM(triggers) AS triggers, SUM(energy_used) AS energy_used
+		FROM contract_daily_callers
+		WHERE contract = @contract AND day >= @from AND day <= @to
+		GROUP BY caller
+		ORDER BY triggers DESC, caller
+		OFFSET @offset LIMIT @limit
+	`, args).Scan(&callers).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for _, caller := range callers {
+		if totals.Triggers > 0 {
+			caller.Share = float64(caller.Triggers) / float64(totals.Triggers)
+		}
+	}
+	return callers, totals.Callers, nil
+}
+
+// GetContractTriggers function: Retrieves the calls to a set of contracts between two millisecond
+// timestamps, newest first
+func (r *StatsRepository) GetContractTriggers(contracts []string, fromTime, toTime int64, offset, limit int) ([]*models.ContractTrigger, int64, error) {
+	var triggers []*models.ContractTrigger
+	var total int64
+
+	query := r.db.Model(&models.ContractTrigger{}).
+		Where("contract IN ? AND block_timestamp >= ? AND block_timestamp <= ?", contracts, fromTime, toTime)
+
+	// Count total
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if err := query.Order("block_number DESC, id DESC").Offset(offset).Limit(limit).Find(&triggers).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return triggers, total, nil
+}
+
+// GetFreezeResource function: Retrieves the amounts an address has staked per resource and the
+// resources delegated to it, as accumulated from the indexed staking actions
+func (r *StatsRepository) GetFreezeResource(address string, resourceType string) (*models.FreezeResourceResponse, error) {
+	var response models.FreezeResourceResponse
+	
+	// Get frozen records
+	err := r.db.Raw(`
+		SELECT SUM(CASE WHEN action = ? THEN amount ELSE -amount END) AS amount, 0 AS expire_at, resource AS type
+		FROM stake_actions
+		WHERE owner = ? AND (? = '' OR resource = ?)
+		GROUP BY resource
+		HAVING SUM(CASE WHEN action = ? THEN amount ELSE -amount END) > 0
+		ORDER BY resource
+	`, models.StakeActionFreeze, address, resourceType, resourceType, models.StakeActionFreeze).Scan(&response.Frozen).Error
+	if err != nil {
+		return nil, err
+	}
+	
+	// Get delegated records
+	err = r.db.Raw(`
+		SELECT owner AS "from", receiver AS "to",
+			SUM(CASE WHEN action = ? THEN amount ELSE -amount END) AS amount,
+			MAX(expire_at) AS expire_at, resource AS type
+		FROM delegation_actions
+		WHERE receiver = ? AND (? = '' OR resource = ?)
+		GROUP BY owner, receiver, resource
+		HAVING SUM(CASE WHEN action = ? THEN amount ELSE -amount END) > 0
+		ORDER BY expire_at
+	`, models.StakeActionDelegate, address, resourceType, resourceType, models.StakeActionDelegate).Scan(&response.Delegated).Error
+	if err != nil {
+		return nil, err
+	}
+	
+	return &response, nil
+}
+
+// GetTurnover function: Retrieves the LIND moved by transfers and staking actions between two
+// millisecond timestamps, in total and per day
+func (r *StatsRepository) GetTurnover(fromTime, toTime int64) (*models.TurnoverResponse, error) {
+	var response models.TurnoverResponse
+	
+	movements := `
+		SELECT block_timestamp, amount FROM transactions
+		WHERE contract_type = @transfer AND block_timestamp >= @from AND block_timestamp <= @to
+		UNION ALL
+		SELECT block_timestamp, amount FROM stake_actions
+		WHERE block_timestamp >= @from AND block_timestamp <= @to`
+	args := map[string]interface{}{
+		"transfer": models.ContractTypeTransfer,
+		"from":     fromTime,
+		"to":       toTime,
+	}
+	
+	// Get total
+	err := r.db.Raw(`
+		SELECT COALESCE(SUM(amount), 0) as total
+		FROM (`+movements+`) AS movements
+	`, args).Scan(&response.Total).Error
+	if err != nil {
+		return nil, err
+	}
+	
+	// Get daily
+	var daily []models.DailyTurnover
+	err = r.db.Raw(`
+		SELECT 
+			TO_CHAR(DATE(to_timestamp(block_timestamp / 1000)), 'YYYY-MM-DD') as date,
+			SUM(amount) as turnover
+		FROM (`+movements+`) AS movements
+		GROUP BY 1
+		ORDER BY 1
+	`, args).Scan(&daily).Error
+	if err != nil {
+		return nil, err
+	}
+	
+	response.Daily = daily
+	return &response, nil
+}
+
+// GetLindHolders function: Retrieves LIND holders
+func (r *StatsRepository) GetLindHolders(offset, limit int) (*models.LindHolderResponse, error) {
+	var response models.LindHolderResponse
+	
+	// Get total
+	r.db.Raw("SELECT COUNT(*) FROM accounts WHERE balance > 0").Scan(&response.Total)
+	
+	// Get holders
+	r.db.Raw(`
+		SELECT address, balance, 
+		ROW_NUMBER() OVER (ORDER BY balance DESC) as rank
+		FROM accounts
+		WHERE balance > 0
+		ORDER BY balance DESC
+		OFFSET ? LIMIT ?
+	`, offset, limit).Scan(&response.Holders)
+	
+	return &response, nil
+}
+
+// GetTopAccounts function: Retrieves top accounts
+func (r *StatsRepository) GetTopAccounts(limit int) ([]models.AccountResponse, error) {
+    var accounts []models.AccountResponse
+    err := r.db.Model(&models.Account{}).
+        Order("balance DESC").
+        Limit(limit).
+        Find(&accounts).Error
+    return accounts, err
+}
+
+// GetTopTokens function: Retrieves top tokens
+func (r *StatsRepository) GetTopTokens(limit int) ([]models.LRC20TokenInfo, error) {
+    var tokens []models.LRC20TokenInfo
+    err := r.db.Model(&models.LRC20TokenInfo{}).
+        Order("holders DESC").
+        Limit(limit).
+        Find(&tokens).Error
+    return tokens, err
 }
\ No newline at end of file
@@ -1,127 +1,215 @@
-package repository
-
-import (
-	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
-	"gorm.io/gorm"
-)
-
-// TagRepository struct: Repository for tag operations
-type TagRepository struct {
-	db *gorm.DB
-}
-
-// NewTagRepository function: Creates a new tag repository
-func NewTagRepository(db *gorm.DB) *TagRepository {
-	return &TagRepository{db: db}
-}
-
-// InsertTag function: Inserts a new tag
-func (r *TagRepository) InsertTag(tag *models.TagResponse) (int32, error) {
-	var id int32
-	err := r.db.Raw(`
-		INSERT INTO tags (address, tag, description, owner, signature, votes, created_at)
-		VALUES (?, ?, ?, ?, ?, ?, ?)
-		RETURNING id
-	`, tag.Address, tag.Tag, tag.Description, tag.Owner, "", tag.Votes, tag.CreatedAt).Scan(&id).Error
-	return id, err
-}
-
-// UpdateTag function: Updates an existing tag
-func (r *TagRepository) UpdateTag(id int32, tag, description string) error {
-	return r.db.Exec(`
-		UPDATE tags 
-		SET tag = ?, description = ?
-		WHERE id = ?
-	`, tag, description, id).Error
-}
-
-// DeleteTag function: Deletes a tag
-func (r *TagRepository) DeleteTag(id int32) error {
-	return r.db.Exec("DELETE FROM tags WHERE id = ?", id).Error
-}
-
-// GetTagByID function: Retrieves a tag by ID
-func (r *TagRepository) GetTagByID(id int32) (*models.TagResponse, error) {
-	var tag models.TagResponse
-	err := r.db.Raw(`
-		SELECT id, address, tag, description, owner, votes, created_at
-		FROM tags
-		WHERE id = ?
-	`, id).Scan(&tag).Error
-	return &tag, err
-}
-
-// GetTagsByAddress function: Retrieves tags for an address
-func (r *TagRepository) GetTagsByAddress(address string, offset, limit int) ([]*models.TagResponse, int64, error) {
-	var tags []*models.TagResponse
-	var total int64
-
-	query := r.db.Raw(`
-		SELECT id, address, tag, description, owner, votes, created_at
-		FROM tags
-		WHERE address = ?
-		ORDER BY votes DESC, created_at DESC
-		OFFSET ? LIMIT ?
-	`, address, offset, limit).Scan(&tags)
-
-	r.db.Raw("SELECT COUNT(*) FROM tags WHERE address = ?", address).Scan(&total)
-
-	return tags, total, query.Error
-}
-
-// GetAllTags function: Retrieves all tags with pagination
-func (r *TagRepository) GetAllTags(offset, limit int, sort string) ([]*models.TagResponse, int64, error) {
-	var tags []*models.TagResponse
-	var total int64
-
-	orderBy := "votes DESC, created_at DESC"
-	if sort != "" {
-		if sort[0] == '-' {
-			orderBy = sort[1:] + " DESC"
-		} else {
-			orderBy = sort + " ASC"
-		}
-	}
-
-	query := r.db.Raw(`
-		SELECT id, address, tag, description, owner, votes, created_at
-		FROM tags
-		ORDER BY ?
-		OFFSET ? LIMIT ?
-	`, orderBy, offset, limit).Scan(&tags)
-
-	r.db.Raw("SELECT COUNT(*) FROM tags").Scan(&total)
-
-	return tags, total, query.Error
-}
-
-// GetRecommendedTags function: Retrieves recommended tags for an address
-func (r *TagRepository) GetRecommendedTags(address string, limit int) ([]*models.TagResponse, error) {
-	var tags []*models.TagResponse
-	
-	// Get most popular tags for similar addresses or overall
-	query := r.db.Raw(`
-		SELECT tag, COUNT(*) as popularity
-		FROM tags
-		WHERE address IN (
-			SELECT DISTINCT address
-			FROM tags
-			WHERE tag IN (
-				SELECT tag
-				FROM tags
-				WHERE address = ?
-				LIMIT 10
-			)
-		)
-		GROUP BY tag
-		ORDER BY popularity DESC
-		LIMIT ?
-	`, address, limit).Scan(&tags)
-
-	return tags, query.Error
-}
-
-// VoteTag function: Increments vote count for a tag
-func (r *TagRepository) VoteTag(id int32) error {
-	return r.db.Exec("UPDATE tags SET votes = votes + 1 WHERE id = ?", id).Error
+package repository
+
+import (
+	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// tagOrder ranks curator tags above community tags, then by votes
+const tagOrder = "curated DESC, votes DESC, created_at DESC"
+
+// tagSortColumns are the columns tag lists can be sorted by
+var tagSortColumns = map[string]bool{
+	"votes":      true,
+	"created_at": true,
+	"tag":        true,
+	"address":    true,
+}
+
+// TagRepository struct: Repository for tag operations
+type TagRepository struct {
+	db *gorm.DB
+}
+
+// NewTagRepository function: Creates a new tag repository
+func NewTagRepository(db *gorm.DB) *TagRepository {
+	return &TagRepository{db: db}
+}
+
+// InsertTag function: Inserts a new tag
+func (r *TagRepository) InsertTag(tag *models.Tag) (int32, error) {
+	err := r.db.Create(tag).Error
+	return tag.ID, err
+}
+
+// UpdateTag function: Updates the text of a tag signed at signedAt and its moderation status,
+// false when the tag was changed by a signature at or after that time. The time of the last
+// moderation is kept, moderations signed before it stay rejected.
+func (r *TagRepository) UpdateTag(id int32, tag, description, signature string, signedAt int64, status string) (bool, error) {
+	result := r.db.Model(&models.Tag{}).
+		Where("id = ? AND signed_at < ?", id, signedAt).
+		Updates(map[string]interface{}{
+			"tag":          tag,
+			"description":  description,
+			"signature":    signature,
+			"signed_at":    signedAt,
+			"status":       status,
+			"moderated_by": "",
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+// DeleteTag function: Deletes a tag and its votes
+func (r *TagRepository) DeleteTag(id int32) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("tag_id = ?", id).Delete(&models.TagVote{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&models.Tag{}, id).Error
+	})
+}
+
+// GetTagByID function: Retrieves a tag by ID
+func (r *TagRepository) GetTagByID(id int32) (*models.Tag, error) {
+	var tag models.Tag
+	err := r.db.First(&tag, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &tag, nil
+}
+
+// FindTag function: Retrieves the tag an owner gave an address, nil if there is none
+func (r *TagRepository) FindTag(address, owner, tag string) (*models.Tag, error) {
+	var tags []*models.Tag
+	err := r.db.Where("address = ? AND owner = ? AND tag = ?", address, owner, tag).Limit(1).Find(&tags).Error
+	if err != nil || len(tags) == 0 {
+		return nil, err
+	}
+	return tags[0], nil
+}
+
+// GetTagsByAddress function: Retrieves the approved tags of an address, curator tags first
+func (r *TagRepository) GetTagsByAddress(address string, offset, limit int) ([]*models.Tag, int64, error) {
+	var tags []*models.Tag
+	var total int64
+
+	query := r.db.Model(&models.Tag{}).
+		Where("address = ? AND status = ?", address, models.TagStatusApproved)
+
+	// Count total
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.Order(tagOrder).Offset(offset).Limit(limit).Find(&tags).Error
+	return tags, total, err
+}
+
+// GetAllTags function: Retrieves the approved tags with pagination
+func (r *TagRepository) GetAllTags(offset, limit int, sort string) ([]*models.Tag, int64, error) {
+	var tags []*models.Tag
+	var total int64
+
+	query := r.db.Model(&models.Tag{}).Where("status = ?", models.TagStatusApproved)
+
+	// Count total
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	orderBy := tagOrder
+	if column := sort; column != "" {
+		desc := column[0] == '-'
+		if desc {
+			column = column[1:]
+		}
+		if tagSortColumns[column] {
+			orderBy = column + " ASC"
+			if desc {
+				orderBy = column + " DESC"
+			}
+		}
+	}
+
+	err := query.Order(orderBy).Offset(offset).Limit(limit).Find(&tags).Error
+	return tags, total, err
+}
+
+// GetPendingTags function: Retrieves the moderation queue, oldest first
+func (r *TagRepository) GetPendingTags(offset, limit int) ([]*models.Tag, int64, error) {
+	var tags []*models.Tag
+	var total int64
+
+	query := r.db.Model(&models.Tag{}).Where("status = ?", models.TagStatusPending)
+
+	// Count total
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.Order("created_at ASC, id ASC").Offset(offset).Limit(limit).Find(&tags).Error
+	return tags, total, err
+}
+
+// GetRecommendedTags function: Retrieves tags to suggest for an address: the best approved tag of each
+// label found on addresses sharing a label with it, or the best labels overall for an untagged address
+func (r *TagRepository) GetRecommendedTags(address string, limit int) ([]*models.Tag, error) {
+	var tags []*models.Tag
+	err := r.db.Raw(`
+		WITH own AS (
+			SELECT DISTINCT tag FROM tags WHERE address = @address AND status = @approved
+		), related AS (
+			SELECT DISTINCT t.address FROM tags t JOIN own ON own.tag = t.tag
+			WHERE t.address <> @address AND t.status = @approved
+		)
+		SELECT * FROM (
+			SELECT DISTINCT ON (t.tag) t.*
+			FROM tags t
+			WHERE t.status = @approved
+				AND t.address <> @address
+				AND t.tag NOT IN (SELECT tag FROM own)
+				AND (NOT EXISTS (SELECT 1 FROM own) OR t.address IN (SELECT address FROM related))
+			ORDER BY t.tag, `+tagOrder+`
+		) best
+		ORDER BY `+tagOrder+`
+		LIMIT @limit
+	`, map[string]interface{}{
+		"address":  address,
+		"approved": models.TagStatusApproved,
+		"limit":    limit,
+	}).Scan(&tags).Error
+	return tags, err
+}
+
+// VoteTag function: Saves the vote of a signer on a tag, replacing a previous vote of the signer
+// signed before it, and returns the new vote total of the tag. voted is false when the signer
+// already voted with a signature at or after the one of vote.
+func (r *TagRepository) VoteTag(vote *models.TagVote) (int32, bool, error) {
+	var votes int32
+	voted := false
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "tag_id"}, {Name: "voter"}},
+			Where:     clause.Where{Exprs: []clause.Expression{clause.Expr{SQL: "tag_votes.signed_at < EXCLUDED.signed_at"}}},
+			DoUpdates: clause.AssignmentColumns([]string{"value", "signature", "signed_at", "created_at"}),
+		}).Create(vote)
+		if result.Error != nil {
+			return result.Error
+		}
+		if voted = result.RowsAffected > 0; !voted {
+			return nil
+		}
+		return tx.Raw(`
+			UPDATE tags
+			SET votes = (SELECT COALESCE(SUM(value), 0) FROM tag_votes WHERE tag_id = @id)
+			WHERE id = @id
+			RETURNING votes
+		`, map[string]interface{}{"id": vote.TagID}).Scan(&votes).Error
+	})
+	return votes, voted, err
+}
+
+// ModerateTag function: Sets the moderation status of a tag signed at moderatedAt, false when the
+// tag was moderated at or after that time
+func (r *TagRepository) ModerateTag(id int32, status, moderator string, moderatedAt int64) (bool, error) {
+	result := r.db.Model(&models.Tag{}).
+		Where("id = ? AND moderated_at < ?", id, moderatedAt).
+		Updates(map[string]interface{}{
+			"status":       status,
+			"moderated_by": moderator,
+			"moderated_at": moderatedAt,
+		})
+	return result.RowsAffected > 0, result.Error
 }
\ No newline at end of file
@@ -1,393 +1,817 @@
-// internal/services/storage/repository/token_repo.go
-package repository
-
-import (
-	"encoding/json"
-	"errors"
-	"math/big"
-
-	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
-	"gorm.io/gorm"
-)
-
-// TokenRepository struct: Repository for token operations
-type TokenRepository struct {
-	db *gorm.DB
-}
-
-// NewTokenRepository function: Creates a new token repository
-func NewTokenRepository(db *gorm.DB) *TokenRepository {
-	return &TokenRepository{db: db}
-}
-
-// SaveLRC20Token function: Saves or updates an LRC20 token
-func (r *TokenRepository) SaveLRC20Token(token *models.LRC20TokenInfo) error {
-	return r.db.Save(token).Error
-}
-
-// GetLRC20TokenByContract function: Retrieves an LRC20 token by contract address
-func (r *TokenRepository) GetLRC20TokenByContract(contract string) (*models.LRC20TokenInfo, error) {
-	var token models.LRC20TokenInfo
-	err := r.db.Where("contract = ?", contract).First(&token).Error
-	if err != nil {
-		return nil, err
-	}
-	return &token, nil
-}
-
-// GetLRC20Tokens function: Retrieves paginated LRC20 tokens
-func (r *TokenRepository) GetLRC20Tokens(offset, limit int, sort string) ([]*models.LRC20TokenInfo, int64, error) {
-	var tokens []*models.LRC20TokenInfo
-	var total int64
-
-	query := r.db.Model(&models.LRC20TokenInfo{})
-
-	// Count total
-	if err := query.Count(&total).Error; err != nil {
-		return nil, 0, err
-	}
-
-	// Apply sorting
-	if sort != "" {
-		if sort[0] == '-' {
-			query = query.Order(sort[1:] + " DESC")
-		} else {
-			query = query.Order(sort + " ASC")
-		}
-	} else {
-		query = query.Order("issue_time DESC")
-	}
-
-	// Apply pagination
-	if err := query.Offset(offset).Limit(limit).Find(&tokens).Error; err != nil {
-		return nil, 0, err
-	}
-
-	return tokens, total, nil
-}
-
-// GetTokenHolders function: Retrieves holders for a token
-func (r *TokenRepository) GetTokenHolders(contract string, offset, limit int, sort string) ([]*models.TokenHolderResponse, int64, error) {
-	var holders []*models.TokenHolderResponse
-	var total int64
-
-	query := r.db.Model(&models.TokenHolder{}).
-		Where("contract_address = ?", contract)
-
-	// Count total
-	if err := query.Count(&total).Error; err != nil {
-		return nil, 0, err
-	}
-
-	// Apply sorting
-	if sort != "" {
-		if sort[0] == '-' {
-			query = query.Order(sort[1:] + " DESC")
-		} else {
-			query = query.Order(sort + " ASC")
-		}
-	} else {
-		query = query.Order("balance DESC")
-	}
-
-	// Apply pagination
-	if err := query.Offset(offset).Limit(limit).Find(&holders).Error; err != nil {
-		return nil, 0, err
-	}
-
-	return holders, total, nil
-}
-
-// SaveTokenTransfer function: Saves a token transfer
-func (r *TokenRepository) SaveTokenTransfer(transfer *models.TokenTransferResponse) error {
-	return r.db.Save(transfer).Error
-}
-
-// UpdateHolderBalance function: Updates the balance of a token holder
-func (r *TokenRepository) UpdateHolderBalance(contractAddr, address string, delta *big.Int) error {
-	var holder models.TokenHolder
-	err := r.db.Where("contract_address = ? AND address = ?", contractAddr, address).First(&holder).Error
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			// Create new holder
-			holder = models.TokenHolder{
-				ContractAddress: contractAddr,
-				Address:         address,
-				Balance:         delta.String(),
-				Percentage:      0,
-			}
-			return r.db.Create(&holder).Error
-		}
-		return err
-	}
-
-	currentBalance, ok := new(big.Int).SetString(holder.Balance, 10)
-	if !ok {
-		return errors.New("invalid balance format")
-	}
-	currentBalance.Add(currentBalance, delta)
-	holder.Balance = currentBalance.String()
-	return r.db.Save(&holder).Error
-}
-
-// GetHolderCount function: Gets the number of token holders
-func (r *TokenRepository) GetHolderCount(contractAddr string) (int64, error) {
-	var count int64
-	err := r.db.Model(&models.TokenHolder{}).Where("contract_address = ?", contractAddr).Count(&count).Error
-	return count, err
-}
-
-// UpdateHolderCount function: Updates the number of token holders
-func (r *TokenRepository) UpdateHolderCount(contractAddr string, count int64) error {
-	return r.db.Model(&models.LRC20TokenInfo{}).
-		Where("contract = ?", contractAddr).
-		Update("holders", count).Error
-}
-
-// UpdateHolderPercentage function: Updates the percentage of a token holder
-func (r *TokenRepository) UpdateHolderPercentage(contractAddr, address string, percentage float64) error {
-	return r.db.Model(&models.TokenHolder{}).
-		Where("contract_address = ? AND address = ?", contractAddr, address).
-		Update("percentage", percentage).Error
-}
-
-// SearchTokens function: Searches for tokens by name or symbol
-func (r *TokenRepository) SearchTokens(query string, limit int) ([]models.LRC20TokenInfo, error) {
-	var tokens []models.LRC20TokenInfo
-	err := r.db.Where("name ILIKE ? OR symbol ILIKE ?", "%"+query+"%", "%"+query+"%").
-		Limit(limit).
-		Find(&tokens).Error
-	return tokens, err
-}
-
-// SaveLRC10Token function: Saves a LRC10 token
-func (r *TokenRepository) SaveLRC10Token(token *models.TokenInfo) error {
-	return r.db.Save(token).Error
-}
-
-// GetTokenTransfers function: Retrieves transfers for a token
-func (r *TokenRepository) GetTokenTransfers(contract, from, to string, offset, limit int, sort string) ([]*models.TokenTransferResponse, int64, error) {
-	var transfers []*models.TokenTransferResponse
-	var total int64
-
-	query := r.db.Model(&models.TokenTransferResponse{})
-
-	if contract != "" {
-		query = query.Where("token_address = ?", contract)
-	}
-	if from != "" {
-		query = query.Where("from = ?", from)
-	}
-	if to != "" {
-		query = query.Where("to = ?", to)
-	}
-
-	// Count total
-	if err := query.Count(&total).Error; err != nil {
-		return nil, 0, err
-	}
-
-	// Apply sorting
-	if sort != "" {
-		if sort[0] == '-' {
-			query = query.Order(sort[1:] + " DESC")
-		} else {
-			query = query.Order(sort + " ASC")
-		}
-	} else {
-		query = query.Order("block_timestamp DESC")
-	}
-
-	// Apply pagination
-	if err := query.Offset(offset).Limit(limit).Find(&transfers).Error; err != nil {
-		return nil, 0, err
-	}
-
-	return transfers, total, nil
-}
-
-// GetTokensOverview function: Retrieves token overview statistics
-func (r *TokenRepository) GetTokensOverview(filter string, offset, limit int, sort string) ([]json.RawMessage, int64, error) {
-	// This would aggregate data from multiple tables
-	var results []json.RawMessage
-	var total int64 = 0
-	return results, total, nil
-}
-
-// GetTokenPrice function: Retrieves token price information
-func (r *TokenRepository) GetTokenPrice() (*models.TokenPriceResponse, error) {
-	// This would fetch from cache or external API
-	return &models.TokenPriceResponse{
-		Price:     0.01,
-		Change24h: 5.2,
-		Volume24h: 1000000,
-		MarketCap: 50000000,
-	}, nil
-}
-
-// GetParticipations function: Retrieves token participations
-func (r *TokenRepository) GetParticipations(offset, limit int) ([]json.RawMessage, int64, error) {
-	var participations []json.RawMessage
-	var total int64 = 0
-	return participations, total, nil
-}
-
-// TokenPosition represents a single token position
-type TokenPosition struct {
-	Address    string  `json:"address"`
-	Balance    string  `json:"balance"`
-	Percentage float64 `json:"percentage"`
-	Rank       int     `json:"rank"`
-}
-
-// GetTokenPositionDistribution function: Retrieves token holder distribution
-func (r *TokenRepository) GetTokenPositionDistribution(contract string, limit int) ([]TokenPosition, error) {
-	var positions []TokenPosition
-	err := r.db.Raw(`
-		SELECT address, balance, percentage, 
-		ROW_NUMBER() OVER (ORDER BY CAST(balance AS NUMERIC) DESC) as rank
-		FROM token_holders
-		WHERE contract_address = ?
-		ORDER BY CAST(balance AS NUMERIC) DESC
-		LIMIT ?
-	`, contract, limit).Scan(&positions).Error
-	if err != nil {
-		return nil, err
-	}
-	return positions, nil
-}
-
-// GetWinkFund function: Retrieves WINK fund information
-func (r *TokenRepository) GetWinkFund() (*models.WinkFundResponse, error) {
-	var fund models.WinkFundResponse
-	// Query from database - this is a placeholder
-	// In real implementation, you would query from a table
-	err := r.db.Raw("SELECT total, burned, circulating FROM wink_fund ORDER BY timestamp DESC LIMIT 1").Scan(&fund).Error
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return &models.WinkFundResponse{
-				Total:       0,
-				Burned:      0,
-				Circulating: 0,
-			}, nil
-		}
-		return nil, err
-	}
-	return &fund, nil
-}
-
-// GetWinkGraphic function: Retrieves WINK graphic data
-func (r *TokenRepository) GetWinkGraphic() (*models.GraphicResponse, error) {
-	var graphic models.GraphicResponse
-	// Query from database - this is a placeholder
-	// In real implementation, you would query time series data
-	err := r.db.Raw(`
-		SELECT array_agg(date) as labels, array_agg(value) as data 
-		FROM wink_daily_stats 
-		ORDER BY date DESC LIMIT 30
-	`).Scan(&graphic).Error
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return &models.GraphicResponse{
-				Labels: []string{},
-				Data:   []float64{},
-			}, nil
-		}
-		return nil, err
-	}
-	return &graphic, nil
-}
-
-// GetJSTFund function: Retrieves JST fund information
-func (r *TokenRepository) GetJSTFund() (*models.JSTFundResponse, error) {
-	var fund models.JSTFundResponse
-	// Query from database - this is a placeholder
-	err := r.db.Raw("SELECT total, burned, circulating FROM jst_fund ORDER BY timestamp DESC LIMIT 1").Scan(&fund).Error
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return &models.JSTFundResponse{
-				Total:       0,
-				Burned:      0,
-				Circulating: 0,
-			}, nil
-		}
-		return nil, err
-	}
-	return &fund, nil
-}
-
-// GetJSTGraphic function: Retrieves JST graphic data
-func (r *TokenRepository) GetJSTGraphic() (*models.GraphicResponse, error) {
-	var graphic models.GraphicResponse
-	// Query from database - this is a placeholder
-	err := r.db.Raw(`
-		SELECT array_agg(date) as labels, array_agg(value) as data 
-		FROM jst_daily_stats 
-		ORDER BY date DESC LIMIT 30
-	`).Scan(&graphic).Error
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return &models.GraphicResponse{
-				Labels: []string{},
-				Data:   []float64{},
-			}, nil
-		}
-		return nil, err
-	}
-	return &graphic, nil
-}
-
-// GetBitTorrentGraphic function: Retrieves BitTorrent graphic data
-func (r *TokenRepository) GetBitTorrentGraphic() (*models.GraphicResponse, error) {
-	var graphic models.GraphicResponse
-	// Query from database - this is a placeholder
-	err := r.db.Raw(`
-		SELECT array_agg(date) as labels, array_agg(value) as data 
-		FROM btt_daily_stats 
-		ORDER BY date DESC LIMIT 30
-	`).Scan(&graphic).Error
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return &models.GraphicResponse{
-				Labels: []string{},
-				Data:   []float64{},
-			}, nil
-		}
-		return nil, err
-	}
-	return &graphic, nil
-}
-
-// GetAssetTransfers function: Retrieves asset transfers
-func (r *TokenRepository) GetAssetTransfers(assetName string, offset, limit int, sort string) ([]*models.TokenTransferResponse, int64, error) {
-	var transfers []*models.TokenTransferResponse
-	var total int64
-
-	query := r.db.Model(&models.TokenTransferResponse{})
-	if assetName != "" {
-		query = query.Where("token_symbol = ? OR token_address = ?", assetName, assetName)
-	}
-
-	// Count total
-	if err := query.Count(&total).Error; err != nil {
-		return nil, 0, err
-	}
-
-	// Apply sorting
-	if sort != "" {
-		if sort[0] == '-' {
-			query = query.Order(sort[1:] + " DESC")
-		} else {
-			query = query.Order(sort + " ASC")
-		}
-	} else {
-		query = query.Order("block_timestamp DESC")
-	}
-
-	// Apply pagination
-	if err := query.Offset(offset).Limit(limit).Find(&transfers).Error; err != nil {
-		return nil, 0, err
-	}
-
-	return transfers, total, nil
+// internal/services/storage/repository/token_repo.go
+package repository
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strconv"
+
+	ethmath "github.com/ethereum/go-ethereum/common/math"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// TokenRepository struct: Repository for token operations
+type TokenRepository struct {
+	db *gorm.DB
+}
+
+// NewTokenRepository function: Creates a new token repository
+func NewTokenRepository(db *gorm.DB) *TokenRepository {
+	return &TokenRepository{db: db}
+}
+
+// SaveLRC20Token function: Saves or updates an LRC20 token
+func (r *TokenRepository) SaveLRC20Token(token *models.LRC20TokenInfo) error {
+	return r.db.Save(token).Error
+}
+
+// GetLRC20TokenByContract function: Retrieves an LRC20 token by contract address
+func (r *TokenRepository) GetLRC20TokenByContract(contract string) (*models.LRC20TokenInfo, error) {
+	var token models.LRC20TokenInfo
+	err := r.db.Where("contract = ?", contract).First(&token).Error
+	if err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// GetLRC20Tokens function: Retrieves paginated LRC20 tokens
+func (r *TokenRepository) GetLRC20Tokens(offset, limit int, sort string) ([]*models.LRC20TokenInfo, int64, error) {
+	var tokens []*models.LRC20TokenInfo
+	var total int64
+
+	query := r.db.Model(&models.LRC20TokenInfo{})
+
+	// Count total
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Apply sorting
+	if sort != "" {
+		if sort[0] == '-' {
+			query = query.Order(sort[1:] + " DESC")
+		} else {
+			query = query.Order(sort + " ASC")
+		}
+	} else {
+		query = query.Order("issue_time DESC")
+	}
+
+	// Apply pagination
+	if err := query.Offset(offset).Limit(limit).Find(&tokens).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return tokens, total, nil
+}
+
+// GetTokenHolders function: Retrieves holders for a token
+func (r *TokenRepository) GetTokenHolders(contract string, offset, limit int, sort string) ([]*models.TokenHolderResponse, int64, error) {
+	var holders []*models.TokenHolderResponse
+	var total int64
+
+	query := r.db.Model(&models.TokenHolder{}).
+		Where("contract_address = ? AND balance > 0", contract)
+
+	// Count total
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Apply sorting
+	if sort != "" {
+		if sort[0] == '-' {
+			query = query.Order(sort[1:] + " DESC")
+		} else {
+			query = query.Order(sort + " ASC")
+		}
+	} else {
+		query = query.Order("balance DESC")
+	}
+
+	// Apply pagination
+	if err := query.Offset(offset).Limit(limit).Find(&holders).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return holders, total, nil
+}
+
+// SaveTokenTransfer function: Saves a token transfer
+func (r *TokenRepository) SaveTokenTransfer(transfer *models.TokenTransferResponse) error {
+	return r.db.Save(transfer).Error
+}
+
+// ApplyTokenTransfer function: Saves an LRC20 transfer, moves the holder balances, records the
+// balance deltas and updates the transfer and holder counts of the token in one transaction.
+// Transfers from untrackedAddress mint and transfers to it burn, adjusting the total supply of
+// the token. A transfer already saved is not applied again.
+func (r *TokenRepository) ApplyTokenTransfer(transfer *models.TokenTransferResponse, untrackedAddress string) error {
+	value, ok := new(big.Int).SetString(transfer.Value, 10)
+	if !ok {
+		return errors.New("invalid transfer value")
+	}
+
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(transfer)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		holders, err := moveHolderBalances(tx, transfer, value, untrackedAddress)
+		if err != nil {
+			return err
+		}
+		if err := saveBalanceDeltas(tx, transferDeltas(transfer, value, untrackedAddress)); err != nil {
+			return err
+		}
+		return tx.Model(&models.LRC20TokenInfo{}).
+			Where("contract = ?", transfer.TokenAddress).
+			Updates(map[string]interface{}{
+				"transfers": gorm.Expr("transfers + 1"),
+				"holders":   gorm.Expr("holders + ?", holders),
+			}).Error
+	})
+}
+
+// UpdateHolderBalance function: Updates the balance of a token holder
+func (r *TokenRepository) UpdateHolderBalance(contractAddr, address string, delta *big.Int) error {
+	_, err := updateHolderBalance(r.db, contractAddr, address, delta)
+	return err
+}
+
+// updateHolderBalance adds delta to a holder balance with a single upsert, so
+// concurrent updates of the same holder cannot overwrite each other. It
+// returns the change in the number of holders: 1 when the balance became
+// positive, -1 when it no longer is and 0 otherwise.
+func updateHolderBalance(db *gorm.DB, contractAddr, address string, delta *big.Int) (int64, error) {
+	var balance string
+	err := db.Raw(`
+		INSERT INTO token_holders (contract_address, address, balance, percentage, updated_at)
+		VALUES (?, ?, CAST(? AS NUMERIC), 0, NOW())
+		ON CONFLICT (contract_address, address)
+		DO UPDATE SET balance = token_holders.balance + EXCLUDED.balance, updated_at = EXCLUDED.updated_at
+		RETURNING balance::TEXT
+	`, contractAddr, address, delta.String()).Scan(&balance).Error
+	if err != nil {
+		return 0, err
+	}
+
+	after, ok := new(big.Int).SetString(balance, 10)
+	if !ok {
+		return 0, errors.New("invalid holder balance")
+	}
+	before := new(big.Int).Sub(after, delta)
+	switch {
+	case before.Sign() <= 0 && after.Sign() > 0:
+		return 1, nil
+	case before.Sign() > 0 && after.Sign() <= 0:
+		return -1, nil
+	}
+	return 0, nil
+}
+
+// moveHolderBalances moves value from the sender to the recipient of a
+// transfer, a negative value moves it back, and returns the change in the
+// number of holders. The untracked address has no holder row, value leaving
+// it is minted and value sent to it is burnt.
+func moveHolderBalances(db *gorm.DB, transfer *models.TokenTransferResponse, value *big.Int, untrackedAddress string) (int64, error) {
+	var holders int64
+	supplyDelta := new(big.Int)
+	if transfer.From == untrackedAddress {
+		supplyDelta.Add(supplyDelta, value)
+	} else {
+		change, err := updateHolderBalance(db, transfer.TokenAddress, transfer.From, new(big.Int).Neg(value))
+		if err != nil {
+			return 0, err
+		}
+		holders += change
+	}
+	if transfer.To == untrackedAddress {
+		supplyDelta.Sub(supplyDelta, value)
+	} else {
+		change, err := updateHolderBalance(db, transfer.TokenAddress, transfer.To, value)
+		if err != nil {
+			return 0, err
+		}
+		holders += change
+	}
+
+	if supplyDelta.Sign() == 0 {
+		return holders, nil
+	}
+	return holders, db.Model(&models.LRC20TokenInfo{}).
+		Where("contract = ?", transfer.TokenAddress).
+		Update("total_supply", gorm.Expr("(COALESCE(NULLIF(total_supply, ''), '0')::NUMERIC + CAST(? AS NUMERIC))::TEXT", supplyDelta.String())).Error
+}
+
+// RevertTransfersFromBlock function: Removes the transfers of blocks at and above a height and
+// reverses their effect on holder balances, supply and the transfer and holder counts of the
+// tokens. untrackedAddress is the mint/burn address, which has no holder row.
+func (r *TokenRepository) RevertTransfersFromBlock(blockNumber int64, untrackedAddress string) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var transfers []*models.TokenTransferResponse
+		if err := tx.Where("block_number >= ?", blockNumber).Find(&transfers).Error; err != nil {
+			return err
+		}
+
+		transferCounts := make(map[string]int64)
+		holderCounts := make(map[string]int64)
+		for _, transfer := range transfers {
+			transferCounts[transfer.TokenAddress]++
+			value, ok := new(big.Int).SetString(transfer.Value, 10)
+			if !ok {
+				continue
+			}
+			holders, err := moveHolderBalances(tx, transfer, new(big.Int).Neg(value), untrackedAddress)
+			if err != nil {
+				return err
+			}
+			holderCounts[transfer.TokenAddress] += holders
+		}
+
+		// Token addresses are LRC-20 contracts or LRC-10 asset IDs, only one
+		// of the updates matches a row
+		for token, count := range transferCounts {
+			if err := tx.Model(&models.LRC20TokenInfo{}).
+				Where("contract = ?", token).
+				Updates(map[string]interface{}{
+					"transfers": gorm.Expr("GREATEST(transfers - ?, 0)", count),
+					"holders":   gorm.Expr("GREATEST(holders + ?, 0)", holderCounts[token]),
+				}).Error; err != nil {
+				return err
+			}
+			if err := tx.Model(&models.TokenInfo{}).
+				Where("id = ?", token).
+				Updates(map[string]interface{}{
+					"transfers": gorm.Expr("GREATEST(transfers - ?, 0)", count),
+					"holders":   gorm.Expr("GREATEST(holders + ?, 0)", holderCounts[token]),
+				}).Error; err != nil {
+				return err
+			}
+		}
+
+		return tx.Where("block_number >= ?", blockNumber).Delete(&models.TokenTransferResponse{}).Error
+	})
+}
+
+// GetHolderBalance function: Gets the balance of a token holder, "0" when the address holds none
+func (r *TokenRepository) GetHolderBalance(contractAddr, address string) (string, error) {
+	var holder models.TokenHolder
+	err := r.db.Where("contract_address = ? AND address = ?", contractAddr, address).First(&holder).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "0", nil
+		}
+		return "", err
+	}
+	return holder.Balance, nil
+}
+
+// SaveAllowance function: Sets the allowance of an owner and spender from an Approval event
+func (r *TokenRepository) SaveAllowance(allowance *models.TokenAllowance) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var existing models.TokenAllowance
+		err := tx.Where("owner = ? AND spender = ? AND contract = ?", allowance.Owner, allowance.Spender, allowance.Contract).
+			First(&existing).Error
+		if err == nil {
+			allowance.ID = existing.ID
+			err = tx.Save(allowance).Error
+		} else if errors.Is(err, gorm.ErrRecordNotFound) {
+			err = tx.Create(allowance).Error
+		}
+		if err != nil {
+			return err
+		}
+		return saveAllowanceChange(tx, allowance)
+	})
+}
+
+// saveAllowanceChange records the value an allowance was set to in the history
+func saveAllowanceChange(db *gorm.DB, allowance *models.TokenAllowance) error {
+	return db.Create(&models.TokenAllowanceChange{
+		Owner:          allowance.Owner,
+		Spender:        allowance.Spender,
+		Contract:       allowance.Contract,
+		Allowance:      allowance.Allowance,
+		TransactionID:  allowance.TransactionID,
+		BlockNumber:    allowance.BlockNumber,
+		BlockTimestamp: allowance.BlockTimestamp,
+	}).Error
+}
+
+// SpendAllowance function: Decreases the allowance used by a transferFrom. The spender is the
+// first candidate holding an allowance; allowances already set by an Approval in the same
+// transaction and unlimited allowances are left unchanged.
+func (r *TokenRepository) SpendAllowance(contract, owner string, spenders []string, value *big.Int, txID string, blockNumber, blockTimestamp int64) error {
+	if len(spenders) == 0 {
+		return nil
+	}
+
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var allowances []*models.TokenAllowance
+		if err := tx.Where("contract = ? AND owner = ? AND spender IN ?", contract, owner, spenders).
+			Find(&allowances).Error; err != nil {
+			return err
+		}
+
+		for _, spender := range spenders {
+			for _, allowance := range allowances {
+				if allowance.Spender != spender {
+					continue
+				}
+				if allowance.TransactionID == txID {
+					return nil
+				}
+				current, ok := new(big.Int).SetString(allowance.Allowance, 10)
+				if !ok || current.Sign() == 0 {
+					continue
+				}
+				if current.Cmp(ethmath.MaxBig256) == 0 {
+					return nil
+				}
+
+				current.Sub(current, value)
+				if current.Sign() < 0 {
+					current.SetInt64(0)
+				}
+				allowance.Allowance = current.String()
+				allowance.TransactionID = txID
+				allowance.BlockNumber = blockNumber
+				allowance.BlockTimestamp = blockTimestamp
+				if err := tx.Save(allowance).Error; err != nil {
+					return err
+				}
+				return saveAllowanceChange(tx, allowance)
+			}
+		}
+		return nil
+	})
+}
+
+// RevertAllowancesFromBlock function: Restores the allowances changed at or above a height to
+// the last value set below it, allowances first set at or above the height are removed
+func (r *TokenRepository) RevertAllowancesFromBlock(blockNumber int64) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("block_number >= ?", blockNumber).Delete(&models.TokenAllowanceChange{}).Error; err != nil {
+			return err
+		}
+
+		var allowances []*models.TokenAllowance
+		if err := tx.Where("block_number >= ?", blockNumber).Find(&allowances).Error; err != nil {
+			return err
+		}
+
+		for _, allowance := range allowances {
+			var previous models.TokenAllowanceChange
+			err := tx.Where("owner = ? AND spender = ? AND contract = ?", allowance.Owner, allowance.Spender, allowance.Contract).
+				Order("block_number DESC, id DESC").
+				First(&previous).Error
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				if err := tx.Delete(allowance).Error; err != nil {
+					return err
+				}
+				continue
+			}
+			if err != nil {
+				return err
+			}
+
+			allowance.Allowance = previous.Allowance
+			allowance.TransactionID = previous.TransactionID
+			allowance.BlockNumber = previous.BlockNumber
+			allowance.BlockTimestamp = previous.BlockTimestamp
+			if err := tx.Save(allowance).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// GetApprovals function: Retrieves the non-zero allowances granted by an owner, optionally for one token
+func (r *TokenRepository) GetApprovals(owner, contract string, offset, limit int) ([]*models.TokenApprovalResponse, int64, error) {
+	var approvals []*models.TokenApprovalResponse
+	var total int64
+
+	query := r.db.Table("token_allowances AS a").
+		Joins("LEFT JOIN lrc20_token_infos AS t ON t.contract = a.contract").
+		Where("a.owner = ? AND a.allowance <> ?", owner, "0")
+	if contract != "" {
+		query = query.Where("a.contract = ?", contract)
+	}
+
+	// Count total
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.Select("a.*, t.name AS token_name, t.symbol AS token_symbol, t.decimals AS token_decimals, t.total_supply").
+		Order("a.block_number DESC").
+		Offset(offset).Limit(limit).
+		Scan(&approvals).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return approvals, total, nil
+}
+
+// GetHolderSample function: Retrieves a random sample of LRC20 token holders with a positive balance
+func (r *TokenRepository) GetHolderSample(limit int) ([]*models.TokenHolder, error) {
+	var holders []*models.TokenHolder
+	err := r.db.Model(&models.TokenHolder{}).
+		Select("token_holders.*").
+		Joins("JOIN lrc20_token_infos ON lrc20_token_infos.contract = token_holders.contract_address").
+		Where("token_holders.balance > 0").
+		Order("RANDOM()").
+		Limit(limit).
+		Find(&holders).Error
+	return holders, err
+}
+
+// GetHolderCount function: Gets the number of token holders
+func (r *TokenRepository) GetHolderCount(contractAddr string) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.TokenHolder{}).Where("contract_address = ? AND balance > 0", contractAddr).Count(&count).Error
+	return count, err
+}
+
+// UpdateHolderCount function: Updates the number of token holders
+func (r *TokenRepository) UpdateHolderCount(contractAddr string, count int64) error {
+	return r.db.Model(&models.LRC20TokenInfo{}).
+		Where("contract = ?", contractAddr).
+		Update("holders", count).Error
+}
+
+// UpdateLRC20TotalSupply function: Updates the total supply of an LRC20 token
+func (r *TokenRepository) UpdateLRC20TotalSupply(contractAddr, totalSupply string) error {
+	return r.db.Model(&models.LRC20TokenInfo{}).
+		Where("contract = ?", contractAddr).
+		Update("total_supply", totalSupply).Error
+}
+
+// UpdateHolderPercentage function: Updates the percentage of a token holder
+func (r *TokenRepository) UpdateHolderPercentage(contractAddr, address string, percentage float64) error {
+	return r.db.Model(&models.TokenHolder{}).
+		Where("contract_address = ? AND address = ?", contractAddr, address).
+		Update("percentage", percentage).Error
+}
+
+// SearchTokens function: Searches for tokens by name or symbol
+func (r *TokenRepository) SearchTokens(query string, limit int) ([]models.LRC20TokenInfo, error) {
+	var tokens []models.LRC20TokenInfo
+	err := r.db.Where("name ILIKE ? OR symbol ILIKE ?", "%"+query+"%", "%"+query+"%").
+		Limit(limit).
+		Find(&tokens).Error
+	return tokens, err
+}
+
+// SaveContract function: Saves a deployed contract, keeping its verification when it is saved again
+func (r *TokenRepository) SaveContract(contract *models.Contract) error {
+	return r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "address"}},
+		DoUpdates: clause.AssignmentColumns([]string{"name", "owner", "block_number"}),
+	}).Create(contract).Error
+}
+
+// DeleteContractsFromBlock function: Removes the contracts deployed at or above a height
+func (r *TokenRepository) DeleteContractsFromBlock(blockNumber int64) error {
+	return r.db.Where("block_number >= ?", blockNumber).Delete(&models.Contract{}).Error
+}
+
+// SaveLRC10Token function: Saves a LRC10 token
+func (r *TokenRepository) SaveLRC10Token(token *models.TokenInfo) error {
+	return r.db.Save(token).Error
+}
+
+// DeleteLRC10TokensFromBlock function: Removes the LRC10 tokens issued at or above a height
+func (r *TokenRepository) DeleteLRC10TokensFromBlock(blockNumber int64) error {
+	return r.db.Where("block_number >= ?", blockNumber).Delete(&models.TokenInfo{}).Error
+}
+
+// GetLRC10Token function: Retrieves an LRC10 token by ID
+func (r *TokenRepository) GetLRC10Token(id string) (*models.TokenInfo, error) {
+	var token models.TokenInfo
+	err := r.db.Where("id = ?", id).First(&token).Error
+	if err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// GetLRC10TokensByIDs function: Retrieves the LRC10 tokens with the given IDs
+func (r *TokenRepository) GetLRC10TokensByIDs(ids []string) ([]*models.TokenInfo, error) {
+	var tokens []*models.TokenInfo
+	if len(ids) == 0 {
+		return tokens, nil
+	}
+	err := r.db.Where("id IN ?", ids).Find(&tokens).Error
+	return tokens, err
+}
+
+// GetLRC20Holdings function: Retrieves the LRC20 tokens held by an address with their metadata
+func (r *TokenRepository) GetLRC20Holdings(address string) ([]*models.PortfolioToken, error) {
+	var holdings []*models.PortfolioToken
+	err := r.db.Table("token_holders AS h").
+		Select("h.contract_address AS id, t.name, t.symbol, t.decimals, h.balance::TEXT AS balance").
+		Joins("JOIN lrc20_token_infos AS t ON t.contract = h.contract_address").
+		Where("h.address = ? AND h.balance > 0", address).
+		Order("t.holders DESC").
+		Scan(&holdings).Error
+	return holdings, err
+}
+
+// ApplyAssetTransfer function: Saves an LRC10 transfer, moves the holder balances, records the
+// balance deltas and updates the transfer and holder counts of the asset. untrackedAddress is
+// the issuance address, which has no holder row. A transfer already saved is not applied again.
+func (r *TokenRepository) ApplyAssetTransfer(transfer *models.TokenTransferResponse, untrackedAddress string) error {
+	value, ok := new(big.Int).SetString(transfer.Value, 10)
+	if !ok {
+		return errors.New("invalid transfer value")
+	}
+
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(transfer)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		holders, err := moveHolderBalances(tx, transfer, value, untrackedAddress)
+		if err != nil {
+			return err
+		}
+		if err := saveBalanceDeltas(tx, transferDeltas(transfer, value, untrackedAddress)); err != nil {
+			return err
+		}
+		return tx.Model(&models.TokenInfo{}).
+			Where("id = ?", transfer.TokenAddress).
+			Updates(map[string]interface{}{
+				"transfers": gorm.Expr("transfers + 1"),
+				"holders":   gorm.Expr("holders + ?", holders),
+			}).Error
+	})
+}
+
+// GetTokenTransfers function: Retrieves transfers for a token
+func (r *TokenRepository) GetTokenTransfers(contract, from, to string, offset, limit int, sort string) ([]*models.TokenTransferResponse, int64, error) {
+	var transfers []*models.TokenTransferResponse
+	var total int64
+
+	query := r.db.Model(&models.TokenTransferResponse{})
+
+	if contract != "" {
+		query = query.Where("token_address = ?", contract)
+	}
+	if from != "" {
+		query = query.Where("from = ?", from)
+	}
+	if to != "" {
+		query = query.Where("to = ?", to)
+	}
+
+	// Count total
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Apply sorting
+	if sort != "" {
+		if sort[0] == '-' {
+			query = query.Order(sort[1:] + " DESC")
+		} else {
+			query = query.Order(sort + " ASC")
+		}
+	} else {
+		query = query.Order("block_timestamp DESC")
+	}
+
+	// Apply pagination
+	if err := query.Offset(offset).Limit(limit).Find(&transfers).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return transfers, total, nil
+}
+
+// GetTokensOverview function: Retrieves token overview statistics
+func (r *TokenRepository) GetTokensOverview(filter string, offset, limit int, sort string) ([]json.RawMessage, int64, error) {
+	// This would aggregate data from multiple tables
+	var results []json.RawMessage
+	var total int64 = 0
+	return results, total, nil
+}
+
+// SaveMarketData function: Saves price feed quotes
+func (r *TokenRepository) SaveMarketData(quotes []*models.MarketData) error {
+	if len(quotes) == 0 {
+		return nil
+	}
+	return r.db.Create(&quotes).Error
+}
+
+// GetMarketData function: Gets the last quote of a pair taken at or before a millisecond
+// timestamp, the latest quote when at is 0
+func (r *TokenRepository) GetMarketData(pair string, at int64) (*models.MarketData, error) {
+	var data models.MarketData
+	query := r.db.Where("pair = ?", pair)
+	if at > 0 {
+		query = query.Where("timestamp <= ?", at)
+	}
+	err := query.Order("timestamp DESC").First(&data).Error
+	return &data, err
+}
+
+// GetTokenPrice function: Retrieves the USD price of a symbol from the price feed quotes,
+// at or before a millisecond timestamp or the latest when at is 0
+func (r *TokenRepository) GetTokenPrice(symbol string, at int64) (*models.TokenPriceResponse, error) {
+	data, err := r.GetMarketData(models.MarketDataPair(symbol), at)
+	if err != nil {
+		return nil, err
+	}
+
+	price := &models.TokenPriceResponse{
+		Source:    data.Source,
+		Timestamp: data.Timestamp,
+	}
+	price.Price, _ = strconv.ParseFloat(data.Price, 64)
+	price.Change24h, _ = strconv.ParseFloat(data.Change24h, 64)
+	price.Volume24h, _ = strconv.ParseFloat(data.Volume24h, 64)
+	price.MarketCap, _ = strconv.ParseFloat(data.MarketCap, 64)
+	return price, nil
+}
+
+// GetParticipations function: Retrieves token participations
+func (r *TokenRepository) GetParticipations(offset, limit int) ([]json.RawMessage, int64, error) {
+	var participations []json.RawMessage
+	var total int64 = 0
+	return participations, total, nil
+}
+
+// TokenPosition represents a single token position
+type TokenPosition struct {
+	Address    string  `json:"address"`
+	Balance    string  `json:"balance"`
+	Percentage float64 `json:"percentage"`
+	Rank       int     `json:"rank"`
+}
+
+// GetTokenPositionDistribution function: Retrieves token holder distribution
+func (r *TokenRepository) GetTokenPositionDistribution(contract string, limit int) ([]TokenPosition, error) {
+	var positions []TokenPosition
+	err := r.db.Raw(`
+		SELECT address, balance, percentage, 
+		ROW_NUMBER() OVER (ORDER BY balance DESC) as rank
+		FROM token_holders
+		WHERE contract_address = ? AND balance > 0
+		ORDER BY balance DESC
+		LIMIT ?
+	`, contract, limit).Scan(&positions).Error
+	if err != nil {
+		return nil, err
+	}
+	return positions, nil
+}
+
+// GetWinkFund function: Retrieves WINK fund information
+func (r *TokenRepository) GetWinkFund() (*models.WinkFundResponse, error) {
+	var fund models.WinkFundResponse
+	// Query from database - this is a placeholder
+	// In real implementation, you would query from a table
+	err := r.db.Raw("SELECT total, burned, circulating FROM wink_fund ORDER BY timestamp DESC LIMIT 1").Scan(&fund).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &models.WinkFundResponse{
+				Total:       0,
+				Burned:      0,
+				Circulating: 0,
+			}, nil
+		}
+		return nil, err
+	}
+	return &fund, nil
+}
+
+// GetWinkGraphic function: Retrieves WINK graphic data
+func (r *TokenRepository) GetWinkGraphic() (*models.GraphicResponse, error) {
+	var graphic models.GraphicResponse
+	// Query from database - this is a placeholder
+	// In real implementation, you would query time series data
+	err := r.db.Raw(`
+		SELECT array_agg(date) as labels, array_agg(value) as data 
+		FROM wink_daily_stats 
+		ORDER BY date DESC LIMIT 30
+	`).Scan(&graphic).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &models.GraphicResponse{
+				Labels: []string{},
+				Data:   []float64{},
+			}, nil
+		}
+		return nil, err
+	}
+	return &graphic, nil
+}
+
+// GetJSTFund function: Retrieves JST fund information
+func (r *TokenRepository) GetJSTFund() (*models.JSTFundResponse, error) {
+	var fund models.JSTFundResponse
+	// Query from database - this is a placeholder
+	err := r.db.Raw("SELECT total, burned, circulating FROM jst_fund ORDER BY timestamp DESC LIMIT 1").Scan(&fund).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &models.JSTFundResponse{
+				Total:       0,
+				Burned:      0,
+				Circulating: 0,
+			}, nil
+		}
+		return nil, err
+	}
+	return &fund, nil
+}
+
+// GetJSTGraphic function: Retrieves JST graphic data
+func (r *TokenRepository) GetJSTGraphic() (*models.GraphicResponse, error) {
+	var graphic models.GraphicResponse
+	// Query from database - this is a placeholder
+	err := r.db.Raw(`
+		SELECT array_agg(date) as labels, array_agg(value) as data 
+		FROM jst_daily_stats 
+		ORDER BY date DESC LIMIT 30
+	`).Scan(&graphic).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &models.GraphicResponse{
+				Labels: []string{},
+				Data:   []float64{},
+			}, nil
+		}
+		return nil, err
+	}
+	return &graphic, nil
+}
+
+// GetBitTorrentGraphic function: Retrieves BitTorrent graphic data
+func (r *TokenRepository) GetBitTorrentGraphic() (*models.GraphicResponse, error) {
+	var graphic models.GraphicResponse
+	// Query from database - this is a placeholder
+	err := r.db.Raw(`
+		SELECT array_agg(date) as labels, array_agg(value) as data 
+		FROM btt_daily_stats 
+		ORDER BY date DESC LIMIT 30
+	`).Scan(&graphic).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &models.GraphicResponse{
+				Labels: []string{},
+				Data:   []float64{},
+			}, nil
+		}
+		return nil, err
+	}
+	return &graphic, nil
+}
+
+// GetAssetTransfers function: Retrieves asset transfers
+func (r *TokenRepository) GetAssetTransfers(assetName string, offset, limit int, sort string) ([]*models.TokenTransferResponse, int64, error) {
+	var transfers []*models.TokenTransferResponse
+	var total int64
+
+	query := r.db.Model(&models.TokenTransferResponse{})
+	if assetName != "" {
+		query = query.Where("token_symbol = ? OR token_address = ?", assetName, assetName)
+	}
+
+	// Count total
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Apply sorting
+	if sort != "" {
+		if sort[0] == '-' {
+			query = query.Order(sort[1:] + " DESC")
+		} else {
+			query = query.Order(sort + " ASC")
+		}
+	} else {
+		query = query.Order("block_timestamp DESC")
+	}
+
+	// Apply pagination
+	if err := query.Offset(offset).Limit(limit).Find(&transfers).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return transfers, total, nil
 }
\ No newline at end of file
@@ -1,148 +1,171 @@
-package repository
-
-import (
-	"github.com/lindaprotocol/grpc-api-gateway/pkg/lindapb"
-	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
-	"gorm.io/gorm"
-)
-
-type TransactionRepository struct {
-	db *gorm.DB
-}
-
-func NewTransactionRepository(db *gorm.DB) *TransactionRepository {
-	return &TransactionRepository{db: db}
-}
-
-// SaveTransaction saves a transaction
-func (r *TransactionRepository) SaveTransaction(tx *models.Transaction) error {
-	return r.db.Save(tx).Error
-}
-
-// GetByHash retrieves a transaction by hash
-func (r *TransactionRepository) GetByHash(hash string) (*models.Transaction, error) {
-	var tx models.Transaction
-	err := r.db.Where("hash = ?", hash).First(&tx).Error
-	return &tx, err
-}
-
-// GetTransactions retrieves paginated transactions
-func (r *TransactionRepository) GetTransactions(blockNumber int64, offset, limit int, sort string) ([]*models.Transaction, int64, error) {
-	var txs []*models.Transaction
-	var total int64
-
-	query := r.db.Model(&models.Transaction{})
-
-	if blockNumber > 0 {
-		query = query.Where("block_number = ?", blockNumber)
-	}
-
-	// Count total
-	if err := query.Count(&total).Error; err != nil {
-		return nil, 0, err
-	}
-
-	// Apply sorting
-	if sort != "" {
-		if sort[0] == '-' {
-			query = query.Order(sort[1:] + " DESC")
-		} else {
-			query = query.Order(sort + " ASC")
-		}
-	} else {
-		query = query.Order("block_timestamp DESC")
-	}
-
-	// Apply pagination
-	if err := query.Offset(offset).Limit(limit).Find(&txs).Error; err != nil {
-		return nil, 0, err
-	}
-
-	return txs, total, nil
-}
-
-// GetTransactionsByAddress retrieves transactions for an address
-func (r *TransactionRepository) GetTransactionsByAddress(address string, offset, limit int, sort string) ([]*models.Transaction, int64, error) {
-	var txs []*models.Transaction
-	var total int64
-
-	query := r.db.Model(&models.Transaction{}).
-		Where("from_address = ? OR to_address = ?", address, address)
-
-	// Count total
-	if err := query.Count(&total).Error; err != nil {
-		return nil, 0, err
-	}
-
-	// Apply sorting
-	if sort != "" {
-		if sort[0] == '-' {
-			query = query.Order(sort[1:] + " DESC")
-		} else {
-			query = query.Order(sort + " ASC")
-		}
-	} else {
-		query = query.Order("block_timestamp DESC")
-	}
-
-	// Apply pagination
-	if err := query.Offset(offset).Limit(limit).Find(&txs).Error; err != nil {
-		return nil, 0, err
-	}
-
-	return txs, total, nil
-}
-
-// GetTransactionsByContract retrieves transactions for a contract
-func (r *TransactionRepository) GetTransactionsByContract(contract string, offset, limit int, sort string) ([]*models.Transaction, int64, error) {
-	var txs []*models.Transaction
-	var total int64
-
-	query := r.db.Model(&models.Transaction{}).
-		Where("contract_address = ?", contract)
-
-	// Count total
-	if err := query.Count(&total).Error; err != nil {
-		return nil, 0, err
-	}
-
-	// Apply sorting
-	if sort != "" {
-		if sort[0] == '-' {
-			query = query.Order(sort[1:] + " DESC")
-		} else {
-			query = query.Order(sort + " ASC")
-		}
-	} else {
-		query = query.Order("block_timestamp DESC")
-	}
-
-	// Apply pagination
-	if err := query.Offset(offset).Limit(limit).Find(&txs).Error; err != nil {
-		return nil, 0, err
-	}
-
-	return txs, total, nil
-}
-
-// GetInternalTransactionsByAddress retrieves internal transactions for an address
-func (r *TransactionRepository) GetInternalTransactionsByAddress(address string, offset, limit int, sort string) ([]*models.InternalTransaction, int64, error) {
-	var txs []*models.InternalTransaction
-	var total int64
-
-	// This would need a separate internal_transactions table
-	// For now, return empty
-	return txs, total, nil
-}
-
-// UpdateTransactionWithInfo updates a transaction with info data
-func (r *TransactionRepository) UpdateTransactionWithInfo(info *lindapb.TransactionInfo) error {
-	return r.db.Model(&models.Transaction{}).
-		Where("hash = ?", string(info.Id)).
-		Updates(map[string]interface{}{
-			"fee":          info.Fee,
-			"energy_used":  info.Receipt.EnergyUsage,
-			"net_usage":    info.Receipt.NetUsage,
-			"result":       info.Result,
-		}).Error
+package repository
+
+import (
+	"github.com/lindaprotocol/grpc-api-gateway/pkg/lindapb"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
+	"gorm.io/gorm"
+)
+
+type TransactionRepository struct {
+	db *gorm.DB
+}
+
+func NewTransactionRepository(db *gorm.DB) *TransactionRepository {
+	return &TransactionRepository{db: db}
+}
+
+// SaveTransaction saves a transaction
+func (r *TransactionRepository) SaveTransaction(tx *models.Transaction) error {
+	return r.db.Save(tx).Error
+}
+
+// GetByHash retrieves a transaction by hash
+func (r *TransactionRepository) GetByHash(hash string) (*models.Transaction, error) {
+	var tx models.Transaction
+	err := r.db.Where("hash = ?", hash).First(&tx).Error
+	return &tx, err
+}
+
+// GetTransactions retrieves paginated transactions, optionally only confirmed or only unconfirmed ones
+func (r *TransactionRepository) GetTransactions(blockNumber int64, offset, limit int, sort string, onlyConfirmed, onlyUnconfirmed bool) ([]*models.Transaction, int64, error) {
+	var txs []*models.Transaction
+	var total int64
+
+	query := r.db.Model(&models.Transaction{})
+
+	if blockNumber > 0 {
+		query = query.Where("block_number = ?", blockNumber)
+	}
+	if onlyConfirmed {
+		query = query.Where("unconfirmed = ?", false)
+	}
+	if onlyUnconfirmed {
+		query = query.Where("unconfirmed = ?", true)
+	}
+
+	// Count total
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Apply sorting
+	if sort != "" {
+		if sort[0] == '-' {
+			query = query.Order(sort[1:] + " DESC")
+		} else {
+			query = query.Order(sort + " ASC")
+		}
+	} else {
+		query = query.Order("block_timestamp DESC")
+	}
+
+	// Apply pagination
+	if err := query.Offset(offset).Limit(limit).Find(&txs).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return txs, total, nil
+}
+
+// GetTransactionsByAddress retrieves transactions for an address
+func (r *TransactionRepository) GetTransactionsByAddress(address string, offset, limit int, sort string) ([]*models.Transaction, int64, error) {
+	var txs []*models.Transaction
+	var total int64
+
+	query := r.db.Model(&models.Transaction{}).
+		Where("from_address = ? OR to_address = ?", address, address)
+
+	// Count total
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Apply sorting
+	if sort != "" {
+		if sort[0] == '-' {
+			query = query.Order(sort[1:] + " DESC")
+		} else {
+			query = query.Order(sort + " ASC")
+		}
+	} else {
+		query = query.Order("block_timestamp DESC")
+	}
+
+	// Apply pagination
+	if err := query.Offset(offset).Limit(limit).Find(&txs).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return txs, total, nil
+}
+
+// GetTransactionsByContract retrieves transactions for a contract
+func (r *TransactionRepository) GetTransactionsByContract(contract string, offset, limit int, sort string) ([]*models.Transaction, int64, error) {
+	var txs []*models.Transaction
+	var total int64
+
+	query := r.db.Model(&models.Transaction{}).
+		Where("contract_address = ?", contract)
+
+	// Count total
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Apply sorting
+	if sort != "" {
+		if sort[0] == '-' {
+			query = query.Order(sort[1:] + " DESC")
+		} else {
+			query = query.Order(sort + " ASC")
+		}
+	} else {
+		query = query.Order("block_timestamp DESC")
+	}
+
+	// Apply pagination
+	if err := query.Offset(offset).Limit(limit).Find(&txs).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return txs, total, nil
+}
+
+// GetInternalTransactionsByAddress retrieves internal transactions for an address
+func (r *TransactionRepository) GetInternalTransactionsByAddress(address string, offset, limit int, sort string) ([]*models.InternalTransaction, int64, error) {
+	var txs []*models.InternalTransaction
+	var total int64
+
+	// This would need a separate internal_transactions table
+	// For now, return empty
+	return txs, total, nil
+}
+
+// UpdateTransactionWithInfo updates a transaction with info data
+func (r *TransactionRepository) UpdateTransactionWithInfo(info *lindapb.TransactionInfo) error {
+	updates := map[string]interface{}{
+		"fee":    info.Fee,
+		"result": info.Result,
+	}
+	if info.Receipt != nil {
+		updates["energy_used"] = info.Receipt.EnergyUsage
+		updates["energy_fee"] = info.Receipt.EnergyFee
+		updates["net_usage"] = info.Receipt.NetUsage
+		updates["net_fee"] = info.Receipt.NetFee
+	}
+	return r.db.Model(&models.Transaction{}).
+		Where("hash = ?", string(info.Id)).
+		Updates(updates).Error
+}
+
+// ConfirmUpTo marks the transactions of blocks up to and including a height as confirmed
+func (r *TransactionRepository) ConfirmUpTo(blockNumber int64) error {
+	return r.db.Model(&models.Transaction{}).
+		Where("unconfirmed = ? AND block_number <= ?", true, blockNumber).
+		Update("unconfirmed", false).Error
+}
+
+// DeleteFromBlock removes the transactions of blocks at and above a height
+func (r *TransactionRepository) DeleteFromBlock(blockNumber int64) error {
+	return r.db.Where("block_number >= ?", blockNumber).Delete(&models.Transaction{}).Error
 }
\ No newline at end of file
@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
+	"gorm.io/gorm"
+)
+
+// WebhookRepository struct: Repository for webhook subscriptions and deliveries
+type WebhookRepository struct {
+	db *gorm.DB
+}
+
+// NewWebhookRepository function: Creates a new webhook repository
+func NewWebhookRepository(db *gorm.DB) *WebhookRepository {
+	return &WebhookRepository{db: db}
+}
+
+// CreateSubscription function: Saves a new subscription
+func (r *WebhookRepository) CreateSubscription(sub *models.WebhookSubscription) error {
+	return r.db.Create(sub).Error
+}
+
+// GetSubscription function: Retrieves a subscription owned by an API key
+func (r *WebhookRepository) GetSubscription(id, apiKeyID string) (*models.WebhookSubscription, error) {
+	var sub models.WebhookSubscription
+	err := r.db.Where("id = ? AND api_key_id = ?", id, apiKeyID).First(&sub).Error
+	if err != nil {
+		return nil, err
+	}
+	return &sub, nil
+}
+
+// GetSubscriptionsByAPIKey function: Retrieves the subscriptions of an API key
+func (r *WebhookRepository) GetSubscriptionsByAPIKey(apiKeyID string) ([]*models.WebhookSubscription, error) {
+	var subs []*models.WebhookSubscription
+	err := r.db.Where("api_key_id = ?", apiKeyID).Order("created_at DESC").Find(&subs).Error
+	return subs, err
+}
+
+// CountSubscriptions function: Counts the subscriptions of an API key
+func (r *WebhookRepository) CountSubscriptions(apiKeyID string) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.WebhookSubscription{}).Where("api_key_id = ?", apiKeyID).Count(&count).Error
+	return count, err
+}
+
+// GetActiveSubscriptions function: Retrieves all active subscriptions
+func (r *WebhookRepository) GetActiveSubscriptions() ([]*models.WebhookSubscription, error) {
+	var subs []*models.WebhookSubscription
+	err := r.db.Where("active = ?", true).Find(&subs).Error
+	return subs, err
+}
+
+// DeleteSubscription function: Deletes a subscription and its pending deliveries
+func (r *WebhookRepository) DeleteSubscription(id, apiKeyID string) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("id = ? AND api_key_id = ?", id, apiKeyID).Delete(&models.WebhookSubscription{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return tx.Where("subscription_id = ? AND status = ?", id, models.DeliveryStatusPending).
+			Delete(&models.WebhookDelivery{}).Error
+	})
+}
+
+// EnqueueDelivery function: Queues a delivery for the dispatcher
+func (r *WebhookRepository) EnqueueDelivery(delivery *models.WebhookDelivery) error {
+	return r.db.Create(delivery).Error
+}
+
+// GetDueDeliveries function: Retrieves pending deliveries whose next attempt is due, except those
+// of the excluded subscriptions. Deliveries waiting for solidification are held back until
+// solidifiedBlock reaches them.
+func (r *WebhookRepository) GetDueDeliveries(now time.Time, solidifiedBlock int64, exclude []string, limit int) ([]*models.WebhookDelivery, error) {
+	var deliveries []*models.WebhookDelivery
+	query := r.db.
+		Where("status = ? AND next_attempt_at <= ?", models.DeliveryStatusPending, now).
+		Where("wait_for_solidified = ? OR block_number <= ?", false, solidifiedBlock)
+	if len(exclude) > 0 {
+		query = query.Where("subscription_id NOT IN ?", exclude)
+	}
+	err := query.Order("next_attempt_at ASC").Limit(limit).Find(&deliveries).Error
+	return deliveries, err
+}
+
+// DeletePendingFromBlock function: Deletes the pending deliveries of blocks at and above a height
+func (r *WebhookRepository) DeletePendingFromBlock(blockNumber int64) error {
+	return r.db.Where("status = ? AND block_number >= ?", models.DeliveryStatusPending, blockNumber).
+		Delete(&models.WebhookDelivery{}).Error
+}
+
+// UpdateDelivery function: Persists the outcome of a delivery attempt
+func (r *WebhookRepository) UpdateDelivery(delivery *models.WebhookDelivery) error {
+	return r.db.Save(delivery).Error
+}
+
+// GetDeliveries function: Retrieves the delivery log of a subscription
+func (r *WebhookRepository) GetDeliveries(subscriptionID, status string, offset, limit int) ([]*models.WebhookDelivery, int64, error) {
+	var deliveries []*models.WebhookDelivery
+	var total int64
+
+	query := r.db.Model(&models.WebhookDelivery{}).Where("subscription_id = ?", subscriptionID)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	// Count total
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if err := query.Order("created_at DESC").Offset(offset).Limit(limit).Find(&deliveries).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return deliveries, total, nil
+}
+
+// ReplayDelivery function: Puts a delivered or dead-lettered delivery back in the queue
+func (r *WebhookRepository) ReplayDelivery(id, subscriptionID string) (*models.WebhookDelivery, error) {
+	var delivery models.WebhookDelivery
+	if err := r.db.Where("id = ? AND subscription_id = ?", id, subscriptionID).First(&delivery).Error; err != nil {
+		return nil, err
+	}
+
+	delivery.Status = models.DeliveryStatusPending
+	delivery.Attempts = 0
+	delivery.LastError = ""
+	delivery.NextAttemptAt = time.Now()
+	delivery.DeliveredAt = nil
+	if err := r.db.Save(&delivery).Error; err != nil {
+		return nil, err
+	}
+	return &delivery, nil
+}
+
+// GetSubscriptionByID function: Retrieves a subscription regardless of owner
+func (r *WebhookRepository) GetSubscriptionByID(id string) (*models.WebhookSubscription, error) {
+	var sub models.WebhookSubscription
+	if err := r.db.Where("id = ?", id).First(&sub).Error; err != nil {
+		return nil, err
+	}
+	return &sub, nil
+}
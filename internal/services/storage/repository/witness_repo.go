@@ -0,0 +1,222 @@
+package repository
+
+import (
+	"database/sql"
+	"sort"
+
+	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// WitnessRepository struct: Repository for witness production, vote and brokerage history
+type WitnessRepository struct {
+	db *gorm.DB
+}
+
+// NewWitnessRepository function: Creates a new witness repository
+func NewWitnessRepository(db *gorm.DB) *WitnessRepository {
+	return &WitnessRepository{db: db}
+}
+
+// SaveMissedSlots function: Saves the slots missed before a block, skipping slots already saved
+func (r *WitnessRepository) SaveMissedSlots(slots []*models.WitnessMissedSlot) error {
+	if len(slots) == 0 {
+		return nil
+	}
+	return r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&slots).Error
+}
+
+// SaveVoteSnapshots function: Saves the votes of witnesses at the start of a cycle, keeping
+// snapshots already taken for that cycle
+func (r *WitnessRepository) SaveVoteSnapshots(snapshots []*models.WitnessVoteSnapshot) error {
+	if len(snapshots) == 0 {
+		return nil
+	}
+	return r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&snapshots).Error
+}
+
+// SaveBrokerageChange function: Saves a brokerage change, keeping the one already saved for its
+// transaction
+func (r *WitnessRepository) SaveBrokerageChange(change *models.WitnessBrokerageChange) error {
+	return r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(change).Error
+}
+
+// DeleteFromBlock function: Removes the missed slots and brokerage changes of blocks at and above a height
+func (r *WitnessRepository) DeleteFromBlock(blockNumber int64) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("block_number >= ?", blockNumber).Delete(&models.WitnessMissedSlot{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("block_number >= ?", blockNumber).Delete(&models.WitnessBrokerageChange{}).Error
+	})
+}
+
+// trackedFrom returns the later of fromTime and the start of the first maintenance cycle the
+// witness indexer followed live, false when it followed none. Missed slots are only recorded
+// for those cycles, the production of earlier cycles would look perfect.
+func (r *WitnessRepository) trackedFrom(fromTime int64) (int64, bool, error) {
+	var first sql.NullInt64
+	if err := r.db.Model(&models.WitnessVoteSnapshot{}).Select("MIN(cycle_start)").Scan(&first).Error; err != nil {
+		return 0, false, err
+	}
+	if !first.Valid {
+		return 0, false, nil
+	}
+	if first.Int64 > fromTime {
+		fromTime = first.Int64
+	}
+	return fromTime, true, nil
+}
+
+// GetCycleStats function: Retrieves the blocks produced and slots missed by a witness per
+// maintenance cycle since a timestamp, limited to the cycles the indexer followed live.
+// Cycles are interval milliseconds long and aligned on anchor.
+func (r *WitnessRepository) GetCycleStats(witness string, fromTime, anchor, interval int64) ([]*models.WitnessCycleStat, error) {
+	fromTime, tracked, err := r.trackedFrom(fromTime)
+	if err != nil || !tracked {
+		return []*models.WitnessCycleStat{}, err
+	}
+
+	var produced, missed []struct {
+		Cycle int64
+		Count int64
+	}
+
+	err = r.db.Model(&models.Block{}).
+		Select("FLOOR((timestamp - ?)::NUMERIC / ?)::BIGINT AS cycle, COUNT(*) AS count", anchor, interval).
+		Where("witness_address = ? AND timestamp >= ?", witness, fromTime).
+		Group("cycle").
+		Scan(&produced).Error
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.db.Model(&models.WitnessMissedSlot{}).
+		Select("FLOOR((slot_timestamp - ?)::NUMERIC / ?)::BIGINT AS cycle, COUNT(*) AS count", anchor, interval).
+		Where("witness = ? AND slot_timestamp >= ?", witness, fromTime).
+		Group("cycle").
+		Scan(&missed).Error
+	if err != nil {
+		return nil, err
+	}
+
+	stats := make(map[int64]*models.WitnessCycleStat)
+	stat := func(cycle int64) *models.WitnessCycleStat {
+		if s, ok := stats[cycle]; ok {
+			return s
+		}
+		s := &models.WitnessCycleStat{CycleStart: anchor + cycle*interval}
+		stats[cycle] = s
+		return s
+	}
+	for _, p := range produced {
+		stat(p.Cycle).Produced = p.Count
+	}
+	for _, m := range missed {
+		stat(m.Cycle).Missed = m.Count
+	}
+
+	cycles := make([]*models.WitnessCycleStat, 0, len(stats))
+	for _, s := range stats {
+		s.Expected = s.Produced + s.Missed
+		if s.Expected > 0 {
+			s.Productivity = float64(s.Produced) / float64(s.Expected)
+		}
+		cycles = append(cycles, s)
+	}
+	// Most recent cycle first
+	sort.Slice(cycles, func(i, j int) bool {
+		return cycles[i].CycleStart > cycles[j].CycleStart
+	})
+	return cycles, nil
+}
+
+// GetProduction function: Retrieves the blocks produced and slots missed by every witness since a
+// timestamp, limited to the cycles the indexer followed live
+func (r *WitnessRepository) GetProduction(fromTime int64) (map[string]*models.WitnessProduction, error) {
+	fromTime, tracked, err := r.trackedFrom(fromTime)
+	if err != nil || !tracked {
+		return map[string]*models.WitnessProduction{}, err
+	}
+
+	var rows []*models.WitnessProduction
+	err = r.db.Raw(`
+		SELECT witness, SUM(produced) AS produced, SUM(missed) AS missed
+		FROM (
+			SELECT witness_address AS witness, COUNT(*) AS produced, 0 AS missed
+			FROM blocks WHERE timestamp >= ?
+			GROUP BY witness_address
+			UNION ALL
+			SELECT witness, 0 AS produced, COUNT(*) AS missed
+			FROM witness_missed_slots WHERE slot_timestamp >= ?
+			GROUP BY witness
+		) AS production
+		GROUP BY witness
+	`, fromTime, fromTime).Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	production := make(map[string]*models.WitnessProduction, len(rows))
+	for _, row := range rows {
+		production[row.Witness] = row
+	}
+	return production, nil
+}
+
+// GetAverageBlockGap function: Gets the average time in milliseconds between the blocks of a
+// witness and the blocks before them since a timestamp. The gap grows with the slots missed
+// before the block, it is not the time the witness took to produce it.
+func (r *WitnessRepository) GetAverageBlockGap(witness string, fromTime int64) (float64, error) {
+	var gap float64
+	err := r.db.Raw(`
+		SELECT COALESCE(AVG(gap), 0)
+		FROM (
+			SELECT witness_address, timestamp - LAG(timestamp) OVER (ORDER BY number) AS gap
+			FROM blocks
+			WHERE timestamp >= ?
+		) AS gaps
+		WHERE witness_address = ? AND gap IS NOT NULL
+	`, fromTime, witness).Scan(&gap).Error
+	return gap, err
+}
+
+// GetVoteHistory function: Retrieves the most recent vote snapshots of a witness
+func (r *WitnessRepository) GetVoteHistory(witness string, limit int) ([]*models.WitnessVoteSnapshot, error) {
+	var snapshots []*models.WitnessVoteSnapshot
+	err := r.db.Where("witness = ?", witness).
+		Order("cycle_start DESC").
+		Limit(limit).
+		Find(&snapshots).Error
+	return snapshots, err
+}
+
+// GetBrokerageHistory function: Retrieves the most recent brokerage changes of a witness
+func (r *WitnessRepository) GetBrokerageHistory(witness string, limit int) ([]*models.WitnessBrokerageChange, error) {
+	var changes []*models.WitnessBrokerageChange
+	err := r.db.Where("witness = ?", witness).
+		Order("block_number DESC").
+		Limit(limit).
+		Find(&changes).Error
+	return changes, err
+}
+
+// GetLatestBrokerages function: Gets the last indexed brokerage of every witness that changed it
+func (r *WitnessRepository) GetLatestBrokerages() (map[string]int, error) {
+	var changes []*models.WitnessBrokerageChange
+	err := r.db.Raw(`
+		SELECT DISTINCT ON (witness) *
+		FROM witness_brokerage_changes
+		ORDER BY witness, block_number DESC, id DESC
+	`).Scan(&changes).Error
+	if err != nil {
+		return nil, err
+	}
+
+	brokerages := make(map[string]int, len(changes))
+	for _, change := range changes {
+		brokerages[change.Witness] = change.Brokerage
+	}
+	return brokerages, nil
+}
@@ -0,0 +1,283 @@
+package tagging
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lindaprotocol/grpc-api-gateway/internal/config"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/storage/repository"
+	"github.com/lindaprotocol/grpc-api-gateway/pkg/utils"
+)
+
+// Actions named in the first line of a signed tag message
+const (
+	ActionInsert   = "insert"
+	ActionUpdate   = "update"
+	ActionDelete   = "delete"
+	ActionVote     = "vote"
+	ActionModerate = "moderate"
+)
+
+// maxTagLength is the length of the tag column
+const maxTagLength = 100
+
+// Errors returned for tag requests that are not accepted
+var (
+	ErrInvalidSignature = errors.New("invalid signature")
+	ErrExpiredSignature = errors.New("signature timestamp is outside the allowed age")
+	ErrInvalidAddress   = errors.New("invalid address")
+	ErrInvalidTag       = errors.New("tag must be 1 to 100 characters on one line")
+	ErrInvalidVote      = errors.New("vote must be 1 or -1")
+	ErrInvalidAction    = errors.New("action must be approve or reject")
+	ErrDuplicateTag     = errors.New("owner already tagged the address with this tag")
+	ErrNotOwner         = errors.New("signer does not own the tag")
+	ErrNotModerator     = errors.New("signer is not a tag moderator")
+	ErrStaleModeration  = errors.New("tag was moderated at or after the signature timestamp")
+	ErrStaleUpdate      = errors.New("tag was changed at or after the signature timestamp")
+	ErrStaleVote        = errors.New("voter already voted at or after the signature timestamp")
+)
+
+// Service accepts tag submissions, edits, votes and moderation decisions signed with
+// Linda keys. Each request carries the signature of Message for its action, hashed as a
+// Linda signed message, by the address acting on the tag and is rejected when the
+// signer does not match.
+type Service struct {
+	config     config.TagsConfig
+	tagRepo    *repository.TagRepository
+	moderators map[string]bool
+	curators   map[string]bool
+}
+
+func NewService(cfg config.TagsConfig, tagRepo *repository.TagRepository) *Service {
+	if cfg.SignatureMaxAge <= 0 {
+		cfg.SignatureMaxAge = 10 * time.Minute
+	}
+	s := &Service{
+		config:     cfg,
+		tagRepo:    tagRepo,
+		moderators: make(map[string]bool, len(cfg.Moderators)),
+		curators:   make(map[string]bool, len(cfg.Curators)),
+	}
+	for _, address := range cfg.Moderators {
+		s.moderators[address] = true
+	}
+	for _, address := range cfg.Curators {
+		s.curators[address] = true
+	}
+	return s
+}
+
+// Message returns the text signed for a tag action: the action, the millisecond timestamp and
+// the fields of the action on separate lines. Free text such as a description comes last.
+//
+//	insert: address, owner, tag, description
+//	update: id, tag, description
+//	delete: id
+//	vote: id, voter, vote
+//	moderate: id, moderator, action
+func Message(action string, timestamp int64, fields ...string) string {
+	return strings.Join(append([]string{"Linda tag " + action, strconv.FormatInt(timestamp, 10)}, fields...), "\n")
+}
+
+// IsCurator reports whether the tags of an address skip moderation and outrank community tags
+func (s *Service) IsCurator(address string) bool {
+	return s.curators[address]
+}
+
+// Insert saves a tag signed by its owner. Curator tags are approved at once, community tags
+// wait in the moderation queue when approval is required.
+func (s *Service) Insert(req *models.TagInsertRequest) (*models.Tag, error) {
+	if !utils.IsValidBase58Address(req.Address) || !utils.IsValidBase58Address(req.Owner) {
+		return nil, ErrInvalidAddress
+	}
+	if !validTag(req.Tag) {
+		return nil, ErrInvalidTag
+	}
+	message := Message(ActionInsert, req.Timestamp, req.Address, req.Owner, req.Tag, req.Description)
+	if err := s.verify(req.Owner, req.Timestamp, req.Signature, message); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.tagRepo.FindTag(req.Address, req.Owner, req.Tag)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, ErrDuplicateTag
+	}
+
+	tag := &models.Tag{
+		Address:     req.Address,
+		Tag:         req.Tag,
+		Description: req.Description,
+		Owner:       req.Owner,
+		Signature:   req.Signature,
+		SignedAt:    req.Timestamp,
+		Status:      s.initialStatus(req.Owner),
+		Curated:     s.IsCurator(req.Owner),
+		CreatedAt:   time.Now().Unix(),
+	}
+	if _, err := s.tagRepo.InsertTag(tag); err != nil {
+		return nil, err
+	}
+	return tag, nil
+}
+
+// Update changes the text of a tag signed by its owner. A changed community tag goes back
+// to the moderation queue when approval is required. Updates apply in signing order.
+func (s *Service) Update(req *models.TagUpdateRequest) (*models.Tag, error) {
+	if !validTag(req.Tag) {
+		return nil, ErrInvalidTag
+	}
+	tag, err := s.tagRepo.GetTagByID(req.ID)
+	if err != nil {
+		return nil, err
+	}
+	message := Message(ActionUpdate, req.Timestamp, strconv.Itoa(int(req.ID)), req.Tag, req.Description)
+	if err := s.verifyOwner(tag, req.Timestamp, req.Signature, message); err != nil {
+		return nil, err
+	}
+
+	// Changes apply in signing order, an older one cannot be replayed
+	if req.Timestamp <= tag.SignedAt {
+		return nil, ErrStaleUpdate
+	}
+	status := s.initialStatus(tag.Owner)
+	updated, err := s.tagRepo.UpdateTag(tag.ID, req.Tag, req.Description, req.Signature, req.Timestamp, status)
+	if err != nil {
+		return nil, err
+	}
+	if !updated {
+		return nil, ErrStaleUpdate
+	}
+	tag.Tag, tag.Description, tag.Signature, tag.SignedAt, tag.Status = req.Tag, req.Description, req.Signature, req.Timestamp, status
+	tag.ModeratedBy = ""
+	return tag, nil
+}
+
+// Delete removes a tag signed by its owner
+func (s *Service) Delete(req *models.TagDeleteRequest) error {
+	tag, err := s.tagRepo.GetTagByID(req.ID)
+	if err != nil {
+		return err
+	}
+	message := Message(ActionDelete, req.Timestamp, strconv.Itoa(int(req.ID)))
+	if err := s.verifyOwner(tag, req.Timestamp, req.Signature, message); err != nil {
+		return err
+	}
+	return s.tagRepo.DeleteTag(tag.ID)
+}
+
+// Vote records the signed vote of an address on a tag, one vote per signer and tag. A vote
+// replaces the previous vote of the signer only when it was signed later.
+func (s *Service) Vote(req *models.TagVoteRequest) (*models.Tag, error) {
+	if req.Vote != 1 && req.Vote != -1 {
+		return nil, ErrInvalidVote
+	}
+	if !utils.IsValidBase58Address(req.Voter) {
+		return nil, ErrInvalidAddress
+	}
+	tag, err := s.tagRepo.GetTagByID(req.ID)
+	if err != nil {
+		return nil, err
+	}
+	message := Message(ActionVote, req.Timestamp, strconv.Itoa(int(req.ID)), req.Voter, strconv.Itoa(int(req.Vote)))
+	if err := s.verify(req.Voter, req.Timestamp, req.Signature, message); err != nil {
+		return nil, err
+	}
+
+	votes, voted, err := s.tagRepo.VoteTag(&models.TagVote{
+		TagID:     tag.ID,
+		Voter:     req.Voter,
+		Value:     req.Vote,
+		Signature: req.Signature,
+		SignedAt:  req.Timestamp,
+		CreatedAt: time.Now().Unix(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !voted {
+		return nil, ErrStaleVote
+	}
+	tag.Votes = votes
+	return tag, nil
+}
+
+// Moderate approves or rejects a tag on the signed decision of a moderator
+func (s *Service) Moderate(req *models.TagModerateRequest) (*models.Tag, error) {
+	var status string
+	switch req.Action {
+	case models.TagActionApprove:
+		status = models.TagStatusApproved
+	case models.TagActionReject:
+		status = models.TagStatusRejected
+	default:
+		return nil, ErrInvalidAction
+	}
+	tag, err := s.tagRepo.GetTagByID(req.ID)
+	if err != nil {
+		return nil, err
+	}
+	message := Message(ActionModerate, req.Timestamp, strconv.Itoa(int(req.ID)), req.Moderator, req.Action)
+	if err := s.verify(req.Moderator, req.Timestamp, req.Signature, message); err != nil {
+		return nil, err
+	}
+	if !s.moderators[req.Moderator] {
+		return nil, ErrNotModerator
+	}
+
+	// Moderations apply in signing order, an older one cannot be replayed
+	if req.Timestamp <= tag.ModeratedAt {
+		return nil, ErrStaleModeration
+	}
+	moderated, err := s.tagRepo.ModerateTag(tag.ID, status, req.Moderator, req.Timestamp)
+	if err != nil {
+		return nil, err
+	}
+	if !moderated {
+		return nil, ErrStaleModeration
+	}
+	tag.Status, tag.ModeratedBy, tag.ModeratedAt = status, req.Moderator, req.Timestamp
+	return tag, nil
+}
+
+// initialStatus returns the status of a tag submitted or edited by an owner
+func (s *Service) initialStatus(owner string) string {
+	if s.IsCurator(owner) || !s.config.RequireApproval {
+		return models.TagStatusApproved
+	}
+	return models.TagStatusPending
+}
+
+// verifyOwner checks a request on an existing tag is signed by the tag owner
+func (s *Service) verifyOwner(tag *models.Tag, timestamp int64, signature, message string) error {
+	if err := s.verify(tag.Owner, timestamp, signature, message); err != nil {
+		if errors.Is(err, ErrInvalidSignature) {
+			return ErrNotOwner
+		}
+		return err
+	}
+	return nil
+}
+
+// verify checks the age of a signed request and that its signature was made by signer
+func (s *Service) verify(signer string, timestamp int64, signature, message string) error {
+	age := time.Since(time.UnixMilli(timestamp))
+	if age > s.config.SignatureMaxAge || age < -s.config.SignatureMaxAge {
+		return ErrExpiredSignature
+	}
+	recovered, err := utils.RecoverMessageSigner([]byte(message), signature)
+	if err != nil || recovered != signer {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// validTag reports whether a tag fits its column on a single line
+func validTag(tag string) bool {
+	return strings.TrimSpace(tag) != "" && len(tag) <= maxTagLength && !strings.ContainsAny(tag, "\r\n")
+}
@@ -0,0 +1,161 @@
+package telemetry
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/config"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/blockchain"
+	"github.com/lindaprotocol/grpc-api-gateway/pkg/lindapb"
+	"github.com/lindaprotocol/grpc-api-gateway/pkg/utils"
+)
+
+// Headers of a telemetry upload signed with a witness key
+const (
+	HeaderWitness   = "X-Node-Witness"
+	HeaderTimestamp = "X-Node-Timestamp"
+	HeaderSignature = "X-Node-Signature"
+)
+
+// Errors returned for uploads whose witness signature is not accepted
+var (
+	ErrInvalidSignature = errors.New("invalid witness signature")
+	ErrExpiredTimestamp = errors.New("upload timestamp is outside the allowed clock skew")
+	ErrUnknownWitness   = errors.New("signer is not a witness")
+	ErrReplayedUpload   = errors.New("upload timestamp is not newer than the last upload of the witness")
+)
+
+// Authenticator verifies telemetry uploads signed with the key of a witness.
+// Each witness must sign its uploads with increasing timestamps, so an upload
+// cannot be replayed within the allowed clock skew.
+type Authenticator struct {
+	config config.TelemetryConfig
+	client *blockchain.Client
+
+	mu        sync.Mutex
+	witnesses map[string]string // witness address to URL
+	loadedAt  time.Time
+	lastSeen  map[string]int64
+}
+
+func NewAuthenticator(cfg config.TelemetryConfig, client *blockchain.Client) *Authenticator {
+	if cfg.MaxClockSkew <= 0 {
+		cfg.MaxClockSkew = 5 * time.Minute
+	}
+	if cfg.WitnessRefresh <= 0 {
+		cfg.WitnessRefresh = 10 * time.Minute
+	}
+	return &Authenticator{
+		config:   cfg,
+		client:   client,
+		lastSeen: make(map[string]int64),
+	}
+}
+
+// Digest returns the Keccak-256 hash of "<timestamp>.<body>" signed by witness uploads
+func Digest(timestamp int64, body []byte) []byte {
+	return crypto.Keccak256([]byte(strconv.FormatInt(timestamp, 10)), []byte("."), body)
+}
+
+// VerifyWitness checks that signature is the hex secp256k1 signature of
+// Digest(timestamp, body) by the key of witness, a base58 witness address
+func (a *Authenticator) VerifyWitness(ctx context.Context, witness, timestamp, signature string, body []byte) error {
+	millis, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return ErrExpiredTimestamp
+	}
+	skew := time.Since(time.UnixMilli(millis))
+	if skew > a.config.MaxClockSkew || skew < -a.config.MaxClockSkew {
+		return ErrExpiredTimestamp
+	}
+
+	sig, err := utils.DecodeSignature(signature)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+	signer, err := utils.RecoverAddress(Digest(millis, body), sig)
+	if err != nil || signer != witness {
+		return ErrInvalidSignature
+	}
+
+	_, isWitness, err := a.witnessURL(ctx, signer)
+	if err != nil {
+		return fmt.Errorf("failed to load witnesses: %w", err)
+	}
+	if !isWitness {
+		return ErrUnknownWitness
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if millis <= a.lastSeen[signer] {
+		return ErrReplayedUpload
+	}
+	a.lastSeen[signer] = millis
+	return nil
+}
+
+// OwnsNode reports whether host is the node registered by a witness, the host
+// of its URL or an address the host resolves to
+func (a *Authenticator) OwnsNode(ctx context.Context, witness, host string) (bool, error) {
+	rawURL, ok, err := a.witnessURL(ctx, witness)
+	if err != nil || !ok {
+		return false, err
+	}
+	if !strings.Contains(rawURL, "://") {
+		rawURL = "//" + rawURL
+	}
+	parsed, err := url.Parse(rawURL)
+	if err != nil || parsed.Hostname() == "" {
+		return false, nil
+	}
+
+	registered := parsed.Hostname()
+	if strings.EqualFold(registered, host) {
+		return true, nil
+	}
+	if net.ParseIP(registered) != nil || net.ParseIP(host) == nil {
+		return false, nil
+	}
+	addrs, err := net.DefaultResolver.LookupHost(ctx, registered)
+	if err != nil {
+		return false, nil
+	}
+	for _, addr := range addrs {
+		if net.ParseIP(addr).Equal(net.ParseIP(host)) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// witnessURL returns the URL of a witness and whether the address is a witness,
+// reloading the witness list when it is old
+func (a *Authenticator) witnessURL(ctx context.Context, address string) (string, bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if time.Since(a.loadedAt) > a.config.WitnessRefresh {
+		list, err := a.client.ListWitnesses(ctx, &lindapb.EmptyMessage{})
+		if err != nil {
+			return "", false, err
+		}
+		witnesses := make(map[string]string, len(list.Witnesses))
+		for _, w := range list.Witnesses {
+			witnesses[utils.MustHexToBase58(hex.EncodeToString(w.Address))] = w.Url
+		}
+		a.witnesses, a.loadedAt = witnesses, time.Now()
+	}
+
+	witnessURL, ok := a.witnesses[address]
+	return witnessURL, ok, nil
+}
@@ -0,0 +1,75 @@
+package telemetry
+
+import (
+	"sync"
+	"time"
+
+	"github.com/lindaprotocol/grpc-api-gateway/internal/config"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/storage/repository"
+	"github.com/sirupsen/logrus"
+)
+
+// Pruner periodically removes node telemetry older than the retention period
+type Pruner struct {
+	config   config.TelemetryConfig
+	nodeRepo *repository.NodeRepository
+	logger   *logrus.Logger
+	stopChan chan struct{}
+	wg       sync.WaitGroup
+}
+
+func NewPruner(cfg config.TelemetryConfig, nodeRepo *repository.NodeRepository) *Pruner {
+	if cfg.Retention <= 0 {
+		cfg.Retention = 30 * 24 * time.Hour
+	}
+	if cfg.PruneInterval <= 0 {
+		cfg.PruneInterval = time.Hour
+	}
+	return &Pruner{
+		config:   cfg,
+		nodeRepo: nodeRepo,
+		logger:   logrus.New(),
+		stopChan: make(chan struct{}),
+	}
+}
+
+// Start begins pruning
+func (p *Pruner) Start() {
+	p.logger.Info("Starting node telemetry pruner")
+
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+
+		ticker := time.NewTicker(p.config.PruneInterval)
+		defer ticker.Stop()
+
+		for {
+			p.prune()
+
+			select {
+			case <-ticker.C:
+			case <-p.stopChan:
+				return
+			}
+		}
+	}()
+}
+
+// Stop halts pruning
+func (p *Pruner) Stop() {
+	p.logger.Info("Stopping node telemetry pruner")
+	close(p.stopChan)
+	p.wg.Wait()
+}
+
+func (p *Pruner) prune() {
+	deleted, err := p.nodeRepo.DeleteTelemetryBefore(time.Now().Add(-p.config.Retention))
+	if err != nil {
+		p.logger.WithError(err).Error("Failed to prune node telemetry")
+		return
+	}
+	if deleted > 0 {
+		p.logger.WithField("deleted", deleted).Info("Pruned node telemetry")
+	}
+}
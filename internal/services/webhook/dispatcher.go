@@ -0,0 +1,296 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/lindaprotocol/grpc-api-gateway/internal/config"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/blockchain"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/storage/repository"
+	"github.com/lindaprotocol/grpc-api-gateway/pkg/lindapb"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// Headers sent with every delivery
+const (
+	HeaderSignature = "X-Webhook-Signature"
+	HeaderTimestamp = "X-Webhook-Timestamp"
+	HeaderDelivery  = "X-Webhook-Delivery"
+)
+
+// Dispatcher posts queued deliveries to subscriber callbacks, retrying
+// failures with exponential backoff until they are dead-lettered. The due
+// deliveries of a subscription are posted in order by one of a bounded pool
+// of workers, so a slow callback only holds back its own subscription.
+type Dispatcher struct {
+	config     config.WebhookConfig
+	repo       *repository.WebhookRepository
+	client     *blockchain.Client
+	httpClient *http.Client
+	logger     *logrus.Logger
+	stopChan   chan struct{}
+	wg         sync.WaitGroup
+
+	workers  chan struct{}
+	mu       sync.Mutex
+	inFlight map[string]bool // subscriptions a worker is delivering for
+}
+
+func NewDispatcher(cfg config.WebhookConfig, repo *repository.WebhookRepository, client *blockchain.Client) *Dispatcher {
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = 8
+	}
+	if cfg.InitialBackoff <= 0 {
+		cfg.InitialBackoff = 10 * time.Second
+	}
+	if cfg.MaxBackoff <= 0 {
+		cfg.MaxBackoff = time.Hour
+	}
+	if cfg.RequestTimeout <= 0 {
+		cfg.RequestTimeout = 10 * time.Second
+	}
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = 2 * time.Second
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = 100
+	}
+	if cfg.Workers <= 0 {
+		cfg.Workers = 8
+	}
+	if cfg.SubscriptionTimeout <= 0 {
+		cfg.SubscriptionTimeout = 3 * cfg.RequestTimeout
+	}
+
+	return &Dispatcher{
+		config:     cfg,
+		repo:       repo,
+		client:     client,
+		httpClient: NewCallbackClient(cfg.RequestTimeout),
+		logger:     logrus.New(),
+		stopChan:   make(chan struct{}),
+		workers:    make(chan struct{}, cfg.Workers),
+		inFlight:   make(map[string]bool),
+	}
+}
+
+// Start begins polling the delivery queue
+func (d *Dispatcher) Start() {
+	d.logger.Info("Starting webhook dispatcher")
+
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+		ticker := time.NewTicker(d.config.PollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				d.dispatchDue()
+			case <-d.stopChan:
+				return
+			}
+		}
+	}()
+}
+
+// Stop halts the dispatcher and waits for in-flight deliveries
+func (d *Dispatcher) Stop() {
+	d.logger.Info("Stopping webhook dispatcher")
+	close(d.stopChan)
+	d.wg.Wait()
+}
+
+func (d *Dispatcher) dispatchDue() {
+	ctx := context.Background()
+
+	// Deliveries that wait for solidification stay queued until the solid head passes them
+	solidifiedBlock := int64(-1)
+	if block, err := d.client.GetNowBlockSolidity(ctx, &lindapb.EmptyMessage{}); err == nil {
+		solidifiedBlock = block.BlockHeader.RawData.Number
+	} else {
+		d.logger.WithError(err).Warn("Failed to get solidified block, holding solidified deliveries")
+	}
+
+	// Subscriptions still being delivered for are left to their worker
+	deliveries, err := d.repo.GetDueDeliveries(time.Now(), solidifiedBlock, d.busySubscriptions(), d.config.BatchSize)
+	if err != nil {
+		d.logger.WithError(err).Error("Failed to load due webhook deliveries")
+		return
+	}
+
+	// Group by subscription, keeping the queue order
+	var order []string
+	batches := make(map[string][]*models.WebhookDelivery)
+	for _, delivery := range deliveries {
+		if _, ok := batches[delivery.SubscriptionID]; !ok {
+			order = append(order, delivery.SubscriptionID)
+		}
+		batches[delivery.SubscriptionID] = append(batches[delivery.SubscriptionID], delivery)
+	}
+
+	for _, subscriptionID := range order {
+		select {
+		case d.workers <- struct{}{}:
+		case <-d.stopChan:
+			return
+		}
+
+		d.mu.Lock()
+		d.inFlight[subscriptionID] = true
+		d.mu.Unlock()
+
+		d.wg.Add(1)
+		go func(subscriptionID string, batch []*models.WebhookDelivery) {
+			defer d.wg.Done()
+			defer func() {
+				d.mu.Lock()
+				delete(d.inFlight, subscriptionID)
+				d.mu.Unlock()
+				<-d.workers
+			}()
+			d.deliverSubscription(subscriptionID, batch, solidifiedBlock)
+		}(subscriptionID, batches[subscriptionID])
+	}
+}
+
+// busySubscriptions returns the IDs of the subscriptions a worker is delivering for
+func (d *Dispatcher) busySubscriptions() []string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	ids := make([]string, 0, len(d.inFlight))
+	for id := range d.inFlight {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// deliverSubscription posts the due deliveries of one subscription in order. After a
+// failure, or once SubscriptionTimeout is spent, the rest stay queued for a later poll.
+func (d *Dispatcher) deliverSubscription(subscriptionID string, deliveries []*models.WebhookDelivery, solidifiedBlock int64) {
+	sub, err := d.repo.GetSubscriptionByID(subscriptionID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		d.logger.WithError(err).WithField("subscription", subscriptionID).Error("Failed to load webhook subscription")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), d.config.SubscriptionTimeout)
+	defer cancel()
+
+	for _, delivery := range deliveries {
+		if sub == nil || !sub.Active {
+			delivery.Status = models.DeliveryStatusDead
+			delivery.LastError = "subscription removed or inactive"
+			delivery.UpdatedAt = time.Now()
+			d.repo.UpdateDelivery(delivery)
+			continue
+		}
+		if ctx.Err() != nil || !d.deliver(ctx, sub, delivery, solidifiedBlock) {
+			return
+		}
+	}
+}
+
+// deliver performs one attempt, records its outcome and reports whether it succeeded
+func (d *Dispatcher) deliver(ctx context.Context, sub *models.WebhookSubscription, delivery *models.WebhookDelivery, solidifiedBlock int64) bool {
+	body := []byte(delivery.Payload)
+
+	// Confirmation status is resolved at send time, not when the delivery was queued
+	var payload map[string]interface{}
+	if err := json.Unmarshal(body, &payload); err == nil {
+		payload["unconfirmed"] = solidifiedBlock < 0 || delivery.BlockNumber > solidifiedBlock
+		if b, err := json.Marshal(payload); err == nil {
+			body = b
+		}
+	}
+
+	delivery.Attempts++
+	timestamp := time.Now().Unix()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sub.CallbackURL, bytes.NewReader(body))
+	if err != nil {
+		d.recordFailure(delivery, 0, err.Error())
+		return false
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderSignature, "sha256="+Sign(sub.Secret, timestamp, body))
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderDelivery, delivery.ID)
+
+	resp, err := d.httpClient.Do(req)
+	if err != nil {
+		d.recordFailure(delivery, 0, err.Error())
+		return false
+	}
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+	resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		d.recordFailure(delivery, resp.StatusCode, fmt.Sprintf("callback returned status %d", resp.StatusCode))
+		return false
+	}
+
+	now := time.Now()
+	delivery.Status = models.DeliveryStatusDelivered
+	delivery.ResponseCode = resp.StatusCode
+	delivery.LastError = ""
+	delivery.DeliveredAt = &now
+	delivery.UpdatedAt = now
+	if err := d.repo.UpdateDelivery(delivery); err != nil {
+		d.logger.WithError(err).WithField("delivery", delivery.ID).Error("Failed to record webhook delivery")
+	}
+	return true
+}
+
+func (d *Dispatcher) recordFailure(delivery *models.WebhookDelivery, statusCode int, reason string) {
+	delivery.ResponseCode = statusCode
+	delivery.LastError = reason
+	delivery.UpdatedAt = time.Now()
+
+	if delivery.Attempts >= d.config.MaxAttempts {
+		delivery.Status = models.DeliveryStatusDead
+		d.logger.WithFields(logrus.Fields{
+			"delivery": delivery.ID,
+			"attempts": delivery.Attempts,
+		}).Warn("Webhook delivery dead-lettered")
+	} else {
+		delivery.NextAttemptAt = time.Now().Add(d.backoff(delivery.Attempts))
+	}
+
+	if err := d.repo.UpdateDelivery(delivery); err != nil {
+		d.logger.WithError(err).WithField("delivery", delivery.ID).Error("Failed to record webhook failure")
+	}
+}
+
+// backoff doubles the wait after every failed attempt up to MaxBackoff
+func (d *Dispatcher) backoff(attempts int) time.Duration {
+	wait := d.config.InitialBackoff
+	for i := 1; i < attempts; i++ {
+		wait *= 2
+		if wait >= d.config.MaxBackoff {
+			return d.config.MaxBackoff
+		}
+	}
+	return wait
+}
+
+// Sign returns the hex HMAC-SHA256 of "<timestamp>.<body>" keyed with the subscription secret
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,188 @@
+package webhook
+
+import (
+	"encoding/json"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/models"
+	"github.com/lindaprotocol/grpc-api-gateway/internal/services/storage/repository"
+	"github.com/lindaprotocol/grpc-api-gateway/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// Notifier matches indexed transactions and events against active
+// subscriptions and enqueues deliveries for the dispatcher
+type Notifier struct {
+	repo    *repository.WebhookRepository
+	refresh time.Duration
+
+	mu       sync.RWMutex
+	subs     []*models.WebhookSubscription
+	loadedAt time.Time
+}
+
+func NewNotifier(repo *repository.WebhookRepository, refresh time.Duration) *Notifier {
+	if refresh <= 0 {
+		refresh = 30 * time.Second
+	}
+	return &Notifier{
+		repo:    repo,
+		refresh: refresh,
+	}
+}
+
+// NotifyTransaction enqueues deliveries for subscriptions matching a transaction
+func (n *Notifier) NotifyTransaction(tx *models.Transaction) error {
+	var matched []*models.WebhookSubscription
+	for _, sub := range n.subscriptions() {
+		switch sub.FilterType {
+		case models.WebhookFilterAddress:
+			if sub.Address == tx.FromAddress || sub.Address == tx.ToAddress {
+				matched = append(matched, sub)
+			}
+		case models.WebhookFilterContract:
+			if sub.ContractAddress == tx.ContractAddress || sub.ContractAddress == tx.ToAddress {
+				matched = append(matched, sub)
+			}
+		}
+	}
+	if len(matched) == 0 {
+		return nil
+	}
+
+	data := map[string]interface{}{
+		"hash":             tx.Hash,
+		"from_address":     tx.FromAddress,
+		"to_address":       tx.ToAddress,
+		"contract_address": tx.ContractAddress,
+		"contract_type":    tx.ContractType,
+		"amount":           tx.Amount,
+		"fee":              tx.Fee,
+		"result":           tx.Result,
+	}
+	return n.enqueue(matched, "transaction", tx.BlockNumber, tx.BlockTimestamp, tx.Hash, tx.Unconfirmed, data)
+}
+
+// NotifyEvent enqueues deliveries for subscriptions matching a contract event
+func (n *Notifier) NotifyEvent(event *models.EventResponse) error {
+	var matched []*models.WebhookSubscription
+	for _, sub := range n.subscriptions() {
+		switch sub.FilterType {
+		case models.WebhookFilterAddress:
+			if eventInvolves(event, sub.Address) {
+				matched = append(matched, sub)
+			}
+		case models.WebhookFilterContract:
+			if sub.ContractAddress == event.ContractAddress {
+				matched = append(matched, sub)
+			}
+		case models.WebhookFilterEvent:
+			if sub.EventName == event.EventName &&
+				(sub.ContractAddress == "" || sub.ContractAddress == event.ContractAddress) {
+				matched = append(matched, sub)
+			}
+		}
+	}
+	if len(matched) == 0 {
+		return nil
+	}
+
+	data := map[string]interface{}{
+		"contract_address": event.ContractAddress,
+		"event_name":       event.EventName,
+		"event_index":      event.LogIndex,
+		"result":           event.Result,
+		"result_type":      event.ResultType,
+	}
+	return n.enqueue(matched, "event", event.BlockNumber, event.BlockTimestamp, event.TransactionID, event.Unconfirmed, data)
+}
+
+func (n *Notifier) enqueue(subs []*models.WebhookSubscription, eventType string, blockNumber, blockTimestamp int64, txID string, unconfirmed bool, data map[string]interface{}) error {
+	now := time.Now()
+	for _, sub := range subs {
+		payload, err := json.Marshal(&models.WebhookPayload{
+			SubscriptionID: sub.ID,
+			Type:           eventType,
+			BlockNumber:    blockNumber,
+			BlockTimestamp: blockTimestamp,
+			TransactionID:  txID,
+			Unconfirmed:    unconfirmed,
+			Data:           data,
+		})
+		if err != nil {
+			return err
+		}
+
+		delivery := &models.WebhookDelivery{
+			ID:                uuid.New().String(),
+			SubscriptionID:    sub.ID,
+			EventType:         eventType,
+			BlockNumber:       blockNumber,
+			TransactionID:     txID,
+			Payload:           models.JSON(payload),
+			WaitForSolidified: sub.WaitForSolidified,
+			Status:            models.DeliveryStatusPending,
+			NextAttemptAt:     now,
+			CreatedAt:         now,
+			UpdatedAt:         now,
+		}
+		if err := n.repo.EnqueueDelivery(delivery); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Retract drops the pending deliveries of blocks at and above a height after
+// they were rolled back by a reorganization, as part of the rollback transaction
+func (n *Notifier) Retract(tx *gorm.DB, blockNumber int64) error {
+	return repository.NewWebhookRepository(tx).DeletePendingFromBlock(blockNumber)
+}
+
+// subscriptions returns the cached active subscriptions, reloading them
+// from the database once the refresh interval has passed
+func (n *Notifier) subscriptions() []*models.WebhookSubscription {
+	n.mu.RLock()
+	subs, loadedAt := n.subs, n.loadedAt
+	n.mu.RUnlock()
+
+	if time.Since(loadedAt) < n.refresh {
+		return subs
+	}
+
+	fresh, err := n.repo.GetActiveSubscriptions()
+	if err != nil {
+		// Keep matching against the previous set
+		return subs
+	}
+
+	n.mu.Lock()
+	n.subs = fresh
+	n.loadedAt = time.Now()
+	n.mu.Unlock()
+	return fresh
+}
+
+// eventInvolves checks the address parameters of an event, such as the
+// from and to of a Transfer, against a base58 address
+func eventInvolves(event *models.EventResponse, address string) bool {
+	for name, typ := range event.ResultType {
+		if typ != "address" {
+			continue
+		}
+		value, ok := event.Result[name].(string)
+		if !ok {
+			continue
+		}
+		if value == address {
+			return true
+		}
+		if base58Addr, err := utils.HexToBase58(strings.TrimPrefix(value, "0x")); err == nil && base58Addr == address {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,229 @@
+// pkg/utils/signature.go
+package utils
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/lindaprotocol/grpc-api-gateway/pkg/lindapb"
+	"google.golang.org/protobuf/proto"
+)
+
+// MessagePrefix is prepended with the message length to messages before they are hashed,
+// so that a signed message can never be a valid transaction signature
+const MessagePrefix = "\x19Linda Signed Message:\n"
+
+// Permission IDs of the owner and witness permissions of an account, active permissions have higher IDs
+const (
+	OwnerPermissionID   = 0
+	WitnessPermissionID = 1
+)
+
+// Errors returned by signature verification
+var (
+	ErrInvalidSignature  = errors.New("invalid signature")
+	ErrUnknownPermission = errors.New("transaction uses a permission the account does not have")
+	ErrSignerNotAllowed  = errors.New("signer is not a key of the permission")
+	ErrDuplicateSigner   = errors.New("transaction is signed twice by the same key")
+	ErrThresholdNotMet   = errors.New("signature weight is below the permission threshold")
+)
+
+// HashMessage returns the Keccak-256 hash of a message prefixed with MessagePrefix and its length
+func HashMessage(message []byte) []byte {
+	return crypto.Keccak256([]byte(MessagePrefix+strconv.Itoa(len(message))), message)
+}
+
+// SignHash signs a 32-byte hash with a private key. The last byte of the 65-byte
+// signature is the recovery ID plus 27, as produced by Linda wallets.
+func SignHash(hash []byte, key *ecdsa.PrivateKey) ([]byte, error) {
+	sig, err := crypto.Sign(hash, key)
+	if err != nil {
+		return nil, err
+	}
+	sig[crypto.RecoveryIDOffset] += 27
+	return sig, nil
+}
+
+// SignMessage signs a message with a hex private key and returns the hex signature
+func SignMessage(message []byte, privateKey string) (string, error) {
+	key, err := crypto.HexToECDSA(strings.TrimPrefix(privateKey, "0x"))
+	if err != nil {
+		return "", fmt.Errorf("invalid private key: %w", err)
+	}
+	sig, err := SignHash(HashMessage(message), key)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(sig), nil
+}
+
+// DecodeSignature decodes a hex signature, with or without 0x, into 65 bytes
+func DecodeSignature(signature string) ([]byte, error) {
+	sig, err := hex.DecodeString(strings.TrimPrefix(signature, "0x"))
+	if err != nil || len(sig) != crypto.SignatureLength {
+		return nil, ErrInvalidSignature
+	}
+	return sig, nil
+}
+
+// RecoverPublicKey returns the public key that made a signature of a hash. The
+// recovery byte may be 0 or 1, or 27 or 28 as produced by Linda wallets.
+func RecoverPublicKey(hash, signature []byte) (*ecdsa.PublicKey, error) {
+	if len(signature) != crypto.SignatureLength {
+		return nil, ErrInvalidSignature
+	}
+	sig := make([]byte, len(signature))
+	copy(sig, signature)
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+
+	pub, err := crypto.SigToPub(hash, sig)
+	if err != nil {
+		return nil, ErrInvalidSignature
+	}
+	return pub, nil
+}
+
+// PublicKeyToAddress returns the 21-byte Linda address of a public key: the 0x30
+// prefix followed by the last 20 bytes of the Keccak-256 hash of the key
+func PublicKeyToAddress(pub *ecdsa.PublicKey) []byte {
+	return append([]byte{AddressPrefix}, crypto.PubkeyToAddress(*pub).Bytes()...)
+}
+
+// RecoverAddress returns the base58 address whose key made a signature of a hash
+func RecoverAddress(hash, signature []byte) (string, error) {
+	pub, err := RecoverPublicKey(hash, signature)
+	if err != nil {
+		return "", err
+	}
+	return HexToBase58(hex.EncodeToString(PublicKeyToAddress(pub)))
+}
+
+// RecoverMessageSigner returns the base58 address that signed a message with a hex signature
+func RecoverMessageSigner(message []byte, signature string) (string, error) {
+	sig, err := DecodeSignature(signature)
+	if err != nil {
+		return "", err
+	}
+	return RecoverAddress(HashMessage(message), sig)
+}
+
+// VerifyMessageSignature reports whether a hex signature of a message was made by
+// the key of an address, given in base58 or hex
+func VerifyMessageSignature(address string, message []byte, signature string) (bool, error) {
+	hexAddr, err := NormalizeAddress(address)
+	if err != nil {
+		return false, err
+	}
+	signer, err := RecoverMessageSigner(message, signature)
+	if err != nil {
+		return false, err
+	}
+	return signer == MustHexToBase58(hexAddr), nil
+}
+
+// TransactionHash returns the SHA-256 hash of the raw data of a transaction, its ID and the hash its keys sign
+func TransactionHash(tx *lindapb.Transaction) ([]byte, error) {
+	if tx == nil || tx.RawData == nil {
+		return nil, errors.New("transaction has no raw data")
+	}
+	data, err := proto.Marshal(tx.RawData)
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.Sum256(data)
+	return hash[:], nil
+}
+
+// TransactionSigners returns the 21-byte addresses whose keys made the signatures of a transaction
+func TransactionSigners(tx *lindapb.Transaction) ([][]byte, error) {
+	hash, err := TransactionHash(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	signers := make([][]byte, 0, len(tx.Signature))
+	for _, sig := range tx.Signature {
+		pub, err := RecoverPublicKey(hash, sig)
+		if err != nil {
+			return nil, err
+		}
+		signers = append(signers, PublicKeyToAddress(pub))
+	}
+	return signers, nil
+}
+
+// TransactionPermission returns the permission of an account a transaction is signed under,
+// chosen by the permission ID of its contract. An account without an owner permission is
+// owned by its own key alone.
+func TransactionPermission(account *lindapb.Account, tx *lindapb.Transaction) (*lindapb.Permission, error) {
+	var id int32
+	if tx.RawData != nil && len(tx.RawData.Contract) > 0 {
+		id = tx.RawData.Contract[0].PermissionId
+	}
+
+	switch id {
+	case OwnerPermissionID:
+		if account.OwnerPermission != nil {
+			return account.OwnerPermission, nil
+		}
+		return &lindapb.Permission{
+			Threshold: 1,
+			Keys:      []*lindapb.Permission_Key{{Address: account.Address, Weight: 1}},
+		}, nil
+	case WitnessPermissionID:
+		if account.WitnessPermission != nil {
+			return account.WitnessPermission, nil
+		}
+	default:
+		for _, permission := range account.ActivePermission {
+			if permission.Id == id {
+				return permission, nil
+			}
+		}
+	}
+	return nil, ErrUnknownPermission
+}
+
+// VerifyTransactionSignature checks that the signatures of a transaction are made by keys of
+// a permission, each key at most once, and that their weights reach the permission threshold
+func VerifyTransactionSignature(tx *lindapb.Transaction, permission *lindapb.Permission) error {
+	signers, err := TransactionSigners(tx)
+	if err != nil {
+		return err
+	}
+
+	var weight int64
+	for i, signer := range signers {
+		for _, previous := range signers[:i] {
+			if bytes.Equal(previous, signer) {
+				return ErrDuplicateSigner
+			}
+		}
+
+		allowed := false
+		for _, key := range permission.Keys {
+			if bytes.Equal(key.Address, signer) {
+				weight += key.Weight
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return ErrSignerNotAllowed
+		}
+	}
+
+	if weight < permission.Threshold {
+		return ErrThresholdNotMet
+	}
+	return nil
+}
@@ -0,0 +1,227 @@
+package utils
+
+import (
+	"crypto/ecdsa"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/lindaprotocol/grpc-api-gateway/pkg/lindapb"
+)
+
+// newTestKey generates a private key and returns it with its 21-byte address
+func newTestKey(t *testing.T) (*ecdsa.PrivateKey, []byte) {
+	t.Helper()
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	return key, append([]byte{AddressPrefix}, crypto.PubkeyToAddress(key.PublicKey).Bytes()...)
+}
+
+// newTestTransaction returns a transaction whose contract is signed under a permission ID
+func newTestTransaction(permissionID int32) *lindapb.Transaction {
+	return &lindapb.Transaction{
+		RawData: &lindapb.TransactionRaw{
+			Contract: []*lindapb.Transaction_Contract{{
+				Type:         lindapb.Transaction_Contract_TransferContract,
+				PermissionId: permissionID,
+			}},
+			Timestamp:  1700000000000,
+			Expiration: 1700000060000,
+		},
+	}
+}
+
+// signTransaction appends a signature of each key to a transaction
+func signTransaction(t *testing.T, tx *lindapb.Transaction, keys ...*ecdsa.PrivateKey) {
+	t.Helper()
+	hash, err := TransactionHash(tx)
+	if err != nil {
+		t.Fatalf("TransactionHash: %v", err)
+	}
+	for _, key := range keys {
+		sig, err := SignHash(hash, key)
+		if err != nil {
+			t.Fatalf("SignHash: %v", err)
+		}
+		tx.Signature = append(tx.Signature, sig)
+	}
+}
+
+func TestSignMessageRecoversSigner(t *testing.T) {
+	key, addr := newTestKey(t)
+	message := []byte("tag TXYZ as exchange")
+
+	sig, err := SignMessage(message, hex.EncodeToString(crypto.FromECDSA(key)))
+	if err != nil {
+		t.Fatalf("SignMessage: %v", err)
+	}
+
+	signer, err := RecoverMessageSigner(message, sig)
+	if err != nil {
+		t.Fatalf("RecoverMessageSigner: %v", err)
+	}
+	if want := MustHexToBase58(hex.EncodeToString(addr)); signer != want {
+		t.Errorf("signer = %s, want %s", signer, want)
+	}
+	if addr[0] != 0x30 {
+		t.Errorf("address prefix = %#x, want 0x30", addr[0])
+	}
+
+	// The signature verifies against the signer only, and not for another message
+	ok, err := VerifyMessageSignature(signer, message, sig)
+	if err != nil || !ok {
+		t.Errorf("VerifyMessageSignature(signer) = %v, %v, want true", ok, err)
+	}
+	_, other := newTestKey(t)
+	if ok, _ := VerifyMessageSignature(MustHexToBase58(hex.EncodeToString(other)), message, sig); ok {
+		t.Error("VerifyMessageSignature(other) = true, want false")
+	}
+	if ok, _ := VerifyMessageSignature(signer, []byte("tag TXYZ as scam"), sig); ok {
+		t.Error("VerifyMessageSignature(other message) = true, want false")
+	}
+}
+
+func TestRecoverPublicKeyAcceptsBothRecoveryBytes(t *testing.T) {
+	key, addr := newTestKey(t)
+	hash := HashMessage([]byte("hello"))
+
+	sig, err := SignHash(hash, key)
+	if err != nil {
+		t.Fatalf("SignHash: %v", err)
+	}
+	if v := sig[64]; v != 27 && v != 28 {
+		t.Fatalf("recovery byte = %d, want 27 or 28", v)
+	}
+
+	// The same signature with a 0/1 recovery byte recovers the same key
+	raw := append([]byte(nil), sig...)
+	raw[64] -= 27
+	for _, s := range [][]byte{sig, raw} {
+		pub, err := RecoverPublicKey(hash, s)
+		if err != nil {
+			t.Fatalf("RecoverPublicKey(v=%d): %v", s[64], err)
+		}
+		if got := hex.EncodeToString(PublicKeyToAddress(pub)); got != hex.EncodeToString(addr) {
+			t.Errorf("RecoverPublicKey(v=%d) address = %s, want %x", s[64], got, addr)
+		}
+	}
+}
+
+func TestRecoverMessageSignerRejectsMalformedSignatures(t *testing.T) {
+	key, _ := newTestKey(t)
+	message := []byte("hello")
+	sig, err := SignMessage(message, hex.EncodeToString(crypto.FromECDSA(key)))
+	if err != nil {
+		t.Fatalf("SignMessage: %v", err)
+	}
+	decoded, err := DecodeSignature(sig)
+	if err != nil {
+		t.Fatalf("DecodeSignature: %v", err)
+	}
+	badV := append([]byte(nil), decoded...)
+	badV[64] = 35
+
+	for name, signature := range map[string]string{
+		"empty":          "",
+		"not hex":        "0xzz",
+		"short":          hex.EncodeToString(decoded[:64]),
+		"long":           hex.EncodeToString(append(decoded, 0)),
+		"recovery byte":  hex.EncodeToString(badV),
+		"zero signature": hex.EncodeToString(make([]byte, 65)),
+	} {
+		if _, err := RecoverMessageSigner(message, signature); !errors.Is(err, ErrInvalidSignature) {
+			t.Errorf("RecoverMessageSigner(%s) error = %v, want %v", name, err, ErrInvalidSignature)
+		}
+	}
+}
+
+func TestVerifyTransactionSignature(t *testing.T) {
+	first, firstAddr := newTestKey(t)
+	second, secondAddr := newTestKey(t)
+	outsider, _ := newTestKey(t)
+	permission := &lindapb.Permission{
+		Id:        2,
+		Threshold: 2,
+		Keys: []*lindapb.Permission_Key{
+			{Address: firstAddr, Weight: 1},
+			{Address: secondAddr, Weight: 1},
+		},
+	}
+
+	tests := []struct {
+		name string
+		keys []*ecdsa.PrivateKey
+		want error
+	}{
+		{"threshold met", []*ecdsa.PrivateKey{first, second}, nil},
+		{"threshold not met", []*ecdsa.PrivateKey{first}, ErrThresholdNotMet},
+		{"unsigned", nil, ErrThresholdNotMet},
+		{"duplicate signer", []*ecdsa.PrivateKey{first, first}, ErrDuplicateSigner},
+		{"non-member", []*ecdsa.PrivateKey{first, outsider}, ErrSignerNotAllowed},
+	}
+	for _, tt := range tests {
+		tx := newTestTransaction(permission.Id)
+		signTransaction(t, tx, tt.keys...)
+		if err := VerifyTransactionSignature(tx, permission); !errors.Is(err, tt.want) {
+			t.Errorf("%s: VerifyTransactionSignature = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+
+	// A signature over other raw data recovers another key, which is not a member
+	tx := newTestTransaction(permission.Id)
+	signTransaction(t, tx, first, second)
+	tx.RawData.Timestamp++
+	if err := VerifyTransactionSignature(tx, permission); !errors.Is(err, ErrSignerNotAllowed) {
+		t.Errorf("tampered: VerifyTransactionSignature = %v, want %v", err, ErrSignerNotAllowed)
+	}
+}
+
+func TestTransactionPermission(t *testing.T) {
+	owner, ownerAddr := newTestKey(t)
+	other, otherAddr := newTestKey(t)
+	account := &lindapb.Account{Address: ownerAddr}
+
+	// An account without an owner permission is owned by its own key alone
+	tx := newTestTransaction(OwnerPermissionID)
+	permission, err := TransactionPermission(account, tx)
+	if err != nil {
+		t.Fatalf("TransactionPermission: %v", err)
+	}
+	if permission.Threshold != 1 || len(permission.Keys) != 1 ||
+		hex.EncodeToString(permission.Keys[0].Address) != hex.EncodeToString(ownerAddr) || permission.Keys[0].Weight != 1 {
+		t.Errorf("fallback permission = %v, want the account key with weight 1 and threshold 1", permission)
+	}
+	signTransaction(t, tx, owner)
+	if err := VerifyTransactionSignature(tx, permission); err != nil {
+		t.Errorf("owner signature: VerifyTransactionSignature = %v, want nil", err)
+	}
+	tx = newTestTransaction(OwnerPermissionID)
+	signTransaction(t, tx, other)
+	if err := VerifyTransactionSignature(tx, permission); !errors.Is(err, ErrSignerNotAllowed) {
+		t.Errorf("other signature: VerifyTransactionSignature = %v, want %v", err, ErrSignerNotAllowed)
+	}
+
+	// An explicit owner permission replaces the fallback
+	account.OwnerPermission = &lindapb.Permission{
+		Threshold: 1,
+		Keys:      []*lindapb.Permission_Key{{Address: otherAddr, Weight: 1}},
+	}
+	if permission, err := TransactionPermission(account, newTestTransaction(OwnerPermissionID)); err != nil || permission != account.OwnerPermission {
+		t.Errorf("TransactionPermission(owner) = %v, %v, want the owner permission", permission, err)
+	}
+
+	// Active permissions are chosen by ID, missing witness and active permissions are unknown
+	active := &lindapb.Permission{Id: 2, Threshold: 1}
+	account.ActivePermission = []*lindapb.Permission{active}
+	if permission, err := TransactionPermission(account, newTestTransaction(2)); err != nil || permission != active {
+		t.Errorf("TransactionPermission(2) = %v, %v, want the active permission", permission, err)
+	}
+	for _, id := range []int32{WitnessPermissionID, 3} {
+		if _, err := TransactionPermission(account, newTestTransaction(id)); !errors.Is(err, ErrUnknownPermission) {
+			t.Errorf("TransactionPermission(%d) error = %v, want %v", id, err, ErrUnknownPermission)
+		}
+	}
+}